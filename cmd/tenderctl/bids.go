@@ -0,0 +1,59 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+)
+
+func listBids(a *app, args []string) error {
+	flags := flag.NewFlagSet("bids list", flag.ContinueOnError)
+	tenderID := flags.String("tender", "", "tender ID (required)")
+	limit := flags.Int("limit", 50, "page size, 0 for all")
+	offset := flags.Int("offset", 0, "number of bids to skip")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *tenderID == "" {
+		return fmt.Errorf("bids list: -tender is required")
+	}
+
+	bids, err := a.service.ListBids(*tenderID, *limit, *offset)
+	if err != nil {
+		return err
+	}
+	return a.printBids(*bids)
+}
+
+func showBid(a *app, args []string) error {
+	bidID, err := singleArg("bids show", args)
+	if err != nil {
+		return err
+	}
+
+	bids, err := a.service.GetBidVersions(bidID)
+	if err != nil {
+		return err
+	}
+	return a.printBids(*bids)
+}
+
+func evaluateQuorum(a *app, args []string) error {
+	bidID, err := singleArg("bids quorum", args)
+	if err != nil {
+		return err
+	}
+
+	isApproved, err := a.service.EvaluateBidQuorum(bidID)
+	if err != nil {
+		return err
+	}
+	if a.json {
+		return a.printJSON(map[string]interface{}{"bidId": bidID, "approved": isApproved})
+	}
+	if isApproved {
+		fmt.Fprintf(a.out, "Bid %s reached quorum, tender is closed\n", bidID)
+	} else {
+		fmt.Fprintf(a.out, "Bid %s has not reached quorum\n", bidID)
+	}
+	return nil
+}
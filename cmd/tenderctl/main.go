@@ -0,0 +1,121 @@
+// Команда tenderctl выполняет операционные задачи напрямую через пакет services:
+// просмотр тендеров и предложений со всеми версиями, принудительное закрытие тендеров,
+// пересчет кворума, заполнение демо-данными, выгрузку и загрузку данных.
+//
+// Подключение к БД берется из тех же переменных POSTGRES_*, что и у сервера.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"zadanie_6105/src/database"
+	"zadanie_6105/src/mailer"
+	"zadanie_6105/src/services"
+)
+
+const usage = `Usage: tenderctl [-json] <command> [arguments]
+
+Commands:
+  tenders list [-status S] [-limit N] [-offset N]   list latest tender versions
+  tenders show <tenderId>                            show all versions of a tender
+  tenders close <tenderId>                           force-close a tender
+  bids list -tender <tenderId> [-limit N] [-offset N]
+  bids show <bidId>                                  show all versions of a bid
+  bids quorum <bidId>                                re-run quorum evaluation
+  seed                                               create demo organizations and employees
+  export [-o file]                                   dump data as JSON (stdout by default)
+  import [-i file]                                   load a JSON dump (stdin by default)
+`
+
+// app - общее состояние подкоманд
+type app struct {
+	service *services.Service
+	out     io.Writer
+	json    bool
+}
+
+type command func(a *app, args []string) error
+
+var commands = map[string]map[string]command{
+	"tenders": {
+		"list":  listTenders,
+		"show":  showTender,
+		"close": closeTender,
+	},
+	"bids": {
+		"list":   listBids,
+		"show":   showBid,
+		"quorum": evaluateQuorum,
+	},
+}
+
+var topCommands = map[string]command{
+	"seed":   seed,
+	"export": exportSnapshot,
+	"import": importSnapshot,
+}
+
+func main() {
+	jsonOutput := flag.Bool("json", false, "print results as JSON")
+	flag.Usage = func() { fmt.Fprint(os.Stderr, usage) }
+	flag.Parse()
+
+	cmd, args, err := resolve(flag.Args())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	// Логи сервиса не должны смешиваться с выводом команды
+	slog.SetDefault(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelWarn})))
+
+	db, err := database.Open(database.ConnectionString())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to connect to database: %v\n", err)
+		os.Exit(1)
+	}
+
+	a := &app{
+		service: services.NewService(db, mailer.LogMailer{}).WithContext(context.Background()),
+		out:     os.Stdout,
+		json:    *jsonOutput,
+	}
+	if err := cmd(a, args); err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
+	}
+}
+
+func resolve(args []string) (command, []string, error) {
+	if len(args) == 0 {
+		return nil, nil, fmt.Errorf("command is required")
+	}
+	if cmd, ok := topCommands[args[0]]; ok {
+		return cmd, args[1:], nil
+	}
+	group, ok := commands[args[0]]
+	if !ok {
+		return nil, nil, fmt.Errorf("unknown command %q", args[0])
+	}
+	if len(args) < 2 {
+		return nil, nil, fmt.Errorf("%s: subcommand is required", args[0])
+	}
+	cmd, ok := group[args[1]]
+	if !ok {
+		return nil, nil, fmt.Errorf("unknown command %q", args[0]+" "+args[1])
+	}
+	return cmd, args[2:], nil
+}
+
+// singleArg разбирает подкоманду с одним позиционным аргументом-идентификатором
+func singleArg(name string, args []string) (string, error) {
+	if len(args) != 1 {
+		return "", fmt.Errorf("%s: exactly one ID is required", name)
+	}
+	return args[0], nil
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"text/tabwriter"
+	"time"
+	"zadanie_6105/src/models"
+)
+
+func (a *app) printJSON(v interface{}) error {
+	encoder := json.NewEncoder(a.out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
+
+func (a *app) printTenders(tenders []models.Tender) error {
+	if a.json {
+		return a.printJSON(tenders)
+	}
+	w := tabwriter.NewWriter(a.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tVERSION\tSTATUS\tSERVICE TYPE\tORGANIZATION\tNAME\tCREATED")
+	for _, t := range tenders {
+		fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\t%s\t%s\n",
+			t.ID, t.Version, t.Status, t.ServiceType, t.OrganizationId, t.Name, t.CreatedAt.Format(time.RFC3339))
+	}
+	return w.Flush()
+}
+
+func (a *app) printBids(bids []models.Bid) error {
+	if a.json {
+		return a.printJSON(bids)
+	}
+	w := tabwriter.NewWriter(a.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tVERSION\tSTATUS\tTENDER\tAUTHOR\tNAME\tCREATED")
+	for _, b := range bids {
+		fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s %s\t%s\t%s\n",
+			b.ID, b.Version, b.Status, b.TenderId, b.AuthorType, b.AuthorId, b.Name, b.CreatedAt.Format(time.RFC3339))
+	}
+	return w.Flush()
+}
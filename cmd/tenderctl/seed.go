@@ -0,0 +1,83 @@
+package main
+
+import (
+	"fmt"
+	"github.com/google/uuid"
+	"zadanie_6105/src/models"
+	"zadanie_6105/src/services"
+)
+
+// Фиксированные идентификаторы делают seed повторяемым: существующие записи пропускаются
+var (
+	demoConstructionID = uuid.MustParse("00000000-0000-4000-8000-000000000001")
+	demoDeliveryID     = uuid.MustParse("00000000-0000-4000-8000-000000000002")
+)
+
+func demoEmployee(n int, username, firstName, lastName string) models.Employee {
+	return models.Employee{
+		ID:        uuid.MustParse(fmt.Sprintf("00000000-0000-4000-8000-0000000001%02d", n)),
+		Username:  username,
+		FirstName: firstName,
+		LastName:  lastName,
+		Email:     username + "@example.com",
+		Locale:    "ru",
+	}
+}
+
+func demoResponsible(n int, organizationID uuid.UUID, employee models.Employee, role string) models.OrganizationResponsible {
+	return models.OrganizationResponsible{
+		ID:             uuid.MustParse(fmt.Sprintf("00000000-0000-4000-8000-0000000002%02d", n)),
+		OrganizationID: organizationID,
+		UserID:         employee.ID,
+		Role:           role,
+	}
+}
+
+func demoSnapshot() *services.Snapshot {
+	admin := demoEmployee(1, "demo_admin", "Анна", "Админова")
+	admin.IsAdmin = true
+	author := demoEmployee(2, "demo_author", "Иван", "Авторов")
+	approvers := []models.Employee{
+		demoEmployee(3, "demo_approver1", "Петр", "Первый"),
+		demoEmployee(4, "demo_approver2", "Мария", "Вторая"),
+		demoEmployee(5, "demo_approver3", "Олег", "Третий"),
+	}
+	viewer := demoEmployee(6, "demo_viewer", "Елена", "Смотрова")
+	contractor := demoEmployee(7, "demo_contractor", "Сергей", "Подрядов")
+
+	responsibles := []models.OrganizationResponsible{
+		demoResponsible(1, demoConstructionID, admin, models.RoleOrgAdmin),
+		demoResponsible(2, demoConstructionID, author, models.RoleTenderAuthor),
+		demoResponsible(6, demoConstructionID, viewer, models.RoleViewer),
+		demoResponsible(7, demoDeliveryID, contractor, models.RoleOrgAdmin),
+	}
+	for i, approver := range approvers {
+		responsibles = append(responsibles, demoResponsible(3+i, demoConstructionID, approver, models.RoleApprover))
+	}
+
+	return &services.Snapshot{
+		Organizations: []models.Organization{
+			{ID: demoConstructionID, Name: "Демо Стройка", Description: "Демо-заказчик", Type: "LLC"},
+			{ID: demoDeliveryID, Name: "Демо Доставка", Description: "Демо-подрядчик", Type: "IE"},
+		},
+		Employees:    append([]models.Employee{admin, author, viewer, contractor}, approvers...),
+		Responsibles: responsibles,
+	}
+}
+
+func seed(a *app, args []string) error {
+	if len(args) != 0 {
+		return fmt.Errorf("seed: no arguments expected")
+	}
+
+	snapshot := demoSnapshot()
+	if err := a.service.ImportSnapshot(snapshot); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(a.out, "Seeded %d organizations and %d employees\n", len(snapshot.Organizations), len(snapshot.Employees))
+	for _, employee := range snapshot.Employees {
+		fmt.Fprintf(a.out, "  %s\n", employee.Username)
+	}
+	return nil
+}
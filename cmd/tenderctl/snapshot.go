@@ -0,0 +1,68 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"zadanie_6105/src/services"
+)
+
+func exportSnapshot(a *app, args []string) error {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	output := flags.String("o", "", "output file, stdout by default")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	snapshot, err := a.service.ExportSnapshot()
+	if err != nil {
+		return err
+	}
+
+	var w io.Writer = a.out
+	if *output != "" {
+		file, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		w = file
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(snapshot)
+}
+
+func importSnapshot(a *app, args []string) error {
+	flags := flag.NewFlagSet("import", flag.ContinueOnError)
+	input := flags.String("i", "", "input file, stdin by default")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	var r io.Reader = os.Stdin
+	if *input != "" {
+		file, err := os.Open(*input)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		r = file
+	}
+
+	var snapshot services.Snapshot
+	if err := json.NewDecoder(r).Decode(&snapshot); err != nil {
+		return fmt.Errorf("invalid dump: %w", err)
+	}
+	if err := a.service.ImportSnapshot(&snapshot); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "Imported %d organizations, %d employees, %d responsibles, %d tender versions, %d bid versions, %d feedbacks, %d decisions\n",
+		len(snapshot.Organizations), len(snapshot.Employees), len(snapshot.Responsibles),
+		len(snapshot.Tenders), len(snapshot.Bids), len(snapshot.Feedbacks), len(snapshot.Decisions))
+	return nil
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+)
+
+func listTenders(a *app, args []string) error {
+	flags := flag.NewFlagSet("tenders list", flag.ContinueOnError)
+	status := flags.String("status", "", "filter by status: Created, Published, Closed")
+	limit := flags.Int("limit", 50, "page size, 0 for all")
+	offset := flags.Int("offset", 0, "number of tenders to skip")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	tenders, err := a.service.ListTenders(*status, *limit, *offset)
+	if err != nil {
+		return err
+	}
+	return a.printTenders(*tenders)
+}
+
+func showTender(a *app, args []string) error {
+	tenderID, err := singleArg("tenders show", args)
+	if err != nil {
+		return err
+	}
+
+	tenders, err := a.service.GetTenderVersions(tenderID)
+	if err != nil {
+		return err
+	}
+	return a.printTenders(*tenders)
+}
+
+// closeTender закрывает тендер в обход проверки кворума
+func closeTender(a *app, args []string) error {
+	tenderID, err := singleArg("tenders close", args)
+	if err != nil {
+		return err
+	}
+
+	tender, err := a.service.UpdateTenderStatus(tenderID, "Closed")
+	if err != nil {
+		return err
+	}
+	if a.json {
+		return a.printJSON(tender)
+	}
+	fmt.Fprintf(a.out, "Tender %s is closed\n", tender.ID)
+	return nil
+}
@@ -1,56 +1,211 @@
 package main
 
 import (
+	"context"
 	"fmt"
-	"gorm.io/driver/postgres"
+	"github.com/gorilla/mux"
+	"github.com/invopop/yaml"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
 	"gorm.io/gorm"
 	"log"
+	"log/slog"
 	"net/http"
 	"os"
-	"zadanie_6105/src/models"
+	"strconv"
+	"time"
+	"zadanie_6105/src/cache"
+	"zadanie_6105/src/database"
+	"zadanie_6105/src/idempotency"
+	"zadanie_6105/src/logging"
+	"zadanie_6105/src/mailer"
+	"zadanie_6105/src/ratelimit"
+	"zadanie_6105/src/repository"
 	"zadanie_6105/src/routes"
 	"zadanie_6105/src/services"
+	"zadanie_6105/src/tracing"
 )
 
 var db *gorm.DB
 
 func initDB() {
-	connStr := fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%s sslmode=disable",
-		os.Getenv("POSTGRES_HOST"),
-		os.Getenv("POSTGRES_USERNAME"),
-		os.Getenv("POSTGRES_PASSWORD"),
-		os.Getenv("POSTGRES_DATABASE"),
-		os.Getenv("POSTGRES_PORT"))
-
 	var err error
-	db, err = gorm.Open(postgres.Open(connStr), &gorm.Config{})
+	db, err = database.Open(database.ConnectionString())
 	if err != nil {
 		log.Fatalf("Failed to connect to database: %v", err)
 	}
 
-	log.Println("Successfully connected to the database with GORM")
+	slog.Info("Successfully connected to the database with GORM")
 
-	err = db.AutoMigrate(&models.Tender{})
+	sqlDB, err := db.DB()
 	if err != nil {
+		log.Fatalf("Failed to get database handle: %v", err)
+	}
+	prometheus.MustRegister(collectors.NewDBStatsCollector(sqlDB, os.Getenv("POSTGRES_DATABASE")))
+
+	if err := database.Migrate(db); err != nil {
 		log.Fatalf("Failed to migrate table: %v", err)
 	}
-	err = db.AutoMigrate(&models.Bid{})
+}
+
+// initStorage выбирает хранилище по STORAGE: postgres (по умолчанию) или memory.
+// В режиме memory сервис не требует БД и при старте заполняется из STORAGE_FIXTURE.
+func initStorage(m mailer.Mailer) (*services.Service, idempotency.Store) {
+	switch storage := os.Getenv("STORAGE"); storage {
+	case "", "postgres":
+		initDB()
+		return services.NewService(db, m), idempotency.NewPostgresStore(db)
+	case "memory":
+		service := services.NewServiceWithRepositories(repository.NewMemory(), m)
+		if fixture := os.Getenv("STORAGE_FIXTURE"); fixture != "" {
+			if err := loadFixture(service, fixture); err != nil {
+				log.Fatalf("Failed to load fixture: %v", err)
+			}
+		}
+		slog.Warn("Using in-memory storage, data will be lost on restart")
+		return service, idempotency.NewMemoryStore()
+	default:
+		log.Fatalf("Unknown STORAGE %q", storage)
+		return nil, nil
+	}
+}
+
+// loadFixture загружает снимок данных из JSON или YAML файла
+func loadFixture(service *services.Service, path string) error {
+	data, err := os.ReadFile(path)
 	if err != nil {
-		log.Fatalf("Failed to migrate table: %v", err)
+		return err
+	}
+
+	var snapshot services.Snapshot
+	if err := yaml.Unmarshal(data, &snapshot); err != nil {
+		return fmt.Errorf("invalid fixture %s: %w", path, err)
+	}
+	if err := service.ImportSnapshot(&snapshot); err != nil {
+		return err
+	}
+
+	slog.Info("Fixture loaded", "path", path,
+		"organizations", len(snapshot.Organizations),
+		"employees", len(snapshot.Employees),
+		"tenders", len(snapshot.Tenders),
+		"bids", len(snapshot.Bids))
+	return nil
+}
+
+// initCache выбирает кеш публичного списка тендеров по CACHE_STORE: memory (по умолчанию),
+// postgres - общий для всех экземпляров, none - без кеша. CACHE_TTL задает время жизни страниц.
+func initCache(service *services.Service) {
+	ttl := time.Minute
+	if value := os.Getenv("CACHE_TTL"); value != "" {
+		var err error
+		ttl, err = time.ParseDuration(value)
+		if err != nil || ttl <= 0 {
+			log.Fatalf("Invalid CACHE_TTL %q", value)
+		}
+	}
+
+	var store cache.Store
+	switch storeName := os.Getenv("CACHE_STORE"); storeName {
+	case "", "memory":
+		store = cache.NewMemoryStore()
+	case "postgres":
+		if db == nil {
+			log.Fatalf("CACHE_STORE=postgres requires STORAGE=postgres")
+		}
+		store = cache.NewPostgresStore(db)
+	case "none":
+		return
+	default:
+		log.Fatalf("Unknown CACHE_STORE %q", storeName)
 	}
-	err = db.AutoMigrate(&models.BidFeedback{})
+
+	service.UseCache(store, ttl)
+	go cache.RunCleanup(context.Background(), store, 10*time.Minute)
+}
+
+func initMailer() mailer.Mailer {
+	host := os.Getenv("SMTP_HOST")
+	if host == "" {
+		slog.Warn("SMTP_HOST is not set, emails will only be logged")
+		return mailer.LogMailer{}
+	}
+
+	return mailer.NewSMTPMailer(
+		host,
+		os.Getenv("SMTP_PORT"),
+		os.Getenv("SMTP_USERNAME"),
+		os.Getenv("SMTP_PASSWORD"),
+		os.Getenv("SMTP_FROM"))
+}
+
+// initRateLimiter читает настройки лимитов из окружения. Без RATE_LIMIT_DEFAULT и
+// RATE_LIMIT_ROUTES ограничения выключены.
+func initRateLimiter() (mux.MiddlewareFunc, bool) {
+	defaultLimit := os.Getenv("RATE_LIMIT_DEFAULT")
+	routeLimits := os.Getenv("RATE_LIMIT_ROUTES")
+	if defaultLimit == "" && routeLimits == "" {
+		return nil, false
+	}
+
+	var config ratelimit.Config
+	var err error
+	if defaultLimit != "" {
+		config.Default, err = ratelimit.ParseLimit(defaultLimit)
+		if err != nil {
+			log.Fatalf("Invalid RATE_LIMIT_DEFAULT: %v", err)
+		}
+	}
+	config.Routes, err = ratelimit.ParseRouteLimits(routeLimits)
 	if err != nil {
-		log.Fatalf("Failed to migrate table: %v", err)
+		log.Fatalf("Invalid RATE_LIMIT_ROUTES: %v", err)
 	}
+	if value := os.Getenv("RATE_LIMIT_TRUSTED_PROXIES"); value != "" {
+		config.TrustedProxies, err = strconv.Atoi(value)
+		if err != nil || config.TrustedProxies < 0 {
+			log.Fatalf("Invalid RATE_LIMIT_TRUSTED_PROXIES %q", value)
+		}
+	}
+
+	var store ratelimit.Store
+	switch storeName := os.Getenv("RATE_LIMIT_STORE"); storeName {
+	case "", "memory":
+		store = ratelimit.NewMemoryStore()
+	case "postgres":
+		if db == nil {
+			log.Fatalf("RATE_LIMIT_STORE=postgres requires STORAGE=postgres")
+		}
+		store = ratelimit.NewPostgresStore(db)
+	default:
+		log.Fatalf("Unknown RATE_LIMIT_STORE %q", storeName)
+	}
+
+	go ratelimit.RunCleanup(context.Background(), store, 10*time.Minute)
+	return ratelimit.Middleware(store, config), true
 }
 
 func main() {
-	initDB()
+	logging.Init()
 
-	service := services.NewService(db)
-	router := routes.RegisterRoutes(service)
+	shutdownTracing, err := tracing.Init(context.Background())
+	if err != nil {
+		log.Fatalf("Failed to initialize tracing: %v", err)
+	}
+
+	service, idempotencyStore := initStorage(initMailer())
+	initCache(service)
+	go service.RunEmailQueue(context.Background(), 30*time.Second)
+	go service.RunUnsealing(context.Background(), time.Minute)
+	go idempotency.RunCleanup(context.Background(), idempotencyStore, time.Hour)
+
+	router := routes.RegisterRoutes(service, idempotencyStore)
+	if limiter, enabled := initRateLimiter(); enabled {
+		router.Use(limiter)
+	}
 
 	// Запуск HTTP сервера
-	log.Println("Starting server on :8080")
-	log.Fatal(http.ListenAndServe(":8080", router))
+	slog.Info("Starting server", "address", ":8080")
+	err = http.ListenAndServe(":8080", router)
+	_ = shutdownTracing(context.Background())
+	log.Fatal(err)
 }
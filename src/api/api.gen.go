@@ -0,0 +1,3676 @@
+// Package api provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
+package api
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gorilla/mux"
+	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Defines values for BidAuthorType.
+const (
+	Organization BidAuthorType = "Organization"
+	User         BidAuthorType = "User"
+)
+
+// Defines values for BidDecision.
+const (
+	Approved BidDecision = "Approved"
+	Rejected BidDecision = "Rejected"
+)
+
+// Defines values for BidStatus.
+const (
+	BidStatusCanceled  BidStatus = "Canceled"
+	BidStatusCreated   BidStatus = "Created"
+	BidStatusPublished BidStatus = "Published"
+)
+
+// Defines values for TenderFilterOrganizationTypes.
+const (
+	TenderFilterOrganizationTypesIE  TenderFilterOrganizationTypes = "IE"
+	TenderFilterOrganizationTypesJSC TenderFilterOrganizationTypes = "JSC"
+	TenderFilterOrganizationTypesLLC TenderFilterOrganizationTypes = "LLC"
+)
+
+// Defines values for TenderFilterSort.
+const (
+	TenderFilterSortBudget    TenderFilterSort = "budget"
+	TenderFilterSortCreatedAt TenderFilterSort = "createdAt"
+	TenderFilterSortDeadline  TenderFilterSort = "deadline"
+	TenderFilterSortName      TenderFilterSort = "name"
+)
+
+// Defines values for TenderStatus.
+const (
+	TenderStatusClosed    TenderStatus = "Closed"
+	TenderStatusCreated   TenderStatus = "Created"
+	TenderStatusPublished TenderStatus = "Published"
+)
+
+// Defines values for GetTendersParamsOrganizationType.
+const (
+	GetTendersParamsOrganizationTypeIE  GetTendersParamsOrganizationType = "IE"
+	GetTendersParamsOrganizationTypeJSC GetTendersParamsOrganizationType = "JSC"
+	GetTendersParamsOrganizationTypeLLC GetTendersParamsOrganizationType = "LLC"
+)
+
+// Defines values for GetTendersParamsSort.
+const (
+	GetTendersParamsSortBudget         GetTendersParamsSort = "budget"
+	GetTendersParamsSortCreatedAt      GetTendersParamsSort = "createdAt"
+	GetTendersParamsSortDeadline       GetTendersParamsSort = "deadline"
+	GetTendersParamsSortMinusBudget    GetTendersParamsSort = "-budget"
+	GetTendersParamsSortMinusCreatedAt GetTendersParamsSort = "-createdAt"
+	GetTendersParamsSortMinusDeadline  GetTendersParamsSort = "-deadline"
+	GetTendersParamsSortMinusName      GetTendersParamsSort = "-name"
+	GetTendersParamsSortName           GetTendersParamsSort = "name"
+)
+
+// Defines values for ExportTenderParamsFormat.
+const (
+	Csv   ExportTenderParamsFormat = "csv"
+	Jsonl ExportTenderParamsFormat = "jsonl"
+	Xlsx  ExportTenderParamsFormat = "xlsx"
+)
+
+// Auction Реверсивный аукцион тендера
+type Auction struct {
+	// EndsAt Окончание приема ставок в формате RFC3339.
+	EndsAt string `json:"endsAt"`
+
+	// ExtensionSeconds На сколько секунд ставка в последние секунды продлевает аукцион
+	ExtensionSeconds AuctionExtensionSeconds `json:"extensionSeconds"`
+
+	// MinDecrement Минимальный шаг снижения цены
+	MinDecrement AuctionMinDecrement `json:"minDecrement"`
+
+	// StartPrice Начальная цена; ставки выше нее не принимаются
+	StartPrice *AuctionStartPrice `json:"startPrice,omitempty"`
+
+	// StartsAt Начало приема ставок в формате RFC3339.
+	StartsAt string `json:"startsAt"`
+
+	// TenderId Уникальный идентификатор тендера, присвоенный сервером.
+	TenderId TenderId `json:"tenderId"`
+}
+
+// AuctionExtensionSeconds На сколько секунд ставка в последние секунды продлевает аукцион
+type AuctionExtensionSeconds = int32
+
+// AuctionMinDecrement Минимальный шаг снижения цены
+type AuctionMinDecrement = float64
+
+// AuctionOffer Лучшая ставка участника в рейтинге аукциона
+type AuctionOffer struct {
+	// AuthorId Уникальный идентификатор автора предложения, присвоенный сервером.
+	AuthorId BidAuthorId `json:"authorId"`
+
+	// BidId Уникальный идентификатор предложения, присвоенный сервером.
+	BidId BidId `json:"bidId"`
+
+	// CreatedAt Дата и время ставки в формате RFC3339.
+	CreatedAt string `json:"createdAt"`
+
+	// Price Цена в ставке
+	Price AuctionPrice `json:"price"`
+
+	// Rank Место участника, начиная с 1
+	Rank int `json:"rank"`
+}
+
+// AuctionPrice Цена в ставке
+type AuctionPrice = float64
+
+// AuctionStanding Место предложения в аукционе
+type AuctionStanding struct {
+	// BidId Уникальный идентификатор предложения, присвоенный сервером.
+	BidId BidId `json:"bidId"`
+
+	// EndsAt Окончание приема ставок с учетом продлений в формате RFC3339.
+	EndsAt string `json:"endsAt"`
+
+	// Participants Число участников со ставками
+	Participants int `json:"participants"`
+
+	// Price Цена в ставке
+	Price *AuctionPrice `json:"price,omitempty"`
+
+	// Rank Место предложения, начиная с 1; 0, если ставок по предложению еще нет
+	Rank int `json:"rank"`
+}
+
+// AuctionStartPrice Начальная цена; ставки выше нее не принимаются
+type AuctionStartPrice = float64
+
+// AuditEvent Запись журнала аудита тендера
+type AuditEvent struct {
+	// Action Действие. BidsUnsealed - предложения запечатанного тендера вскрыты после срока приема.
+	Action string `json:"action"`
+
+	// CreatedAt Дата и время действия в формате RFC3339.
+	CreatedAt string `json:"createdAt"`
+
+	// Details Подробности действия
+	Details *map[string]interface{} `json:"details,omitempty"`
+
+	// EmployeeId Сотрудник, совершивший действие. Не передается, если действие выполнил сервис.
+	EmployeeId *string `json:"employeeId,omitempty"`
+
+	// Id Уникальный идентификатор записи.
+	Id string `json:"id"`
+}
+
+// Bid Информация о предложении
+type Bid struct {
+	// AuthorId Уникальный идентификатор автора предложения, присвоенный сервером.
+	AuthorId BidAuthorId `json:"authorId"`
+
+	// AuthorType Тип автора
+	AuthorType BidAuthorType `json:"authorType"`
+
+	// Commitment SHA-256 в hex от названия и описания предложения, разделенных нулевым байтом.
+	// Задается для предложений запечатанного тендера; после вскрытия по нему можно
+	// проверить, что содержимое не менялось.
+	Commitment *BidCommitment `json:"commitment,omitempty"`
+
+	// CreatedAt Серверная дата и время в момент, когда пользователь отправил предложение на создание.
+	// Передается в формате RFC3339.
+	CreatedAt string `json:"createdAt"`
+
+	// Description Описание предложения
+	Description BidDescription `json:"description"`
+
+	// Id Уникальный идентификатор предложения, присвоенный сервером.
+	Id BidId `json:"id"`
+
+	// Name Полное название предложения
+	Name BidName `json:"name"`
+
+	// Round Раунд тендера, в котором подана эта версия предложения.
+	Round BidRound `json:"round"`
+
+	// Sealed Содержимое предложения запечатано до срока приема тендера.
+	Sealed BidSealed `json:"sealed"`
+
+	// Status Статус предложения
+	Status BidStatus `json:"status"`
+
+	// TenderId Уникальный идентификатор тендера, присвоенный сервером.
+	TenderId TenderId `json:"tenderId"`
+
+	// Version Номер версии посел правок
+	Version BidVersion `json:"version"`
+}
+
+// BidAuthorId Уникальный идентификатор автора предложения, присвоенный сервером.
+type BidAuthorId = string
+
+// BidAuthorType Тип автора
+type BidAuthorType string
+
+// BidCommitment SHA-256 в hex от названия и описания предложения, разделенных нулевым байтом.
+// Задается для предложений запечатанного тендера; после вскрытия по нему можно
+// проверить, что содержимое не менялось.
+type BidCommitment = string
+
+// BidDecision Решение по предложению
+type BidDecision string
+
+// BidDescription Описание предложения
+type BidDescription = string
+
+// BidFeedback Отзыв на предложение
+type BidFeedback = string
+
+// BidId Уникальный идентификатор предложения, присвоенный сервером.
+type BidId = string
+
+// BidName Полное название предложения
+type BidName = string
+
+// BidReview Отзыв о предложении
+type BidReview struct {
+	// CreatedAt Серверная дата и время в момент, когда пользователь отправил отзыв на предложение.
+	// Передается в формате RFC3339.
+	CreatedAt string `json:"createdAt"`
+
+	// Description Описание предложения
+	Description BidReviewDescription `json:"description"`
+
+	// Id Уникальный идентификатор отзыва, присвоенный сервером.
+	Id BidReviewId `json:"id"`
+}
+
+// BidReviewDescription Описание предложения
+type BidReviewDescription = string
+
+// BidReviewId Уникальный идентификатор отзыва, присвоенный сервером.
+type BidReviewId = string
+
+// BidRound Раунд тендера, в котором подана эта версия предложения.
+type BidRound = int32
+
+// BidSealed Содержимое предложения запечатано до срока приема тендера.
+type BidSealed = bool
+
+// BidStatus Статус предложения
+type BidStatus string
+
+// BidVersion Номер версии посел правок
+type BidVersion = int32
+
+// ErrorResponse Используется для возвращения ошибки пользователю
+type ErrorResponse struct {
+	// Reason Описание ошибки в свободной форме
+	Reason string `json:"reason"`
+}
+
+// ImportReport Отчет об импорте тендеров
+type ImportReport struct {
+	// DryRun Был ли это только предпросмотр
+	DryRun bool `json:"dryRun"`
+
+	// Imported Сколько тендеров создано
+	Imported int `json:"imported"`
+
+	// Reason Причина, по которой импорт отклонен
+	Reason *string     `json:"reason,omitempty"`
+	Rows   []ImportRow `json:"rows"`
+}
+
+// ImportRow Результат проверки строки файла импорта
+type ImportRow struct {
+	// Errors Ошибки в строке. Если их нет, заполнено tender; при dry_run у него еще нет id и createdAt, они нулевые.
+	Errors *[]string `json:"errors,omitempty"`
+
+	// Row Номер строки в файле, заголовок - строка 1
+	Row int `json:"row"`
+
+	// Tender Информация о тендере
+	Tender *Tender `json:"tender,omitempty"`
+}
+
+// OrganizationId Уникальный идентификатор организации, присвоенный сервером.
+type OrganizationId = string
+
+// SavedSearch Сохраненный поиск тендеров
+type SavedSearch struct {
+	// CreatedAt Дата и время создания в формате RFC3339.
+	CreatedAt string `json:"createdAt"`
+
+	// Filter Фильтр тендеров, как параметры списка тендеров. Фильтры объединяются по И, значения одного списочного фильтра - по ИЛИ.
+	Filter TenderFilter `json:"filter"`
+
+	// Id Уникальный идентификатор сохраненного поиска, присвоенный сервером.
+	Id SavedSearchId `json:"id"`
+
+	// IncludeSubcategories Включать тендеры подкатегорий видов услуг из фильтра
+	IncludeSubcategories bool `json:"includeSubcategories"`
+
+	// Name Название сохраненного поиска
+	Name SavedSearchName `json:"name"`
+
+	// Notify Присылать письма о подходящих тендерах
+	Notify bool `json:"notify"`
+}
+
+// SavedSearchId Уникальный идентификатор сохраненного поиска, присвоенный сервером.
+type SavedSearchId = string
+
+// SavedSearchName Название сохраненного поиска
+type SavedSearchName = string
+
+// ServiceType Вид услуг из справочника
+type ServiceType struct {
+	// Code Код вида услуги из справочника, к которой относиться тендер.
+	//
+	// Справочник иерархический: например, Roadworks входит в Construction.
+	Code TenderServiceType `json:"code"`
+
+	// CreatedAt Дата и время создания в формате RFC3339.
+	CreatedAt string `json:"createdAt"`
+
+	// Name Название на запрошенном языке
+	Name string `json:"name"`
+
+	// Names Названия вида услуг по языкам. Поддерживаются языки ru и en.
+	Names ServiceTypeNames `json:"names"`
+
+	// ParentCode Код вида услуги из справочника, к которой относиться тендер.
+	//
+	// Справочник иерархический: например, Roadworks входит в Construction.
+	ParentCode *TenderServiceType `json:"parentCode,omitempty"`
+}
+
+// ServiceTypeNames Названия вида услуг по языкам. Поддерживаются языки ru и en.
+type ServiceTypeNames map[string]string
+
+// ShortlistEntry Предложение в шорт-листе раунда
+type ShortlistEntry struct {
+	// AuthorId Уникальный идентификатор автора предложения, присвоенный сервером.
+	AuthorId BidAuthorId `json:"authorId"`
+
+	// BidId Уникальный идентификатор предложения, присвоенный сервером.
+	BidId BidId `json:"bidId"`
+
+	// CreatedAt Дата и время добавления в формате RFC3339.
+	CreatedAt string `json:"createdAt"`
+
+	// EmployeeId Идентификатор сотрудника, добавившего предложение в шорт-лист.
+	EmployeeId string `json:"employeeId"`
+
+	// Round Номер текущего раунда тендера.
+	Round TenderRound `json:"round"`
+}
+
+// Tender Информация о тендере
+type Tender struct {
+	// Budget Бюджет тендера в рублях.
+	Budget *TenderBudget `json:"budget,omitempty"`
+
+	// CreatedAt Серверная дата и время в момент, когда пользователь отправил тендер на создание.
+	// Передается в формате RFC3339.
+	CreatedAt string `json:"createdAt"`
+
+	// Deadline Срок приема предложений в формате RFC3339.
+	Deadline *TenderDeadline `json:"deadline,omitempty"`
+
+	// Description Описание тендера
+	Description TenderDescription `json:"description"`
+
+	// Id Уникальный идентификатор тендера, присвоенный сервером.
+	Id TenderId `json:"id"`
+
+	// Name Полное название тендера
+	Name TenderName `json:"name"`
+
+	// OrganizationId Уникальный идентификатор организации, присвоенный сервером.
+	OrganizationId OrganizationId `json:"organizationId"`
+
+	// Region Регион, в котором нужно оказать услугу.
+	Region *TenderRegion `json:"region,omitempty"`
+
+	// Round Номер текущего раунда тендера.
+	Round TenderRound `json:"round"`
+
+	// Rounds Число раундов закупки. Во втором и следующих раундах предложения подают
+	// только авторы, прошедшие в шорт-лист предыдущего раунда, а решения по предложениям
+	// принимаются только в последнем раунде.
+	Rounds TenderRounds `json:"rounds"`
+
+	// Sealed Запечатанный тендер: название и описание предложений хранятся зашифрованными
+	// и недоступны ответственным до срока приема, после срока все предложения вскрываются разом.
+	// Для запечатанного тендера срок обязателен и может только переноситься на более поздний,
+	// а раунд у него только один.
+	Sealed TenderSealed `json:"sealed"`
+
+	// ServiceType Код вида услуги из справочника, к которой относиться тендер.
+	//
+	// Справочник иерархический: например, Roadworks входит в Construction.
+	ServiceType TenderServiceType `json:"serviceType"`
+
+	// Status Статус тендер
+	Status TenderStatus `json:"status"`
+
+	// Version Номер версии посел правок
+	Version TenderVersion `json:"version"`
+}
+
+// TenderBudget Бюджет тендера в рублях.
+type TenderBudget = float64
+
+// TenderDeadline Срок приема предложений в формате RFC3339.
+type TenderDeadline = string
+
+// TenderDescription Описание тендера
+type TenderDescription = string
+
+// TenderFilter Фильтр тендеров, как параметры списка тендеров. Фильтры объединяются по И, значения одного списочного фильтра - по ИЛИ.
+type TenderFilter struct {
+	BudgetMax    *float64   `json:"budgetMax,omitempty"`
+	BudgetMin    *float64   `json:"budgetMin,omitempty"`
+	CreatedFrom  *time.Time `json:"createdFrom,omitempty"`
+	CreatedTo    *time.Time `json:"createdTo,omitempty"`
+	DeadlineFrom *time.Time `json:"deadlineFrom,omitempty"`
+	DeadlineTo   *time.Time `json:"deadlineTo,omitempty"`
+
+	// Descending Сортировать по убыванию
+	Descending        *bool                            `json:"descending,omitempty"`
+	OrganizationIds   *[]OrganizationId                `json:"organizationIds,omitempty"`
+	OrganizationTypes *[]TenderFilterOrganizationTypes `json:"organizationTypes,omitempty"`
+	Regions           *[]TenderRegion                  `json:"regions,omitempty"`
+
+	// Search Слова, которые должны встретиться в названии или описании
+	Search       *string              `json:"search,omitempty"`
+	ServiceTypes *[]TenderServiceType `json:"serviceTypes,omitempty"`
+
+	// Sort Поле сортировки, по умолчанию - название
+	Sort *TenderFilterSort `json:"sort,omitempty"`
+}
+
+// TenderFilterOrganizationTypes defines model for TenderFilter.OrganizationTypes.
+type TenderFilterOrganizationTypes string
+
+// TenderFilterSort Поле сортировки, по умолчанию - название
+type TenderFilterSort string
+
+// TenderId Уникальный идентификатор тендера, присвоенный сервером.
+type TenderId = string
+
+// TenderName Полное название тендера
+type TenderName = string
+
+// TenderRegion Регион, в котором нужно оказать услугу.
+type TenderRegion = string
+
+// TenderRound Номер текущего раунда тендера.
+type TenderRound = int32
+
+// TenderRounds Число раундов закупки. Во втором и следующих раундах предложения подают
+// только авторы, прошедшие в шорт-лист предыдущего раунда, а решения по предложениям
+// принимаются только в последнем раунде.
+type TenderRounds = int32
+
+// TenderSealed Запечатанный тендер: название и описание предложений хранятся зашифрованными
+// и недоступны ответственным до срока приема, после срока все предложения вскрываются разом.
+// Для запечатанного тендера срок обязателен и может только переноситься на более поздний,
+// а раунд у него только один.
+type TenderSealed = bool
+
+// TenderServiceType Код вида услуги из справочника, к которой относиться тендер.
+//
+// Справочник иерархический: например, Roadworks входит в Construction.
+type TenderServiceType = string
+
+// TenderStatus Статус тендер
+type TenderStatus string
+
+// TenderVersion Номер версии посел правок
+type TenderVersion = int32
+
+// Username Уникальный slug пользователя.
+type Username = string
+
+// Locale defines model for locale.
+type Locale = string
+
+// PaginationLimit defines model for paginationLimit.
+type PaginationLimit = int32
+
+// PaginationOffset defines model for paginationOffset.
+type PaginationOffset = int32
+
+// GetUserBidsParams defines parameters for GetUserBids.
+type GetUserBidsParams struct {
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	Offset   *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+	Username *Username         `form:"username,omitempty" json:"username,omitempty"`
+}
+
+// CreateBidJSONBody defines parameters for CreateBid.
+type CreateBidJSONBody struct {
+	// AuthorId Уникальный идентификатор автора предложения, присвоенный сервером.
+	AuthorId BidAuthorId `json:"authorId"`
+
+	// AuthorType Тип автора
+	AuthorType BidAuthorType `json:"authorType"`
+
+	// Description Описание предложения
+	Description BidDescription `json:"description"`
+
+	// Name Полное название предложения
+	Name BidName `json:"name"`
+
+	// TenderId Уникальный идентификатор тендера, присвоенный сервером.
+	TenderId TenderId `json:"tenderId"`
+}
+
+// GetAuctionStandingParams defines parameters for GetAuctionStanding.
+type GetAuctionStandingParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// PlaceAuctionOfferJSONBody defines parameters for PlaceAuctionOffer.
+type PlaceAuctionOfferJSONBody struct {
+	// Price Цена в ставке
+	Price AuctionPrice `json:"price"`
+}
+
+// PlaceAuctionOfferParams defines parameters for PlaceAuctionOffer.
+type PlaceAuctionOfferParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// EditBidJSONBody defines parameters for EditBid.
+type EditBidJSONBody struct {
+	// Description Описание предложения
+	Description *BidDescription `json:"description,omitempty"`
+
+	// Name Полное название предложения
+	Name *BidName `json:"name,omitempty"`
+}
+
+// EditBidParams defines parameters for EditBid.
+type EditBidParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// SubmitBidFeedbackParams defines parameters for SubmitBidFeedback.
+type SubmitBidFeedbackParams struct {
+	BidFeedback BidFeedback `form:"bidFeedback" json:"bidFeedback"`
+	Username    Username    `form:"username" json:"username"`
+}
+
+// RollbackBidParams defines parameters for RollbackBid.
+type RollbackBidParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetBidStatusParams defines parameters for GetBidStatus.
+type GetBidStatusParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// UpdateBidStatusParams defines parameters for UpdateBidStatus.
+type UpdateBidStatusParams struct {
+	Status   BidStatus `form:"status" json:"status"`
+	Username Username  `form:"username" json:"username"`
+}
+
+// SubmitBidDecisionParams defines parameters for SubmitBidDecision.
+type SubmitBidDecisionParams struct {
+	Decision BidDecision `form:"decision" json:"decision"`
+	Username Username    `form:"username" json:"username"`
+}
+
+// GetBidsForTenderParams defines parameters for GetBidsForTender.
+type GetBidsForTenderParams struct {
+	Username Username `form:"username" json:"username"`
+
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetBidReviewsParams defines parameters for GetBidReviews.
+type GetBidReviewsParams struct {
+	// AuthorUsername Имя пользователя автора предложений, отзывы на которые нужно просмотреть.
+	AuthorUsername Username `form:"authorUsername" json:"authorUsername"`
+
+	// RequesterUsername Имя пользователя, который запрашивает отзывы.
+	RequesterUsername Username `form:"requesterUsername" json:"requesterUsername"`
+
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetSavedSearchesParams defines parameters for GetSavedSearches.
+type GetSavedSearchesParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// CreateSavedSearchJSONBody defines parameters for CreateSavedSearch.
+type CreateSavedSearchJSONBody struct {
+	// Filter Фильтр тендеров, как параметры списка тендеров. Фильтры объединяются по И, значения одного списочного фильтра - по ИЛИ.
+	Filter               *TenderFilter `json:"filter,omitempty"`
+	IncludeSubcategories *bool         `json:"includeSubcategories,omitempty"`
+
+	// Name Название сохраненного поиска
+	Name   SavedSearchName `json:"name"`
+	Notify *bool           `json:"notify,omitempty"`
+}
+
+// CreateSavedSearchParams defines parameters for CreateSavedSearch.
+type CreateSavedSearchParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// DeleteSavedSearchParams defines parameters for DeleteSavedSearch.
+type DeleteSavedSearchParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetSavedSearchParams defines parameters for GetSavedSearch.
+type GetSavedSearchParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// EditSavedSearchJSONBody defines parameters for EditSavedSearch.
+type EditSavedSearchJSONBody struct {
+	// Filter Фильтр тендеров, как параметры списка тендеров. Фильтры объединяются по И, значения одного списочного фильтра - по ИЛИ.
+	Filter               *TenderFilter `json:"filter,omitempty"`
+	IncludeSubcategories *bool         `json:"includeSubcategories,omitempty"`
+
+	// Name Название сохраненного поиска
+	Name   *SavedSearchName `json:"name,omitempty"`
+	Notify *bool            `json:"notify,omitempty"`
+}
+
+// EditSavedSearchParams defines parameters for EditSavedSearch.
+type EditSavedSearchParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// RunSavedSearchParams defines parameters for RunSavedSearch.
+type RunSavedSearchParams struct {
+	Username Username `form:"username" json:"username"`
+
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetServiceTypesParams defines parameters for GetServiceTypes.
+type GetServiceTypesParams struct {
+	// Locale Язык названий: ru или en. Без него язык выбирается по заголовку Accept-Language, а неподдерживаемый язык заменяется языком по умолчанию.
+	Locale *Locale `form:"locale,omitempty" json:"locale,omitempty"`
+}
+
+// CreateServiceTypeJSONBody defines parameters for CreateServiceType.
+type CreateServiceTypeJSONBody struct {
+	// Code Код вида услуги из справочника, к которой относиться тендер.
+	//
+	// Справочник иерархический: например, Roadworks входит в Construction.
+	Code TenderServiceType `json:"code"`
+
+	// Names Названия вида услуг по языкам. Поддерживаются языки ru и en.
+	Names ServiceTypeNames `json:"names"`
+
+	// ParentCode Код вида услуги из справочника, к которой относиться тендер.
+	//
+	// Справочник иерархический: например, Roadworks входит в Construction.
+	ParentCode *TenderServiceType `json:"parentCode,omitempty"`
+}
+
+// CreateServiceTypeParams defines parameters for CreateServiceType.
+type CreateServiceTypeParams struct {
+	Username Username `form:"username" json:"username"`
+
+	// Locale Язык названий: ru или en. Без него язык выбирается по заголовку Accept-Language, а неподдерживаемый язык заменяется языком по умолчанию.
+	Locale *Locale `form:"locale,omitempty" json:"locale,omitempty"`
+}
+
+// DeleteServiceTypeParams defines parameters for DeleteServiceType.
+type DeleteServiceTypeParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetServiceTypeParams defines parameters for GetServiceType.
+type GetServiceTypeParams struct {
+	// Locale Язык названий: ru или en. Без него язык выбирается по заголовку Accept-Language, а неподдерживаемый язык заменяется языком по умолчанию.
+	Locale *Locale `form:"locale,omitempty" json:"locale,omitempty"`
+}
+
+// EditServiceTypeJSONBody defines parameters for EditServiceType.
+type EditServiceTypeJSONBody struct {
+	// Names Названия вида услуг по языкам. Поддерживаются языки ru и en.
+	Names *ServiceTypeNames `json:"names,omitempty"`
+
+	// ParentCode Код вышестоящего вида услуг или пустая строка.
+	ParentCode *string `json:"parentCode,omitempty"`
+}
+
+// EditServiceTypeParams defines parameters for EditServiceType.
+type EditServiceTypeParams struct {
+	Username Username `form:"username" json:"username"`
+
+	// Locale Язык названий: ru или en. Без него язык выбирается по заголовку Accept-Language, а неподдерживаемый язык заменяется языком по умолчанию.
+	Locale *Locale `form:"locale,omitempty" json:"locale,omitempty"`
+}
+
+// GetTendersParams defines parameters for GetTenders.
+type GetTendersParams struct {
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// ServiceType Возвращенные тендеры должны соответствовать указанным видам услуг.
+	//
+	// Если список пустой, фильтры не применяются.
+	ServiceType *[]TenderServiceType `form:"service_type,omitempty" json:"service_type,omitempty"`
+
+	// IncludeSubcategories Включать тендеры подкатегорий указанных видов услуг.
+	IncludeSubcategories *bool `form:"include_subcategories,omitempty" json:"include_subcategories,omitempty"`
+
+	// OrganizationId Тендеры указанных организаций.
+	OrganizationId *[]OrganizationId `form:"organization_id,omitempty" json:"organization_id,omitempty"`
+
+	// OrganizationType Тендеры организаций указанных форм.
+	OrganizationType *[]GetTendersParamsOrganizationType `form:"organization_type,omitempty" json:"organization_type,omitempty"`
+
+	// Region Тендеры в указанных регионах.
+	Region *[]TenderRegion `form:"region,omitempty" json:"region,omitempty"`
+
+	// CreatedFrom Тендеры, созданные не раньше указанного времени (RFC3339).
+	CreatedFrom *time.Time `form:"created_from,omitempty" json:"created_from,omitempty"`
+
+	// CreatedTo Тендеры, созданные не позже указанного времени (RFC3339).
+	CreatedTo *time.Time `form:"created_to,omitempty" json:"created_to,omitempty"`
+
+	// DeadlineFrom Тендеры со сроком приема предложений не раньше указанного времени (RFC3339).
+	DeadlineFrom *time.Time `form:"deadline_from,omitempty" json:"deadline_from,omitempty"`
+
+	// DeadlineTo Тендеры со сроком приема предложений не позже указанного времени (RFC3339).
+	DeadlineTo *time.Time `form:"deadline_to,omitempty" json:"deadline_to,omitempty"`
+
+	// BudgetMin Тендеры с бюджетом не меньше указанного. Тендеры без бюджета не попадают в выборку.
+	BudgetMin *float64 `form:"budget_min,omitempty" json:"budget_min,omitempty"`
+
+	// BudgetMax Тендеры с бюджетом не больше указанного. Тендеры без бюджета не попадают в выборку.
+	BudgetMax *float64 `form:"budget_max,omitempty" json:"budget_max,omitempty"`
+
+	// Search Полнотекстовый поиск по названию и описанию: тендер должен содержать все слова запроса.
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
+
+	// Sort Порядок сортировки; минус перед полем означает сортировку по убыванию.
+	// Тендеры без срока или бюджета при сортировке по этим полям идут последними.
+	Sort *GetTendersParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// GetTendersParamsOrganizationType defines parameters for GetTenders.
+type GetTendersParamsOrganizationType string
+
+// GetTendersParamsSort defines parameters for GetTenders.
+type GetTendersParamsSort string
+
+// ImportTendersMultipartBody defines parameters for ImportTenders.
+type ImportTendersMultipartBody struct {
+	File openapi_types.File `json:"file"`
+}
+
+// ImportTendersParams defines parameters for ImportTenders.
+type ImportTendersParams struct {
+	Username Username `form:"username" json:"username"`
+
+	// DryRun Только проверить файл, ничего не создавая.
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+
+	// Filename Имя файла, по расширению которого определяется формат, если тело передано без multipart.
+	Filename *string `form:"filename,omitempty" json:"filename,omitempty"`
+}
+
+// GetUserTendersParams defines parameters for GetUserTenders.
+type GetUserTendersParams struct {
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	Offset   *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+	Username *Username         `form:"username,omitempty" json:"username,omitempty"`
+}
+
+// CreateTenderJSONBody defines parameters for CreateTender.
+type CreateTenderJSONBody struct {
+	// Budget Бюджет тендера в рублях.
+	Budget *TenderBudget `json:"budget,omitempty"`
+
+	// CreatorUsername Уникальный slug пользователя.
+	CreatorUsername Username `json:"creatorUsername"`
+
+	// Deadline Срок приема предложений в формате RFC3339.
+	Deadline *TenderDeadline `json:"deadline,omitempty"`
+
+	// Description Описание тендера
+	Description TenderDescription `json:"description"`
+
+	// Name Полное название тендера
+	Name TenderName `json:"name"`
+
+	// OrganizationId Уникальный идентификатор организации, присвоенный сервером.
+	OrganizationId OrganizationId `json:"organizationId"`
+
+	// Region Регион, в котором нужно оказать услугу.
+	Region *TenderRegion `json:"region,omitempty"`
+
+	// Rounds Число раундов закупки. Во втором и следующих раундах предложения подают
+	// только авторы, прошедшие в шорт-лист предыдущего раунда, а решения по предложениям
+	// принимаются только в последнем раунде.
+	Rounds *TenderRounds `json:"rounds,omitempty"`
+
+	// Sealed Запечатанный тендер: название и описание предложений хранятся зашифрованными
+	// и недоступны ответственным до срока приема, после срока все предложения вскрываются разом.
+	// Для запечатанного тендера срок обязателен и может только переноситься на более поздний,
+	// а раунд у него только один.
+	Sealed *TenderSealed `json:"sealed,omitempty"`
+
+	// ServiceType Код вида услуги из справочника, к которой относиться тендер.
+	//
+	// Справочник иерархический: например, Roadworks входит в Construction.
+	ServiceType TenderServiceType `json:"serviceType"`
+}
+
+// GetAuctionParams defines parameters for GetAuction.
+type GetAuctionParams struct {
+	Username *Username `form:"username,omitempty" json:"username,omitempty"`
+}
+
+// ConfigureAuctionJSONBody defines parameters for ConfigureAuction.
+type ConfigureAuctionJSONBody struct {
+	EndsAt time.Time `json:"endsAt"`
+
+	// ExtensionSeconds На сколько секунд ставка в последние секунды продлевает аукцион
+	ExtensionSeconds *AuctionExtensionSeconds `json:"extensionSeconds,omitempty"`
+
+	// MinDecrement Минимальный шаг снижения цены
+	MinDecrement AuctionMinDecrement `json:"minDecrement"`
+
+	// StartPrice Начальная цена; ставки выше нее не принимаются
+	StartPrice *AuctionStartPrice `json:"startPrice,omitempty"`
+	StartsAt   time.Time          `json:"startsAt"`
+}
+
+// ConfigureAuctionParams defines parameters for ConfigureAuction.
+type ConfigureAuctionParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetAuctionRankingParams defines parameters for GetAuctionRanking.
+type GetAuctionRankingParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetAuditEventsParams defines parameters for GetAuditEvents.
+type GetAuditEventsParams struct {
+	Username Username `form:"username" json:"username"`
+
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// EditTenderJSONBody defines parameters for EditTender.
+type EditTenderJSONBody struct {
+	// Budget Бюджет тендера в рублях.
+	Budget *TenderBudget `json:"budget,omitempty"`
+
+	// Deadline Срок приема предложений в формате RFC3339.
+	Deadline *TenderDeadline `json:"deadline,omitempty"`
+
+	// Description Описание тендера
+	Description *TenderDescription `json:"description,omitempty"`
+
+	// Name Полное название тендера
+	Name *TenderName `json:"name,omitempty"`
+
+	// Region Регион, в котором нужно оказать услугу.
+	Region *TenderRegion `json:"region,omitempty"`
+
+	// Rounds Число раундов закупки. Во втором и следующих раундах предложения подают
+	// только авторы, прошедшие в шорт-лист предыдущего раунда, а решения по предложениям
+	// принимаются только в последнем раунде.
+	Rounds *TenderRounds `json:"rounds,omitempty"`
+
+	// ServiceType Код вида услуги из справочника, к которой относиться тендер.
+	//
+	// Справочник иерархический: например, Roadworks входит в Construction.
+	ServiceType *TenderServiceType `json:"serviceType,omitempty"`
+}
+
+// EditTenderParams defines parameters for EditTender.
+type EditTenderParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// ExportTenderParams defines parameters for ExportTender.
+type ExportTenderParams struct {
+	Username Username `form:"username" json:"username"`
+
+	// Format Формат выгрузки.
+	Format *ExportTenderParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// ExportTenderParamsFormat defines parameters for ExportTender.
+type ExportTenderParamsFormat string
+
+// AdvanceTenderRoundParams defines parameters for AdvanceTenderRound.
+type AdvanceTenderRoundParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetTenderProtocolParams defines parameters for GetTenderProtocol.
+type GetTenderProtocolParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// RollbackTenderParams defines parameters for RollbackTender.
+type RollbackTenderParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetRoundBidsParams defines parameters for GetRoundBids.
+type GetRoundBidsParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetShortlistParams defines parameters for GetShortlist.
+type GetShortlistParams struct {
+	Username Username `form:"username" json:"username"`
+
+	// Round Номер раунда, по умолчанию - текущий.
+	Round *TenderRound `form:"round,omitempty" json:"round,omitempty"`
+}
+
+// RemoveFromShortlistParams defines parameters for RemoveFromShortlist.
+type RemoveFromShortlistParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// ShortlistBidParams defines parameters for ShortlistBid.
+type ShortlistBidParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetTenderStatusParams defines parameters for GetTenderStatus.
+type GetTenderStatusParams struct {
+	Username *Username `form:"username,omitempty" json:"username,omitempty"`
+}
+
+// UpdateTenderStatusParams defines parameters for UpdateTenderStatus.
+type UpdateTenderStatusParams struct {
+	Status   TenderStatus `form:"status" json:"status"`
+	Username Username     `form:"username" json:"username"`
+}
+
+// CreateBidJSONRequestBody defines body for CreateBid for application/json ContentType.
+type CreateBidJSONRequestBody CreateBidJSONBody
+
+// PlaceAuctionOfferJSONRequestBody defines body for PlaceAuctionOffer for application/json ContentType.
+type PlaceAuctionOfferJSONRequestBody PlaceAuctionOfferJSONBody
+
+// EditBidJSONRequestBody defines body for EditBid for application/json ContentType.
+type EditBidJSONRequestBody EditBidJSONBody
+
+// CreateSavedSearchJSONRequestBody defines body for CreateSavedSearch for application/json ContentType.
+type CreateSavedSearchJSONRequestBody CreateSavedSearchJSONBody
+
+// EditSavedSearchJSONRequestBody defines body for EditSavedSearch for application/json ContentType.
+type EditSavedSearchJSONRequestBody EditSavedSearchJSONBody
+
+// CreateServiceTypeJSONRequestBody defines body for CreateServiceType for application/json ContentType.
+type CreateServiceTypeJSONRequestBody CreateServiceTypeJSONBody
+
+// EditServiceTypeJSONRequestBody defines body for EditServiceType for application/json ContentType.
+type EditServiceTypeJSONRequestBody EditServiceTypeJSONBody
+
+// ImportTendersMultipartRequestBody defines body for ImportTenders for multipart/form-data ContentType.
+type ImportTendersMultipartRequestBody ImportTendersMultipartBody
+
+// CreateTenderJSONRequestBody defines body for CreateTender for application/json ContentType.
+type CreateTenderJSONRequestBody CreateTenderJSONBody
+
+// ConfigureAuctionJSONRequestBody defines body for ConfigureAuction for application/json ContentType.
+type ConfigureAuctionJSONRequestBody ConfigureAuctionJSONBody
+
+// EditTenderJSONRequestBody defines body for EditTender for application/json ContentType.
+type EditTenderJSONRequestBody EditTenderJSONBody
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Получение списка ваших предложений
+	// (GET /bids/my)
+	GetUserBids(w http.ResponseWriter, r *http.Request, params GetUserBidsParams)
+	// Создание нового предложения
+	// (POST /bids/new)
+	CreateBid(w http.ResponseWriter, r *http.Request)
+	// Место предложения в аукционе
+	// (GET /bids/{bidId}/auction)
+	GetAuctionStanding(w http.ResponseWriter, r *http.Request, bidId BidId, params GetAuctionStandingParams)
+	// Ставка в аукционе
+	// (POST /bids/{bidId}/auction)
+	PlaceAuctionOffer(w http.ResponseWriter, r *http.Request, bidId BidId, params PlaceAuctionOfferParams)
+	// Редактирование параметров предложения
+	// (PATCH /bids/{bidId}/edit)
+	EditBid(w http.ResponseWriter, r *http.Request, bidId BidId, params EditBidParams)
+	// Отправка отзыва по предложению
+	// (PUT /bids/{bidId}/feedback)
+	SubmitBidFeedback(w http.ResponseWriter, r *http.Request, bidId BidId, params SubmitBidFeedbackParams)
+	// Откат версии предложения
+	// (PUT /bids/{bidId}/rollback/{version})
+	RollbackBid(w http.ResponseWriter, r *http.Request, bidId BidId, version int32, params RollbackBidParams)
+	// Получение текущего статуса предложения
+	// (GET /bids/{bidId}/status)
+	GetBidStatus(w http.ResponseWriter, r *http.Request, bidId BidId, params GetBidStatusParams)
+	// Изменение статуса предложения
+	// (PUT /bids/{bidId}/status)
+	UpdateBidStatus(w http.ResponseWriter, r *http.Request, bidId BidId, params UpdateBidStatusParams)
+	// Отправка решения по предложению
+	// (PUT /bids/{bidId}/submit_decision)
+	SubmitBidDecision(w http.ResponseWriter, r *http.Request, bidId BidId, params SubmitBidDecisionParams)
+	// Получение списка предложений для тендера
+	// (GET /bids/{tenderId}/list)
+	GetBidsForTender(w http.ResponseWriter, r *http.Request, tenderId TenderId, params GetBidsForTenderParams)
+	// Просмотр отзывов на прошлые предложения
+	// (GET /bids/{tenderId}/reviews)
+	GetBidReviews(w http.ResponseWriter, r *http.Request, tenderId TenderId, params GetBidReviewsParams)
+	// Проверка доступности сервера
+	// (GET /ping)
+	CheckServer(w http.ResponseWriter, r *http.Request)
+	// Сохраненные поиски пользователя
+	// (GET /searches)
+	GetSavedSearches(w http.ResponseWriter, r *http.Request, params GetSavedSearchesParams)
+	// Сохранение поиска
+	// (POST /searches/new)
+	CreateSavedSearch(w http.ResponseWriter, r *http.Request, params CreateSavedSearchParams)
+	// Удаление сохраненного поиска
+	// (DELETE /searches/{searchId})
+	DeleteSavedSearch(w http.ResponseWriter, r *http.Request, searchId SavedSearchId, params DeleteSavedSearchParams)
+	// Получение сохраненного поиска
+	// (GET /searches/{searchId})
+	GetSavedSearch(w http.ResponseWriter, r *http.Request, searchId SavedSearchId, params GetSavedSearchParams)
+	// Редактирование сохраненного поиска
+	// (PATCH /searches/{searchId}/edit)
+	EditSavedSearch(w http.ResponseWriter, r *http.Request, searchId SavedSearchId, params EditSavedSearchParams)
+	// Тендеры по сохраненному поиску
+	// (GET /searches/{searchId}/tenders)
+	RunSavedSearch(w http.ResponseWriter, r *http.Request, searchId SavedSearchId, params RunSavedSearchParams)
+	// Справочник видов услуг
+	// (GET /service_types)
+	GetServiceTypes(w http.ResponseWriter, r *http.Request, params GetServiceTypesParams)
+	// Создание вида услуг
+	// (POST /service_types/new)
+	CreateServiceType(w http.ResponseWriter, r *http.Request, params CreateServiceTypeParams)
+	// Удаление вида услуг
+	// (DELETE /service_types/{code})
+	DeleteServiceType(w http.ResponseWriter, r *http.Request, code TenderServiceType, params DeleteServiceTypeParams)
+	// Получение вида услуг
+	// (GET /service_types/{code})
+	GetServiceType(w http.ResponseWriter, r *http.Request, code TenderServiceType, params GetServiceTypeParams)
+	// Редактирование вида услуг
+	// (PATCH /service_types/{code}/edit)
+	EditServiceType(w http.ResponseWriter, r *http.Request, code TenderServiceType, params EditServiceTypeParams)
+	// Получение списка тендеров
+	// (GET /tenders)
+	GetTenders(w http.ResponseWriter, r *http.Request, params GetTendersParams)
+	// Импорт тендеров из файла
+	// (POST /tenders/import)
+	ImportTenders(w http.ResponseWriter, r *http.Request, params ImportTendersParams)
+	// Получить тендеры пользователя
+	// (GET /tenders/my)
+	GetUserTenders(w http.ResponseWriter, r *http.Request, params GetUserTendersParams)
+	// Создание нового тендера
+	// (POST /tenders/new)
+	CreateTender(w http.ResponseWriter, r *http.Request)
+	// Настройки аукциона
+	// (GET /tenders/{tenderId}/auction)
+	GetAuction(w http.ResponseWriter, r *http.Request, tenderId TenderId, params GetAuctionParams)
+	// Настройка аукциона
+	// (PUT /tenders/{tenderId}/auction)
+	ConfigureAuction(w http.ResponseWriter, r *http.Request, tenderId TenderId, params ConfigureAuctionParams)
+	// Рейтинг аукциона
+	// (GET /tenders/{tenderId}/auction/ranking)
+	GetAuctionRanking(w http.ResponseWriter, r *http.Request, tenderId TenderId, params GetAuctionRankingParams)
+	// Журнал аудита тендера
+	// (GET /tenders/{tenderId}/audit)
+	GetAuditEvents(w http.ResponseWriter, r *http.Request, tenderId TenderId, params GetAuditEventsParams)
+	// Редактирование тендера
+	// (PATCH /tenders/{tenderId}/edit)
+	EditTender(w http.ResponseWriter, r *http.Request, tenderId TenderId, params EditTenderParams)
+	// Выгрузка тендера
+	// (GET /tenders/{tenderId}/export)
+	ExportTender(w http.ResponseWriter, r *http.Request, tenderId TenderId, params ExportTenderParams)
+	// Переход к следующему раунду
+	// (PUT /tenders/{tenderId}/next_round)
+	AdvanceTenderRound(w http.ResponseWriter, r *http.Request, tenderId TenderId, params AdvanceTenderRoundParams)
+	// Протокол закупки
+	// (GET /tenders/{tenderId}/protocol.pdf)
+	GetTenderProtocol(w http.ResponseWriter, r *http.Request, tenderId TenderId, params GetTenderProtocolParams)
+	// Откат версии тендера
+	// (PUT /tenders/{tenderId}/rollback/{version})
+	RollbackTender(w http.ResponseWriter, r *http.Request, tenderId TenderId, version int32, params RollbackTenderParams)
+	// Предложения раунда
+	// (GET /tenders/{tenderId}/rounds/{round}/bids)
+	GetRoundBids(w http.ResponseWriter, r *http.Request, tenderId TenderId, round TenderRound, params GetRoundBidsParams)
+	// Шорт-лист раунда
+	// (GET /tenders/{tenderId}/shortlist)
+	GetShortlist(w http.ResponseWriter, r *http.Request, tenderId TenderId, params GetShortlistParams)
+	// Удаление предложения из шорт-листа
+	// (DELETE /tenders/{tenderId}/shortlist/{bidId})
+	RemoveFromShortlist(w http.ResponseWriter, r *http.Request, tenderId TenderId, bidId BidId, params RemoveFromShortlistParams)
+	// Добавление предложения в шорт-лист
+	// (PUT /tenders/{tenderId}/shortlist/{bidId})
+	ShortlistBid(w http.ResponseWriter, r *http.Request, tenderId TenderId, bidId BidId, params ShortlistBidParams)
+	// Получение текущего статуса тендера
+	// (GET /tenders/{tenderId}/status)
+	GetTenderStatus(w http.ResponseWriter, r *http.Request, tenderId TenderId, params GetTenderStatusParams)
+	// Изменение статуса тендера
+	// (PUT /tenders/{tenderId}/status)
+	UpdateTenderStatus(w http.ResponseWriter, r *http.Request, tenderId TenderId, params UpdateTenderStatusParams)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetUserBids operation middleware
+func (siw *ServerInterfaceWrapper) GetUserBids(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUserBidsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUserBids(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateBid operation middleware
+func (siw *ServerInterfaceWrapper) CreateBid(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateBid(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAuctionStanding operation middleware
+func (siw *ServerInterfaceWrapper) GetAuctionStanding(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", mux.Vars(r)["bidId"], &bidId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "bidId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuctionStandingParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAuctionStanding(w, r, bidId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PlaceAuctionOffer operation middleware
+func (siw *ServerInterfaceWrapper) PlaceAuctionOffer(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", mux.Vars(r)["bidId"], &bidId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "bidId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PlaceAuctionOfferParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PlaceAuctionOffer(w, r, bidId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// EditBid operation middleware
+func (siw *ServerInterfaceWrapper) EditBid(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", mux.Vars(r)["bidId"], &bidId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "bidId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params EditBidParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.EditBid(w, r, bidId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SubmitBidFeedback operation middleware
+func (siw *ServerInterfaceWrapper) SubmitBidFeedback(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", mux.Vars(r)["bidId"], &bidId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "bidId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SubmitBidFeedbackParams
+
+	// ------------- Required query parameter "bidFeedback" -------------
+
+	if paramValue := r.URL.Query().Get("bidFeedback"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "bidFeedback"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "bidFeedback", r.URL.Query(), &params.BidFeedback)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "bidFeedback", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SubmitBidFeedback(w, r, bidId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RollbackBid operation middleware
+func (siw *ServerInterfaceWrapper) RollbackBid(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", mux.Vars(r)["bidId"], &bidId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "bidId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", mux.Vars(r)["version"], &version, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RollbackBidParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RollbackBid(w, r, bidId, version, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetBidStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetBidStatus(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", mux.Vars(r)["bidId"], &bidId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "bidId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBidStatusParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBidStatus(w, r, bidId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateBidStatus operation middleware
+func (siw *ServerInterfaceWrapper) UpdateBidStatus(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", mux.Vars(r)["bidId"], &bidId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "bidId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateBidStatusParams
+
+	// ------------- Required query parameter "status" -------------
+
+	if paramValue := r.URL.Query().Get("status"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "status"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateBidStatus(w, r, bidId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SubmitBidDecision operation middleware
+func (siw *ServerInterfaceWrapper) SubmitBidDecision(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", mux.Vars(r)["bidId"], &bidId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "bidId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SubmitBidDecisionParams
+
+	// ------------- Required query parameter "decision" -------------
+
+	if paramValue := r.URL.Query().Get("decision"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "decision"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "decision", r.URL.Query(), &params.Decision)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "decision", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SubmitBidDecision(w, r, bidId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetBidsForTender operation middleware
+func (siw *ServerInterfaceWrapper) GetBidsForTender(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", mux.Vars(r)["tenderId"], &tenderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenderId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBidsForTenderParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBidsForTender(w, r, tenderId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetBidReviews operation middleware
+func (siw *ServerInterfaceWrapper) GetBidReviews(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", mux.Vars(r)["tenderId"], &tenderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenderId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBidReviewsParams
+
+	// ------------- Required query parameter "authorUsername" -------------
+
+	if paramValue := r.URL.Query().Get("authorUsername"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "authorUsername"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "authorUsername", r.URL.Query(), &params.AuthorUsername)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "authorUsername", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "requesterUsername" -------------
+
+	if paramValue := r.URL.Query().Get("requesterUsername"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "requesterUsername"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "requesterUsername", r.URL.Query(), &params.RequesterUsername)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "requesterUsername", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBidReviews(w, r, tenderId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CheckServer operation middleware
+func (siw *ServerInterfaceWrapper) CheckServer(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CheckServer(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSavedSearches operation middleware
+func (siw *ServerInterfaceWrapper) GetSavedSearches(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSavedSearchesParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSavedSearches(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateSavedSearch operation middleware
+func (siw *ServerInterfaceWrapper) CreateSavedSearch(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateSavedSearchParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateSavedSearch(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteSavedSearch operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSavedSearch(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "searchId" -------------
+	var searchId SavedSearchId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "searchId", mux.Vars(r)["searchId"], &searchId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "searchId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteSavedSearchParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteSavedSearch(w, r, searchId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSavedSearch operation middleware
+func (siw *ServerInterfaceWrapper) GetSavedSearch(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "searchId" -------------
+	var searchId SavedSearchId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "searchId", mux.Vars(r)["searchId"], &searchId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "searchId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSavedSearchParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSavedSearch(w, r, searchId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// EditSavedSearch operation middleware
+func (siw *ServerInterfaceWrapper) EditSavedSearch(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "searchId" -------------
+	var searchId SavedSearchId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "searchId", mux.Vars(r)["searchId"], &searchId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "searchId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params EditSavedSearchParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.EditSavedSearch(w, r, searchId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RunSavedSearch operation middleware
+func (siw *ServerInterfaceWrapper) RunSavedSearch(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "searchId" -------------
+	var searchId SavedSearchId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "searchId", mux.Vars(r)["searchId"], &searchId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "searchId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RunSavedSearchParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RunSavedSearch(w, r, searchId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetServiceTypes operation middleware
+func (siw *ServerInterfaceWrapper) GetServiceTypes(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetServiceTypesParams
+
+	// ------------- Optional query parameter "locale" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "locale", r.URL.Query(), &params.Locale)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "locale", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetServiceTypes(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateServiceType operation middleware
+func (siw *ServerInterfaceWrapper) CreateServiceType(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateServiceTypeParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "locale" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "locale", r.URL.Query(), &params.Locale)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "locale", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateServiceType(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteServiceType operation middleware
+func (siw *ServerInterfaceWrapper) DeleteServiceType(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code TenderServiceType
+
+	err = runtime.BindStyledParameterWithOptions("simple", "code", mux.Vars(r)["code"], &code, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteServiceTypeParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteServiceType(w, r, code, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetServiceType operation middleware
+func (siw *ServerInterfaceWrapper) GetServiceType(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code TenderServiceType
+
+	err = runtime.BindStyledParameterWithOptions("simple", "code", mux.Vars(r)["code"], &code, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetServiceTypeParams
+
+	// ------------- Optional query parameter "locale" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "locale", r.URL.Query(), &params.Locale)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "locale", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetServiceType(w, r, code, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// EditServiceType operation middleware
+func (siw *ServerInterfaceWrapper) EditServiceType(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code TenderServiceType
+
+	err = runtime.BindStyledParameterWithOptions("simple", "code", mux.Vars(r)["code"], &code, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params EditServiceTypeParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "locale" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "locale", r.URL.Query(), &params.Locale)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "locale", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.EditServiceType(w, r, code, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTenders operation middleware
+func (siw *ServerInterfaceWrapper) GetTenders(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTendersParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "service_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "service_type", r.URL.Query(), &params.ServiceType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "service_type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "include_subcategories" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_subcategories", r.URL.Query(), &params.IncludeSubcategories)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_subcategories", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "organization_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "organization_id", r.URL.Query(), &params.OrganizationId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "organization_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "organization_type", r.URL.Query(), &params.OrganizationType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "region" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "region", r.URL.Query(), &params.Region)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "region", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "created_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_from", r.URL.Query(), &params.CreatedFrom)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "created_from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "created_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_to", r.URL.Query(), &params.CreatedTo)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "created_to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "deadline_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "deadline_from", r.URL.Query(), &params.DeadlineFrom)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deadline_from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "deadline_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "deadline_to", r.URL.Query(), &params.DeadlineTo)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deadline_to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "budget_min" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "budget_min", r.URL.Query(), &params.BudgetMin)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "budget_min", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "budget_max" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "budget_max", r.URL.Query(), &params.BudgetMax)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "budget_max", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTenders(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ImportTenders operation middleware
+func (siw *ServerInterfaceWrapper) ImportTenders(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportTendersParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dry_run", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "filename" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filename", r.URL.Query(), &params.Filename)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filename", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportTenders(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUserTenders operation middleware
+func (siw *ServerInterfaceWrapper) GetUserTenders(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUserTendersParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUserTenders(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateTender operation middleware
+func (siw *ServerInterfaceWrapper) CreateTender(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateTender(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAuction operation middleware
+func (siw *ServerInterfaceWrapper) GetAuction(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", mux.Vars(r)["tenderId"], &tenderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenderId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuctionParams
+
+	// ------------- Optional query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAuction(w, r, tenderId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ConfigureAuction operation middleware
+func (siw *ServerInterfaceWrapper) ConfigureAuction(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", mux.Vars(r)["tenderId"], &tenderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenderId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ConfigureAuctionParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ConfigureAuction(w, r, tenderId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAuctionRanking operation middleware
+func (siw *ServerInterfaceWrapper) GetAuctionRanking(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", mux.Vars(r)["tenderId"], &tenderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenderId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuctionRankingParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAuctionRanking(w, r, tenderId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAuditEvents operation middleware
+func (siw *ServerInterfaceWrapper) GetAuditEvents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", mux.Vars(r)["tenderId"], &tenderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenderId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuditEventsParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAuditEvents(w, r, tenderId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// EditTender operation middleware
+func (siw *ServerInterfaceWrapper) EditTender(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", mux.Vars(r)["tenderId"], &tenderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenderId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params EditTenderParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.EditTender(w, r, tenderId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExportTender operation middleware
+func (siw *ServerInterfaceWrapper) ExportTender(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", mux.Vars(r)["tenderId"], &tenderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenderId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportTenderParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportTender(w, r, tenderId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AdvanceTenderRound operation middleware
+func (siw *ServerInterfaceWrapper) AdvanceTenderRound(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", mux.Vars(r)["tenderId"], &tenderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenderId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AdvanceTenderRoundParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdvanceTenderRound(w, r, tenderId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTenderProtocol operation middleware
+func (siw *ServerInterfaceWrapper) GetTenderProtocol(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", mux.Vars(r)["tenderId"], &tenderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenderId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTenderProtocolParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTenderProtocol(w, r, tenderId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RollbackTender operation middleware
+func (siw *ServerInterfaceWrapper) RollbackTender(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", mux.Vars(r)["tenderId"], &tenderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenderId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", mux.Vars(r)["version"], &version, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RollbackTenderParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RollbackTender(w, r, tenderId, version, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRoundBids operation middleware
+func (siw *ServerInterfaceWrapper) GetRoundBids(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", mux.Vars(r)["tenderId"], &tenderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenderId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "round" -------------
+	var round TenderRound
+
+	err = runtime.BindStyledParameterWithOptions("simple", "round", mux.Vars(r)["round"], &round, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "round", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRoundBidsParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRoundBids(w, r, tenderId, round, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetShortlist operation middleware
+func (siw *ServerInterfaceWrapper) GetShortlist(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", mux.Vars(r)["tenderId"], &tenderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenderId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetShortlistParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "round", r.URL.Query(), &params.Round)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "round", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetShortlist(w, r, tenderId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RemoveFromShortlist operation middleware
+func (siw *ServerInterfaceWrapper) RemoveFromShortlist(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", mux.Vars(r)["tenderId"], &tenderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenderId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", mux.Vars(r)["bidId"], &bidId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "bidId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RemoveFromShortlistParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RemoveFromShortlist(w, r, tenderId, bidId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ShortlistBid operation middleware
+func (siw *ServerInterfaceWrapper) ShortlistBid(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", mux.Vars(r)["tenderId"], &tenderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenderId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", mux.Vars(r)["bidId"], &bidId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "bidId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ShortlistBidParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ShortlistBid(w, r, tenderId, bidId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTenderStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetTenderStatus(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", mux.Vars(r)["tenderId"], &tenderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenderId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTenderStatusParams
+
+	// ------------- Optional query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTenderStatus(w, r, tenderId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateTenderStatus operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTenderStatus(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", mux.Vars(r)["tenderId"], &tenderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenderId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateTenderStatusParams
+
+	// ------------- Required query parameter "status" -------------
+
+	if paramValue := r.URL.Query().Get("status"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "status"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateTenderStatus(w, r, tenderId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, GorillaServerOptions{})
+}
+
+type GorillaServerOptions struct {
+	BaseURL          string
+	BaseRouter       *mux.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r *mux.Router) http.Handler {
+	return HandlerWithOptions(si, GorillaServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r *mux.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, GorillaServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = mux.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.HandleFunc(options.BaseURL+"/bids/my", wrapper.GetUserBids).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/bids/new", wrapper.CreateBid).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/bids/{bidId}/auction", wrapper.GetAuctionStanding).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/bids/{bidId}/auction", wrapper.PlaceAuctionOffer).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/bids/{bidId}/edit", wrapper.EditBid).Methods("PATCH")
+
+	r.HandleFunc(options.BaseURL+"/bids/{bidId}/feedback", wrapper.SubmitBidFeedback).Methods("PUT")
+
+	r.HandleFunc(options.BaseURL+"/bids/{bidId}/rollback/{version}", wrapper.RollbackBid).Methods("PUT")
+
+	r.HandleFunc(options.BaseURL+"/bids/{bidId}/status", wrapper.GetBidStatus).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/bids/{bidId}/status", wrapper.UpdateBidStatus).Methods("PUT")
+
+	r.HandleFunc(options.BaseURL+"/bids/{bidId}/submit_decision", wrapper.SubmitBidDecision).Methods("PUT")
+
+	r.HandleFunc(options.BaseURL+"/bids/{tenderId}/list", wrapper.GetBidsForTender).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/bids/{tenderId}/reviews", wrapper.GetBidReviews).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/ping", wrapper.CheckServer).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/searches", wrapper.GetSavedSearches).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/searches/new", wrapper.CreateSavedSearch).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/searches/{searchId}", wrapper.DeleteSavedSearch).Methods("DELETE")
+
+	r.HandleFunc(options.BaseURL+"/searches/{searchId}", wrapper.GetSavedSearch).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/searches/{searchId}/edit", wrapper.EditSavedSearch).Methods("PATCH")
+
+	r.HandleFunc(options.BaseURL+"/searches/{searchId}/tenders", wrapper.RunSavedSearch).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/service_types", wrapper.GetServiceTypes).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/service_types/new", wrapper.CreateServiceType).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/service_types/{code}", wrapper.DeleteServiceType).Methods("DELETE")
+
+	r.HandleFunc(options.BaseURL+"/service_types/{code}", wrapper.GetServiceType).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/service_types/{code}/edit", wrapper.EditServiceType).Methods("PATCH")
+
+	r.HandleFunc(options.BaseURL+"/tenders", wrapper.GetTenders).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/tenders/import", wrapper.ImportTenders).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/tenders/my", wrapper.GetUserTenders).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/tenders/new", wrapper.CreateTender).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/tenders/{tenderId}/auction", wrapper.GetAuction).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/tenders/{tenderId}/auction", wrapper.ConfigureAuction).Methods("PUT")
+
+	r.HandleFunc(options.BaseURL+"/tenders/{tenderId}/auction/ranking", wrapper.GetAuctionRanking).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/tenders/{tenderId}/audit", wrapper.GetAuditEvents).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/tenders/{tenderId}/edit", wrapper.EditTender).Methods("PATCH")
+
+	r.HandleFunc(options.BaseURL+"/tenders/{tenderId}/export", wrapper.ExportTender).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/tenders/{tenderId}/next_round", wrapper.AdvanceTenderRound).Methods("PUT")
+
+	r.HandleFunc(options.BaseURL+"/tenders/{tenderId}/protocol.pdf", wrapper.GetTenderProtocol).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/tenders/{tenderId}/rollback/{version}", wrapper.RollbackTender).Methods("PUT")
+
+	r.HandleFunc(options.BaseURL+"/tenders/{tenderId}/rounds/{round}/bids", wrapper.GetRoundBids).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/tenders/{tenderId}/shortlist", wrapper.GetShortlist).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/tenders/{tenderId}/shortlist/{bidId}", wrapper.RemoveFromShortlist).Methods("DELETE")
+
+	r.HandleFunc(options.BaseURL+"/tenders/{tenderId}/shortlist/{bidId}", wrapper.ShortlistBid).Methods("PUT")
+
+	r.HandleFunc(options.BaseURL+"/tenders/{tenderId}/status", wrapper.GetTenderStatus).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/tenders/{tenderId}/status", wrapper.UpdateTenderStatus).Methods("PUT")
+
+	return r
+}
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/+x9bXMUR5rgX6mp2w8zEyWpBYjBIu4DBrznPc+YQPbExhofUeouQa9b1drqagxDKEIv",
+	"lsEjBnkdvlvHzGLGMxO392XjWo0aSi/d/IXMf7SRT75UZlZmVbXUSEL0F1tIVZWZTz7vrw/danNxqRkG",
+	"YdxyZx+6S37kLwZxEMG/Gs2q3wjIT7WgVY3qS3G9GbqzLvr/6BXeRHsO6qMOeoW6qIP6KEG7s07UdlCC",
+	"9lHiBOGkg/4V9dAr8lgPvUADB2/xF7t4E22jBK+gDurhNbyKtxz0Gg0c9Ap1yLNoHw1QF+3hdedKtRos",
+	"xRMf+eGdtn8n8BzUoZ98jQZoB+2gHl5BL1EC++ihA7yJdqWlyAcPUA/18ZZYiv8VDdABXRevowOyKn5E",
+	"D4OfTt4KXc+tkwP/SzuIHrieG/qLgTvL4eK5rerdYNEnAIofLJG/tOKoHt5xl5c9d8m/Uw99ArGP6ov1",
+	"2ADFf0cdtIdXUYIOUAft4yeojwao5+BHKMGrBAAO6qIBATCBE/6GHw9vOGiAtvHvUQ/t4TUCqEkH/YBX",
+	"ASLkQ6/wegrWHbSPtygcXuMVNMCr5A0Hr5KTE2An5B7x1yhBPbQ7eSu8FaKfAKhd8l/yAXIdLwkMMzvC",
+	"a/iJgw4sR4FXX+N1vIrX4I/6+fRj2GEOQJRBXgsW/HYjdmdnPHehGS36sTvr1sP4/DnXcxf9+/XF9qI7",
+	"O1Px3MV6SP9R8fhF1cM4uBNE2k19vLDQCkxX9UdyPnqiPQBGgh+hHpyqazhGCrI++es23qRgAvADPL4h",
+	"wCRotwpEBFi3jzojvUYLJJv0kEZQVkygzIPeMv8MMAy/XaUQywDwz6hHsQmQpIv6QKSog9fRHmx5gPoO",
+	"XgOoUILuuJ67FDWXgiiuB/D1IKy1rphu50e4lD4nXXJLBEIEDgeo48A1dQBxCedx8FdogFfIn8iCzs0P",
+	"rp4/f/69SdfTqdhzg/txELbqzXAuqDbDGmzj76JgwZ11/9tUyjqnGAymGACu668tAxivBdUoWAzCuORn",
+	"fi2/QiAd+1F8I6pXg5IfmEtf4K+bIfhMoOBgZLCLg7AWRB/WivYqniPIFAX/0q5HQc2d/Sz9gAY86SQe",
+	"RwrDVX0u9tSc/+egChC03Y9GA1noOHiVUj5+Qv5P/kmofZ2gawqkPSKZuiBQgMH10A5DSPl5vMk5wQ48",
+	"06UyUKMGN4+rnb9YKeBrnmtCIpMQSlBf5ttUej4mHIXsmvwNOD9KiNz8mvyINwHe1Ua7Vb8X/JrvIo7a",
+	"gbTpWrM9D1LSsM2wvTiv7PLjhYUgMmzvT3gdPyK7wVsqmMnvUQd+RbbIII9XCO8DWdNHL1BPg6mBqfjt",
+	"+G6zBJbO12tX+KPLnjtfr5V6hz5djQI/DmpG0vseSKnjoMQBqUokvHLWZEi6WxqCQQjeEPnhF0bsoEJu",
+	"YIC3xyUXlTtkz860fN3TRmkrUzisyoHppXfBDyFDLoecBUvUdv9/AW8pYqTw7I0ceediP6wR6OcBEEi+",
+	"ByQ/kCgKdXUc7WVwdDhkG4mYxKv0xnt4jenIEsMCXX9YrPSjuF6tL/nM0tD29h9CJcwgGlVy0CDd4B5o",
+	"9IlbxAHfDCWYL9JIDZediueQF8EiUmXpa9unnpI3viG30yfgdwv1V5miOCkxylKgLlAjh5TmFBXDpiXg",
+	"J/yQX1MSu6wzLLyJH7MjsP9xZGPCBj+leu0oibFWj6/fM4u5fyNaM8Exooa/xOt4BfWpyk3JbwclwIUL",
+	"VFDfpt9+D3KH2gMJ6k0679drrU/DVuA3gpozYaV+0OZRD1B+DeiSGE5gKys7ISBdRXt4BUyJTUnFIJAn",
+	"pAkSUKJnagAE9/3FJWLDu/KGTAQ6vIxCO/Kh8daQHKEWxH69QcFaq9XJWn7jhgRuigXaVp4TLgQH3iag",
+	"ooZlZiuuAcODxaVG80EQUFaqffYnNMBreAUwAdiO51D7CmyWx8Rmgf/uakuRq0bPALtRj12x8GhItK+/",
+	"BSRC7TxYbx80RDC5E7w6SXW9j4LwTnzXnZ2uVAzwq5vO8VcunSVlDiWwep9ACn9F/0w4GV7h+Ed4b1Ji",
+	"UY3Z1EFoU5IoEtXzxu3+gPoSxnxNscjMF1Eio7OsuLkXp/0Ll2YWKhPBuffmJy5M1y5M+L+avjhx4cLF",
+	"izMzFy5UKpWKUC8+oTv7tBVEyqZn3XOVysWJyvRE5dwn0zOzlQuzlZl/qvxqFt4lu3dnZirBpQuVonWY",
+	"nY2+RwOJKwJvIQjVAeztOuhbEKbEbdJDu4RnN9thDdQmRqWzC36jFYC5E7db7qx7le7W9dx7QdQCGE4v",
+	"j0ijlaFT8kV4mLCO5uJiPS5j2M7Xa1fThwu4juSDYuKGEJeJEXUdcC1RL9+aR500L8jTTupLYcAnbHWf",
+	"SIEBXgM86wBF7puRDmRXh/KCV+SD9LeTt0L0PEvwORxQ48Z5uGbglBJcCgF8TXpasIlSmiNF3MKHf0Me",
+	"Wxb4Wvj8TXhuOUXrwjfm6IPLKeoXv0IfPJTfQSKnwnV+y5408kIAoHpf4gie7M9I0V4hPMUEouAVUEs3",
+	"aWGvVyS6P6JU6KAu/ZHrFCZ1l/yeCA6iz5Lf0s8KMdYDNncwqaB9SQ5aKPtUFpQ98F9Qgl4r5wDtl6iO",
+	"n7kfR3f8sP47n90PSILPzYtcVTibusjc/7gycW7mIqH5u8F94CdaQIQwhMQhHl8AlPidBaJkm8BkeszK",
+	"6lNXfx+vwy+IxnDgoG3UARcHAe6tEDRbhQUxP7FhEbTLBX6xwnlZVjFl5ZMdAQ2ocn+A1ynzBV/3rZAZ",
+	"ivT+E+L29hz8iLoQVsGC5NEaGgygpgGPz+yTJfETnVu+t3DpYq1yafrSpQvVX9Uuzrznn1sIfL9SnZnx",
+	"a5XpGf/8/MKFhen5c/OV+UvnzlVr0zO1i9XpmfnKQqXiVy655tu9FlTrLau/Gj9mUOvlGWsSXl1ZWoqa",
+	"94BUbwaEMIOaDa+uqRw9Y6hLCIN65pW3VDqZsdHJB0FQm/erJpv2R7wGYbAuFXJmAZghR8s6I2E8p5TX",
+	"/IYJRoMtss8jXQrll7w064I3g3v14Mv8KyunJpdVcNV1rjQazp1ms9ms/exnP/vZUPpvRh89TSreoAzG",
+	"n2bljiLGYVQ8+uaHNbPmoqoshaZcdhuj4WJ29iK2f3QmI5AAfNgnzFpuciVaxJ+mvaww6vBIkyKjPSCL",
+	"PTjQgO7TYUkRHWq2/IGSkoi8WrWPSTcv8jtt8q6merrRnZKV9KVdYAOIn9scWxoQJN/SfLPZCPyQb06Y",
+	"DvrmyDJ4jWQl2JGSC/XU5L7Rnm/UW3fh56t+WA0advn+29SeyLnXZ5Sh4RXphlDCFa8et0mZy3j4Gwqi",
+	"qBndDFpLzbBlkl9FqQZqqoe4sAF4wrapp9fMe5+qQigK/BYseatdqZyv0nQJvAXhRcZ0PXrsR1yllNIc",
+	"bItsCeURrg6vSZkWZAUCuW0gjg7qw8pBVjrxrRWzL+XYEJIg8NkGTCc6wG4qHXr0aoRuVuRIY5swMdv6",
+	"4lIzim8G5L9mfYBGaeDIDhAbgeMKyCeFUgaom/Fn16IHN9um0/8r3iToB2GLP1DtfU0KfwuyYVeEDqj/",
+	"1EiL9AgWPqEE1bXtqm6XgWvCcev9PQe+zuIxHlPhZV65q0CLioU94AOAlCZZHTW/BLDV42Cx0CnBbq75",
+	"pbssPuVHkf8gc/3sFiRIsaVyEKL5pSXLhhDyPn5CeZyjmGN7LAjF2GpCELaDdmkURMacjkq+wEdaZKfX",
+	"Ar/WqIeBs9huxc584PhOzY8DstGI7Ohchr74uwbM1chJ7Iu41P8395snYAETDPe4qKBKN82foj6Vy0xA",
+	"OLXowe2oHTqEg/CkQyWa5tRrRLMUCo7nwH0nqpXdI0JFXHI2s0W5THb0h3bursAcdVOw9zw935GEBifk",
+	"FzoQU8/iPT14OR9Xlt80vzTiVlPyjIxI1VpBLxgLfcWS05ITVrpa/r2AaC5R9a4lELQBoqMv7Qywjmx4",
+	"r5inHibZQ/YuDx1IW6g34rKo8AF9tpTRIAGKeknrYbXRrgVz7fmqHwd3mhGXIuohvyNsFD+lCh1+okCM",
+	"hS7RDsMSIFG8whIKAJWA8a8T+sfr6AVBr1cO4BVwNebLy0qZMs5r6UTciR024/rCA6v8WCVykJ2DB5BB",
+	"CUUDdhC8Af/dwt8Ar1KVU7xh2GuO55hdpQXUYrdFFpp6c0cmY7yqEwVzGaZkceKmlH61xuwFxUFT6lSl",
+	"lg6ie/VqYHFFf0cAm0Xo1VS1x4/4dWium2aNLHSz6de+bEZftIYIV8ohyBXqnCVizUl1YrzJHmOptdpC",
+	"UbvwdZrUE4TxVbrPq82wFUcsAzjrBWrWCsmTsqg5CZzL3vGz07Ac+lAHUmqmPBY4dJCWF/RsCxRqjxJS",
+	"/Qae18A9NCQ1rgP3IfgO3VIhW9H3ZE3deFhMNXnghTsDhtRRKOe1VENCcsAmHZoPotaA8NSi9NGE1qWQ",
+	"mhRNsw11xOWo/xPTwBLuTeTJ/u6yCTJ3m1HcqLfi62Ec2aSJIbbcJZm2oHNPQFHBKjXbVrjD5+1JWAXB",
+	"vQ0MbR/1DkV4uQk6P+RLJyV3B4RRuh+au8OMgdelL6JUEk6pGDilRhYGtyTsGQK/EjyKKDO1Bsol2Cha",
+	"Sm8UEQP0DK/zepcdKfElEf4YNGB3RO0cBnjmaoKaGrBAExLPZGqiwxK9qNG+hnp4g91wMvKcHLLkH4EJ",
+	"dVAfPyE5e/9OHkZ75O9Keg781DJm6siqgHstaNTv0dqb4VJ45tu1O0FcDq3ep8+erlwaGb9ORwYN9VuU",
+	"Ayn3cgwZnuEvDxmbkfNQyhgx9Hluv2RN9rx3taeBF90pfbSb9NlDsb2Uakq/1CqfM8T1HZE2pKrkQyud",
+	"5dKO2Ksi86hkFhF97RCJRNIepbQi7U4FoHMyiMqJk/cFF8o4h5+iHeZyz6QpO8DltwlbxxuKujU9Q5hx",
+	"ZejEbo0oTQGdFZZTL0WJbAkweSrJIblLlvCLwwmZRPPCXA7FgZNd4G+phyTjowIu3gEIEVFHC6LJk3gT",
+	"LFFu7WZenHTk7+JNGlj5PcA1IRk7Qtem/vUfwKFJCyHSeBELkLxAg3Q1avWyXyreHWeCfwz9Cf2gsf2H",
+	"bot57lwqsqCiZU2lDnBVK1r95xYR+2v/PvnHkAjJ3q2Hh3iXEd4HUXNRfduPg4m4DmRvy83/pFn+FS7x",
+	"hluIvzXcSq1qYKt/+olFFBKKT6knDSrut2kUXmRSZb16KnMrH3zJCjrdaS8/wZBG+jYP/3543fXcjz66",
+	"6nruP8xdNYZ79Q9TiVp+q7ps1b/Xsruqacyg40mBLfDTpOXnhGS7LJzQg3tYw0+4oqXlLCW8dYOasYgS",
+	"lTsVOsGGPbomezPnN0c+afIVc+PJKLZHIwzGng7OhH7onhTrZ1JXTs4ViiOnegMKLFsl6Eicr3rKx0l6",
+	"WSXdc8h0uDx5l7fWTaGfGmKdL2jJpDEPpp/aoxBHQ68Y80kdSnhdhRL6CzWJSCYGhqyBIfZZIo9Higiu",
+	"0XJwSKt4gQaKy8eQ4DJk4oeiROfvSSq8TLcA5SmvoKfHOnpNKGrSQd9BPxIZxCSaTKvc8Tp+ygMg0knw",
+	"BkVXQ9YPS1Mi+sOtUM0tEFnbeNNzJO/qDn5sddaIdfAm2rHAFRrHkKfwY2Uflj2iA5bNnKlZ1HIhMgX/",
+	"PXQgL9yjOkxOj5Jy9ylnW7H7ZG4HY7GjkuNNeYSEV7NZEs1y/p5Ni+ZxE7zFIALU9ZgwLy7o2aoHKLkV",
+	"ki/34TPgfQGkAtE0AJ8qtf3hJ/5WbgaYZyt/JGnq9kQzkcSuOolp1j3Lpv9earJSpiyTr04V4y30intF",
+	"EDTLSeTsJC2Dhjk/oIhRlssQV9im0o3lnoPjBCDv3QpRR0IuJdNBXWBAdXSKfFnNKit7TW1vBmjH5IpH",
+	"SV4Yi+gjmVybAV7LnFUGJu87lPkeWQmAjVfwBm+8g/ZovylAYooawFk9R0SxyIVvUCgQ/tB1ZItA9yZp",
+	"MQDFGCOBlzgOIgKS//XZlYl/8id+9zn7f2XivdsTn//y7+xmYak0RBkQxcmHjWbLknqouhZONvuw3Qoi",
+	"SyzNpAK1Gu071kw/VU7HQSu+3aYFnLouBmkKC83soldufMi9zXhdnCyNVaiETfgWkK9FNBCJCAj7IyQx",
+	"DlhLo56DvwLCpO0cEgdW7UoJEU/xmmH9jIIE6/9cd5p6QHXUN6smThKyxI/Sj0lG/S9AUJuWtB9uVEsz",
+	"5lOP4d4+Aex0fu2H/h3oR0PAI3vC3elJKCBoLgWhv1R3Z93zk5XJaWhkEN8FIiIxqtbUIsTYzN6p57lb",
+	"sko0XSOzouKtUMgJFs7YZtKL+NLxqv5iGtDkWMC6kqjt6+jetEZeCQUgcVwID6/790FMatZIQb/rKS37",
+	"PjObW+kjU3pXumVviFdYezTyjqmxmCB4ubVYnvknXlheJgmELFEZrvlcpeJC1kAYs+I7f2mpUa/CPqb+",
+	"maV8psuUMjdJDXo2FzMbi/6J+6jQng1dbNjhgaDL2KQdJZPMAcb3lYiPrU+SbV2oTA915LyTqpnfpjM+",
+	"t4Vy+lSnAkKgeE0zw4VzgJD/HpxvhXW866H+JJjBrfbioh89SL9vp8Iu0xc3LBCG71FiD2kl1FKzFVtK",
+	"DSQ+ZdX+GOvXToafCoLPWl0q2VFh/D7454nDPmjF7zdrD4a6s5Ou1D9SJfmQ5eFH7v9mDIJIRdTmwuls",
+	"PMOA/d8LigRXBY1Iv7CagpOuvDPSl2T5iPyqkE2ZSNaYugBK+Wswavu8yFakzE86aifP1HfU4f1SqT4y",
+	"XFpvbrrVmWBm5ATnj/EEz1LrmIEZwiNCBU9rcqS2MRJvU7rfsP1fOMb9/0WN9zMH4C7aMQqHDM8uJkFJ",
+	"HjyEnJnlKanbqFkT/JY7kuwuAZQw+5A7VKEWnXf6StivSU+ufdqJkIgMqdkVMb+3ockxbYVF0yWI/r9O",
+	"rXvW19akxF3RusdldDlQsojmm+pYor+Xwo288pyF8tlCBe5w3x+hQleuuykFnAkhD9N4b8y53kHOZZaq",
+	"HMoKhpTgbIdBO3Jmm3IrN5Yt7soy6aQ9L9MO1B2pLrLPWo/x7nxyQz9aEsebcTyBRxSzeFdwQfiH0vKP",
+	"uNrwGjngNnHswqoHOb1lL7+Zrrkme/lGw68GV+Q+s2eP047CHDlEu0xNY6dfKKWBczyVkeh41ew3LEUq",
+	"x8jDNHOmJ+diUtImcOZpT5JTAm8KTtdnsXQ9JgM2MglewPm3ZZ8WOmBnHUvMscQsLzHJft87xv1+a9gR",
+	"GLcpbvA+tlKn2oEcPHotXQ+80kEH2lGJy0g3czQBl5H7GZMmqNFJIUt+XL1rSXrYgbi86ltMUC/PtWX3",
+	"aqiS8nqtHlMH11g+ZuXj8TnPlstIUJY9L6appFEdNceTZnOkpfQD0P82tERNtCvYDmutq1bymDEIYiGi",
+	"Vl/9oGj7LGX599GAVdyTADXPskmT/6kpnYk0sWkmb4kLTtv+gPrL9EE+rGWHAuk+eBcgs1Yt2nk6VirG",
+	"SsW7qFTo6gMaZKRsnkw088KyDsYFqXXiUtvSficlC1bplraYs3eNzIreufb8Ighf0a/xxIXwvLKZQy8h",
+	"vvHW+x1tUuHHMleekRJyzdy+xDWOk8crHUBtrbQs+xzz5zF/LsOfZR4JlpDUfzGPYAwMOWo2GoSVTD1k",
+	"KUPL+ayZhiwTXnOilz7ZfDp7JCK6x2uBuWdUSpKbdNB/spbGoH6vyQWsPJa160jHHqBdlrX6B9q6Ga9L",
+	"XySvJRA1ojVNPOy6kjbly0qMmwwYx26wlcojNOaAyjn5mesxtmJ1PdNR0jpG+2GGylY8q5KpnL2S3kVH",
+	"sleKcFMoWp0TEFzP+DxJHlSQajrVGZkdIQpEXp/GB8bybCzPNCemgv26jMObRhm3R3FPzaUuaWmk9eZF",
+	"Oa2UW3Kw5/SwZaKVIr2e4cMlkDXHB69nBc7fB8Q+meN15+NkhbLHYCCz5c3Q8GqCdstc65hZjZnVEMq3",
+	"IQ03U3ooIZ01s4Cc2axn/yCcnSfImj5dqtHc3NPCnUR3jkN/XTCNM6qaFndhz/erj5XOMR9/d/j4D3rt",
+	"VVm2nVU1wdF8uybNPirr25Zrp1HP+TkasPInNukp7R8htVKHv/zikM5wMaHpxBl6Ld3Job8vTnNmmXrp",
+	"sVnF3nA0YAR6nBxe2395nzgajBn6mKEfwSteui+F4hfn1VDLU6T57DA1seaqO5h63MVb3PMNiSIwC172",
+	"h0ODBq1VGPTiHXLGNTT+ULrH5Y39AaLbkTo38GyREjMXL1s6Rqh9KpUGEcLxbq7AJdW3HzQjWtJcSjxJ",
+	"lWuH4+1yodybFx/e8dQSv2WFv0eq7x3bKmPR9nZW9DHYvh6lH6pEUwYGIlVKmOVfBPMBc1zoP1paDdGZ",
+	"uMZRNfipov29liddoV4atiTtqfZpnpyIbYsiGIsHTCrm0dr37coVtfax5Ax7ytWuU4F1k8HoBKRVxml4",
+	"wJQc81y3olInzog1WGtdEKVwszanjN6eiC5rYpTWkn86WmE6FAQyKCE4NnRNSIufJBjYTsOSn4NRH+gs",
+	"aQeUMobXEQT4aWJhDsFnMkpeKK3uxkH0sY5wNnQEjSsXhs6fq5zZTlOSlM3xdS6xPsRmJQCytvCag/8A",
+	"+yYgTUiMSWvcZJiFqs9RJGTAhkf02Q0mahPWjjyFlDhqN9P+xxJt4U3WSew/AClFm44BesEo7IBW8K7z",
+	"kqWXaId/h9ZWdCn1Sh41+EWadO/Q6gxKIge87pcORMziFgsSwpl4sQhe5SVSzCLvmuziq3eD6hekpSCY",
+	"xAWcOw7ux1NLDb+uoWTa5635hbnBm322hXQtZaHvOeSoKe52qacCYHOLbNv5+H/ecklvSILt+2iQPrYm",
+	"CsaIPH7JqluMBM3Z7Drs4Jbb/AK+SUh2hkIm71CwxkhOtqbGDUgb15lKRRDvPn4K1RhE9Ybswxcsc3GP",
+	"OnlQzzlXqZhpWBBHx1EbfVrIg9Ir7W4d5Cju35HXDNPaGCsQs9qS7PzYvHaCGQV5Lp0gF9h05FPsri+l",
+	"6sizL4dVdkw3gDfkG4BGJ2euk9lPxYhnwzMVxYsbmSnrAB9WJyOYEXyHDu+lwUHih/3JoXMi/ztBxZyp",
+	"PWnzxg63cPmAy4E6+GeAXtOhHpCIoXm8GDTVqjf2t7wXVeerNExT1NyiHQUCRpEDXdkk2j0hyh1Fgekh",
+	"B7lap7KqTaLfyMjU/CVMDd2O2p8tnc15vO0iFNZpYUNsQrDGKMe1m2eK/ycq99f0mKmHLTZ3d5lSSCOI",
+	"gchUtnUNfl/MtlSnHP/0obmWNtL5ZNIQLlgClIx61qnj80xVml045hNQUGbMb8WRD12GWTOll1Tkpjo/",
+	"pGCaFYenGQr5a3pjQ0xWXva4wp+nir9DdHF8sqpoyP2Y7t4KujMG1cpQnkVgFbafyWYhWgrsJSXNOY7+",
+	"IJcVO4H6QeABvCVeBgcWt0MOzM1v3hnGc/rMlTdgnhjMkWLT4xn1eaKehq94qwSyn1KL5MQz58f2yFiS",
+	"5Umy/L5mR5JplP/kJYZYXVTqUBY6jSz1UuEtqNrrCU/VaoFWlU5h7crZL73s1NVsrX87fBdE01nKKqB4",
+	"N7yXPePipSIGr+AttEPQxaPqzY7IJDhgQ41TT/G4B+mYxZ82Fv8XmZPCa2bOLj4K28PrnLPDnLrbMZ8v",
+	"mxcrpG39yWxrMbAub1wdDMnI/F4NYgJcYLwgOqDbM4R7TFYF8WbIo3GHHdjUaFb9RnBMPKuVN4m3gHFR",
+	"oAPLSsFucKYaAG1603DtBfGz76GdojbhLDu6kEtffRuTDvpeuvA+Tc/a4U3M4Q8rvAYZ+r+KcDYUGtgG",
+	"Bs0pU/uPOTTlDYdfo7AKq81acKi5z2TLw2Dob+D5ZYBpEMZXD7euFqKC3fO9HDFUlcW9Y7YPlXNmd/4d",
+	"2Z9MGfIkn7EGMc5dPPwJ7JwzwzWPXfX5jk6/oOltzIjbZdSqyIlT0LrcQKIO+ET3SMoHTdHYARMAUt17",
+	"Dj0XSW0vGrmU5U4mkfuQMEQtkpkZ5qpGfwxf9mBMsdwMDhhkX9RVgGVDcegFPJHQWSQZy3jkQpoFYguF",
+	"tGpcMzFxlMoITQqdlkBsFuPOYjx2zCgPw3xOA0f8q1mnZ2zPxkwSjhWqQ68wkm3kkjlx61PARo7Jnhyl",
+	"4nlacbso2jqUDD1McLWbUVVgCJcJu7UyeLRr2t3oxScETd8B4XkChvSI7GENy/5IE4ZNmGUxWiV/4Dor",
+	"HthyGKoMeNRz0b//URDeie+6szOVbNHGGwm8GvH7eIaR3ArRcwYNUiyRQpxWZe3zhOqsUQEaCXyLnJVk",
+	"az8DSxc+xk1frZ+FlM0AViyrf3qZTuGXXp8QGht9+rhHpwztdRgHp8fq9LupTvNmw2+RQ+IZg/qWadvw",
+	"a005YQOlgKJYXaUDI9D20P5wo1xsylZhgF+t2LZF+0mRox57xat8cBNtA8FK2fAT/FQNuEK7CFYIxHaK",
+	"1xUHiKm/BEq8WyGQ0ws6Ew6vQ/0gbZN0AFrdNn6KdmgHCvgrr9QcsD6urEjTkBLBxlerjWrIlE0QX39L",
+	"d4836RX9HqCfcDFDhSAc6AfPIJMHMKZ1wHrIchjjR+kvFQg5E/xj6E/oh0lpbhj+St1Ln6oALMQNaRfa",
+	"9Kx0e11aHKhadeaOTZ8wPBk2AjeSlAPP4GiRj9SzpJxIc3xJPNMgYTgbxE+0HgPQpYtTDXgIhZqkjG3D",
+	"qzJ9vE7VGs98MzQsLOkjaZcsYydcyRZyPVOd72fu1WbYiiM2zt0jPrn6PfKRz71hci3mcqOXphvYI5Fq",
+	"/IiDby0Tojb6JXUw4w0OZi34aYUJy4G83VKSIGXgFFdWPcwpxsebxk2aWNCurW1IM7rjh/XfARbfrteU",
+	"3ZW6FPkDH9ZK3Yh6BPN+TSfjylmps2QQUZwmCMl4kM/cD6+7nvvRR1ddz/2Huavu5xl7ZvijdI3bllg/",
+	"6uANeweXO5QwDpWCdJO+PfSmPSUeKOnHDs3Y4BPCjY1Nuum0EJQ4P7/5wdXz58+/9wvbCasQKq/dXoia",
+	"i65xigvp7z0R18Gaz1iXhz0IkPgr9HLUx4ibIz8EnCFtXQhZX0p3REsHrTdzX7XArzXqYfBmLuwoZx31",
+	"lYqTvpk7VRQ8elLWdLaXe2OTjvYp7q2QP9eRQPKa6lPUf9AF7Z2Yo3iF5FjZDj/frt0J4tuL9dBy9mZ7",
+	"vhHIs5VS30/YXpwPoiMBYZuaeqcECP79kQLhuVmR13KG4UfdJfTU0OL06axWT8/1RpK5BvwPfo9eMmWH",
+	"a86rQEQwik1p+JSn0LEc5BQWkvdvulIpQwfPRUaraC2hmSmXHWrIswEB3G/HLSDIwxsIq6TDepvo32HJ",
+	"hAR9tllfV9pznPVzyeCO3H2W2ecaNgEnMm25x5YiE96SNE1wi/wIBmHaRJDsn1hPRI9OckDdjGKzWugy",
+	"xzZXWdg/J9j/mSC6Qt6ekP/B2Rn5vfQzxXHyS/ZTVud5+7KmM1nSeho1Q4wDuKZHgrgmzE1czaT49rdt",
+	"G6pRpw5oxQUzVV9cIhib2+NEyULJ3huk6X4FPq591HGuzv2Wn+EfP5r7R9Jkmrnx9CiE/NYEBQPJUd5n",
+	"3pE9kDN77Dd98gahPYcQjOdI2/QcyYPtOaoV4zmcaDyHUornUO2csOSo2Q5rrctCr6fbE7iYbmdPKKC0",
+	"cRTx5YFN/kf45072cHL/MammkReUpDxJAm+itwUlnWFq0YPbUTukrWHkhkwOXmOe1T3qwzLNK8drBDHw",
+	"mkdnAoI/SLeb013obhrUBcQjkq4n+ZUT6ktKUN/kufkQcMrqvDmGwF9Ge5HAJF0LH5LB0NCDo+FHnPRE",
+	"hIBeECCwVemkl3RUjwDtMZrSBWd55E5XSR9RQFSmUWSyswZCzabJ7RznZHYjtRijjnjKZJQYG5esi+1G",
+	"XF/yo9h26oV6I2DXlh7bKIXKhFfvhbXJ5lIQ3l9sUFWtNdFcWKhXg1qz2l4MwniytRQFfq11NwjixcYk",
+	"/F9l00LFm6+HPuw0G9cUp5oiT0/U/NgvqJkNyn5azkuG9wz5yB5to1dt3VNXLe6a9zeKFSyjUGIToJI4",
+	"6DvHcDSBTOotC3bUFeqZQ3Z8vPnOVPrcDMh/bdOuKfvi5Scpg0UH4miHbMXqhw8+XrD6ky3S3RvmRJ8P",
+	"rQxIIlEEm2gk70BKmSKNNRO0DbEBKYAu/Vrr4UlLKFLg4Q3PKDAoTgihARIY1BOp2yR1U0eB32qGZyUe",
+	"On2c8dCUjsm+8GPuMEmN5wFx8mbmSR0wdXitUAlTdbzFB8OMGclVHrOzCK0NG0E7+h7t007KdOoU3Aqg",
+	"Nl7VX+RBqq7w8fdYJ1nQNbgtTFUr0M2oPgNROUsAifTPPukgUoG6czq7To7MqLN3Sz/8UI6z1KosMybY",
+	"ENGytqnkBF7YpVKtI5BLnrRxPpzfp373A5RkjFCmcVjK58SAndEk1TH3RimEfZ8+u8zcKdJEgNL0JTlc",
+	"Si15jT+t4065l9MXSrZPoS/yzilaxG7o+B6LUw0ZlaJWc8m36LME6wO/EdTKBobhWXgrDREfuVaQ8Vz5",
+	"ntQVMhDNYtLRSgyzYz+OT9vmTL2gLbw2508pMXTULtfgxCDzx1BHGnGhzwfmPWct04EdmlEFMR68paw3",
+	"Hp99SsYUFFSm2TFcFVTS9B+f5Y5YNdNn4PKgFssubRa+gnrSsP6uNJSDxJpAE4S4fNaLZhsldKA1Pseb",
+	"zPNF2yh7aZYxx+QD4Wy25KWxhgiKIDeP5r8i0mdO2yi6U9UakWOKGc91JNFxYcw+xlNO9jN4UaJ4qBi1",
+	"7BP2MwzS2HA9Qb2j8LRv1QNlep+wKyJrGeZ5mtNnTcUkPLdPmcjJ55gQ4LABFTQIDEDVASclSGj5h5oB",
+	"0QwX6nfaUXB6WePpaeYYhLXWlbhsZovnBvfjICTDrOeCarOE/s747nX9tWXIm7gWVKNgkW27xGd+Lb9C",
+	"KC32o/hGVK8GJT8wl77AXx/i+JohoBxA+prHofp5ufqnYulznAp+nqT8Vmd/6dbHNTxjjeCd0ghOQVVO",
+	"hhx7NgGuD9zmpZ0sQk2NDbWViCKQCxWbjkGxyTfapiI//CJ3atufiEsTolE9TTsA64qoHjDEBv7W5wZU",
+	"Vunh1TkD9IrFwNdE0g8db4Y3tRJpAjo6NQ2GYK2lJbIJzTcrGhhdZjQr009uMjicVTXlTYcZGD59vLBQ",
+	"MtjwZ2AkaxD6eWHEoTF3H9t7h7L3VNQagiWyJhG2SkYS99zEaxRUL/E61JJ3aIS/A5HRBLJUVa7j0c2K",
+	"yi1ISoP2miv0a7zizmTWmfmagU9mKtMkv5h9xLaFI9bq8fV75OLOIjs8S32OfXFVJQO8eSis9zomsf0N",
+	"jppE0CbjMcljgXLWzAVVdPyflCTyeLpVhoxoiIuOGfipyNIpUmlJUyARtx+73Eabi/A2JRQcb0LAEUP7",
+	"xR46WnrQg/SaVbSfTz8oYcKrL4Zoaz2JdgXfIo2r4TH+0Yylrpbsv9nmRm9BGoG6bxrzNxYsaIAl1WSk",
+	"eg71JSH+9QnV8Yx9lmMl5LQqIbkDeUrqIfd5MZhtVsMmegGzJF5ls4M7tAacj1ygBM7imgSFUeLlDZc+",
+	"4PMrNLtVvMpqsaB89oA1UCQk9IoVidIaCLVZItvigUO+NiJ3IOvFo9kTSk1FVrgcODRI5TmoIzh5X3Tw",
+	"g6LmBN7pKW18tCI0vO5cqVaDpfiy0vaF/ZKA5JdTv1SKbIGnXJ37rSnaev1+Wqd1Rm32TOa9dG1dBZsT",
+	"a40TXJzaB4eV8JIaHs+932iROnNC/Y3RVOE28iuDvJOomjpq0VIW3GOvwFggn70g4sVjhWdaiCtKgARK",
+	"K1X1aiUzlxeizUZaXyPSeCFHR1cy8jUAq2IRBvfj22Akgk1tzJn6N9RRvNuZSiOyAqQW76COkP7KG6xL",
+	"g+L54CjVQV1RLMvrpR7T8pMJQiUUKbOekSu1e35YDT5JbddxtG9k9uJPyoWBsiUuWbnfMbsds9sTz9n4",
+	"c4qaek8Y0nlR6eXzKuVmHC8y7EbR4Q2VYdR1tUHbf+9l2Rtkfgh64XP3DOx3KWrGzWqzMblUW7Badzeu",
+	"fTDBFKA11sRrXzmHuYLFQc+1d2T/B17nosSjrTxe0OzSVfhxnwTvFUPVbAFK20iLTKRteCAOdt5ccJNy",
+	"/xsMjGMBwPBoGAvCwGB1XOuq6kvPuXHtgzHjHzN+y7DTHv6G/qmnsKksIzXzNMIm9lBiZZpRs9GY96tf",
+	"TD28F0Qk/3nZrruS8iJaz5bwmTaavZhJ49vL9sfblTxm4Mz6T3BX4VVaHSw7h3jB1a64UzjcLu0XA/3M",
+	"2FxWyQdHOwDtpd0Uqacw5dGGKdYMCCfnJDJ0M+fpMCmsiHBR++GQ3pKQFcmiKer9yJch/D7qMdil555C",
+	"cL16GJ8/J/cTnBYMsB7GwR3eUPCMqvF5YZ8U9B0R9inCSKESdMZeorH0OgvJiArO6xLN0NNPCBSFzZV1",
+	"t9Bw/NRD+P/y1Hy9ljNr4UdrSMaujnczFhDekHaKt6C/EHUsyS3XZR9Oz6hpg4flfbLhk1Ky1RUi5vE5",
+	"yufhTGcoa3u+Xht+1rgltib59Ma8fszrzwCvlz2YxRMKjfxVpgork2/dbUZxo97Kidcbv+45DKkfk7+x",
+	"Gh06Yd/ujD2JGps5ccB3IjadWjby9ef1PJYiJDlTQbgEO4zEOhZxIhD5ehhHD0pJlv+ne1LHcmQsR95l",
+	"OZJLEMUiZOrhfL32YU2bGq+5goLF5r3gg6i5ePKMWV0B9n7oz9O3T9E8d4PUptM00kHXg5wA9ph7jLmH",
+	"OZWS6XkZpOllGQwanLL4qmkafL7bPzMY3uLLsBCSvbnP95Cj3kFd+eMGuBZlsNj7h71AA8t2Va8Kf7Tr",
+	"cI+/6mBxyMhCR0p8OWATJhVFH2/Ib3XwhhVUbGmWpy431RfZNXjTClHxWbyJdixQMaWpCnHzfr02ljin",
+	"0B80EgU+j1bGQm0s1PKE2tsvvTyH/J1U/eQf0iwzqAiQKUgTBLpsNIowq68/w8vtBkXsx+1WmW7zidSn",
+	"hjiVMjMtWAEEYwZaR1ke3Lb2lLV03KRh7Dm6y3HbzTIllxRWNgIV3qe8qxyz73EOT5EfPDuGIqMQSAhm",
+	"yDy3aetp7fxwLGc43vLpUk10vj9d7KXFd3OUBQQXOMOp6Ha0yK1kHnubx7z5bPPmbPeRAla8zHo7cMan",
+	"9wZk87vS2QDKUMMrNz50PbcdNdxZ924cL81OTTWaVb9xt9mKZy9VLlWm/KU6Ga31XwMAZkznNApXAQA=",
+}
+
+// GetSwagger returns the content of the embedded swagger specification file
+// or error if failed to decode
+func decodeSpec() ([]byte, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+var rawSpec = decodeSpecCached()
+
+// a naive cached of a decoded swagger spec
+func decodeSpecCached() func() ([]byte, error) {
+	data, err := decodeSpec()
+	return func() ([]byte, error) {
+		return data, err
+	}
+}
+
+// Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
+func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
+	res := make(map[string]func() ([]byte, error))
+	if len(pathToFile) > 0 {
+		res[pathToFile] = rawSpec
+	}
+
+	return res
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file. The external references of Swagger specification are resolved.
+// The logic of resolving external references is tightly connected to "import-mapping" feature.
+// Externally referenced files must be embedded in the corresponding golang packages.
+// Urls can be supported but this task was out of the scope.
+func GetSwagger() (swagger *openapi3.T, err error) {
+	resolvePath := PathToRawSpec("")
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
+		pathToFile := url.String()
+		pathToFile = path.Clean(pathToFile)
+		getSpec, ok := resolvePath[pathToFile]
+		if !ok {
+			err1 := fmt.Errorf("path not found: %s", pathToFile)
+			return nil, err1
+		}
+		return getSpec()
+	}
+	var specData []byte
+	specData, err = rawSpec()
+	if err != nil {
+		return
+	}
+	swagger, err = loader.LoadFromData(specData)
+	if err != nil {
+		return
+	}
+	return
+}
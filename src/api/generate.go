@@ -0,0 +1,5 @@
+// Package api содержит типы и серверные интерфейсы, сгенерированные из задание/openapi.yml.
+// После изменения спецификации выполните go generate ./src/api
+package api
+
+//go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen@v2.4.1 -config oapi-codegen.yaml ../../задание/openapi.yml
@@ -0,0 +1,64 @@
+package cache
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestConditional(t *testing.T) {
+	modifiedAt := time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC)
+	handler := Conditional(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/missing" {
+			http.NotFound(w, r)
+			return
+		}
+		if r.URL.Path == "/stream" {
+			Streaming(w)
+		}
+		w.Header().Set("Last-Modified", modifiedAt.Format(http.TimeFormat))
+		_, _ = w.Write([]byte(`["tender"]`))
+	}))
+	etag := ETag([]byte(`["tender"]`))
+
+	tests := []struct {
+		name    string
+		method  string
+		path    string
+		header  string
+		value   string
+		want    int
+		hasETag bool
+	}{
+		{"plain", "GET", "/", "", "", http.StatusOK, true},
+		{"matching etag", "GET", "/", "If-None-Match", etag, http.StatusNotModified, true},
+		{"weak etag in list", "GET", "/", "If-None-Match", `"other", W/` + etag, http.StatusNotModified, true},
+		{"other etag", "GET", "/", "If-None-Match", `"other"`, http.StatusOK, true},
+		{"not modified since", "GET", "/", "If-Modified-Since", modifiedAt.Format(http.TimeFormat), http.StatusNotModified, true},
+		{"modified since", "GET", "/", "If-Modified-Since", modifiedAt.Add(-time.Hour).Format(http.TimeFormat), http.StatusOK, true},
+		{"error", "GET", "/missing", "If-None-Match", "*", http.StatusNotFound, false},
+		{"not get", "POST", "/", "If-None-Match", etag, http.StatusOK, false},
+		{"streaming", "GET", "/stream", "If-None-Match", "*", http.StatusOK, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.header != "" {
+				req.Header.Set(tt.header, tt.value)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.want {
+				t.Errorf("status = %d, want %d", rec.Code, tt.want)
+			}
+			if got := rec.Header().Get("ETag") != ""; got != tt.hasETag {
+				t.Errorf("ETag present = %v, want %v", got, tt.hasETag)
+			}
+			if rec.Code == http.StatusNotModified && rec.Body.Len() != 0 {
+				t.Errorf("304 response has body %q", rec.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"strings"
+	"time"
+	"zadanie_6105/src/models"
+)
+
+// PostgresStore - кеш, общий для всех экземпляров сервиса
+type PostgresStore struct {
+	db *gorm.DB
+}
+
+func NewPostgresStore(db *gorm.DB) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+func (s *PostgresStore) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	var entry models.CacheEntry
+	err := s.db.WithContext(ctx).
+		Where("key = ? AND expires_at > ?", key, time.Now()).
+		First(&entry).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return entry.Value, true, nil
+}
+
+func (s *PostgresStore) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	entry := models.CacheEntry{Key: key, Value: value, ExpiresAt: time.Now().Add(ttl)}
+	return s.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "key"}},
+		DoUpdates: clause.AssignmentColumns([]string{"value", "expires_at"}),
+	}).Create(&entry).Error
+}
+
+func (s *PostgresStore) DeletePrefix(ctx context.Context, prefix string) error {
+	pattern := strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(prefix) + "%"
+	return s.db.WithContext(ctx).
+		Where("key LIKE ?", pattern).
+		Delete(&models.CacheEntry{}).Error
+}
+
+func (s *PostgresStore) Cleanup(ctx context.Context) error {
+	return s.db.WithContext(ctx).
+		Where("expires_at <= ?", time.Now()).
+		Delete(&models.CacheEntry{}).Error
+}
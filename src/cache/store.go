@@ -0,0 +1,35 @@
+// Package cache хранит готовые ответы сервиса в памяти процесса или в общей таблице
+// PostgreSQL и отвечает 304 на условные GET-запросы.
+package cache
+
+import (
+	"context"
+	"time"
+	"zadanie_6105/src/logging"
+)
+
+type Store interface {
+	// Get возвращает значение и false, если ключа нет или он просрочен
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	// DeletePrefix удаляет все ключи, начинающиеся с prefix
+	DeletePrefix(ctx context.Context, prefix string) error
+	// Cleanup удаляет просроченные ключи
+	Cleanup(ctx context.Context) error
+}
+
+func RunCleanup(ctx context.Context, store Store, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := store.Cleanup(ctx); err != nil {
+				logging.FromContext(ctx).Error("Failed to clean up cache", "error", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package client
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+	"zadanie_6105/src/api"
+)
+
+type (
+	Bid       = api.Bid
+	NewBid    = api.CreateBidJSONRequestBody
+	BidEdit   = api.EditBidJSONRequestBody
+	BidReview = api.BidReview
+)
+
+func (c *Client) CreateBid(ctx context.Context, bid NewBid) (*Bid, error) {
+	var created Bid
+	err := c.do(ctx, request{
+		method:         http.MethodPost,
+		path:           "/bids/new",
+		body:           bid,
+		idempotencyKey: true,
+	}, &created)
+	if err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// MyBids возвращает предложения пользователя клиента
+func (c *Client) MyBids(ctx context.Context, page Page) ([]Bid, error) {
+	var bids []Bid
+	err := c.do(ctx, request{
+		method:     http.MethodGet,
+		path:       "/bids/my",
+		query:      page.values(),
+		authParam:  "username",
+		idempotent: true,
+	}, &bids)
+	return bids, err
+}
+
+func (c *Client) AllMyBids(ctx context.Context) iter.Seq2[Bid, error] {
+	return paginate(ctx, c.MyBids)
+}
+
+func (c *Client) ListBidsForTender(ctx context.Context, tenderID string, page Page) ([]Bid, error) {
+	var bids []Bid
+	err := c.do(ctx, request{
+		method:     http.MethodGet,
+		path:       pathEscape("bids", tenderID, "list"),
+		query:      page.values(),
+		authParam:  "username",
+		idempotent: true,
+	}, &bids)
+	return bids, err
+}
+
+func (c *Client) AllBidsForTender(ctx context.Context, tenderID string) iter.Seq2[Bid, error] {
+	return paginate(ctx, func(ctx context.Context, page Page) ([]Bid, error) {
+		return c.ListBidsForTender(ctx, tenderID, page)
+	})
+}
+
+func (c *Client) BidStatus(ctx context.Context, bidID string) (api.BidStatus, error) {
+	var status api.BidStatus
+	err := c.do(ctx, request{
+		method:     http.MethodGet,
+		path:       pathEscape("bids", bidID, "status"),
+		authParam:  "username",
+		idempotent: true,
+	}, &status)
+	return status, err
+}
+
+func (c *Client) SetBidStatus(ctx context.Context, bidID string, status api.BidStatus) (*Bid, error) {
+	var bid Bid
+	err := c.do(ctx, request{
+		method:     http.MethodPut,
+		path:       pathEscape("bids", bidID, "status"),
+		query:      url.Values{"status": {string(status)}},
+		authParam:  "username",
+		idempotent: true,
+	}, &bid)
+	if err != nil {
+		return nil, err
+	}
+	return &bid, nil
+}
+
+// EditBid создает новую версию предложения, поэтому не повторяется
+func (c *Client) EditBid(ctx context.Context, bidID string, edit BidEdit) (*Bid, error) {
+	var bid Bid
+	err := c.do(ctx, request{
+		method:    http.MethodPatch,
+		path:      pathEscape("bids", bidID, "edit"),
+		body:      edit,
+		authParam: "username",
+	}, &bid)
+	if err != nil {
+		return nil, err
+	}
+	return &bid, nil
+}
+
+func (c *Client) SubmitBidDecision(ctx context.Context, bidID string, decision api.BidDecision) (*Bid, error) {
+	var bid Bid
+	err := c.do(ctx, request{
+		method:         http.MethodPut,
+		path:           pathEscape("bids", bidID, "submit_decision"),
+		query:          url.Values{"decision": {string(decision)}},
+		authParam:      "username",
+		idempotencyKey: true,
+	}, &bid)
+	if err != nil {
+		return nil, err
+	}
+	return &bid, nil
+}
+
+// SubmitBidFeedback каждый раз добавляет новый отзыв, поэтому не повторяется
+func (c *Client) SubmitBidFeedback(ctx context.Context, bidID string, feedback string) (*Bid, error) {
+	var bid Bid
+	err := c.do(ctx, request{
+		method:    http.MethodPut,
+		path:      pathEscape("bids", bidID, "feedback"),
+		query:     url.Values{"bidFeedback": {feedback}},
+		authParam: "username",
+	}, &bid)
+	if err != nil {
+		return nil, err
+	}
+	return &bid, nil
+}
+
+// RollbackBid создает новую версию с параметрами указанной, поэтому не повторяется
+func (c *Client) RollbackBid(ctx context.Context, bidID string, version int32) (*Bid, error) {
+	var bid Bid
+	err := c.do(ctx, request{
+		method:    http.MethodPut,
+		path:      pathEscape("bids", bidID, "rollback", strconv.Itoa(int(version))),
+		authParam: "username",
+	}, &bid)
+	if err != nil {
+		return nil, err
+	}
+	return &bid, nil
+}
+
+// ListBidReviews возвращает отзывы на предложения автора; запрашивает пользователь клиента
+func (c *Client) ListBidReviews(ctx context.Context, tenderID string, authorUsername string, page Page) ([]BidReview, error) {
+	query := page.values()
+	query.Set("authorUsername", authorUsername)
+
+	var reviews []BidReview
+	err := c.do(ctx, request{
+		method:     http.MethodGet,
+		path:       pathEscape("bids", tenderID, "reviews"),
+		query:      query,
+		authParam:  "requesterUsername",
+		idempotent: true,
+	}, &reviews)
+	return reviews, err
+}
+
+func (c *Client) AllBidReviews(ctx context.Context, tenderID string, authorUsername string) iter.Seq2[BidReview, error] {
+	return paginate(ctx, func(ctx context.Context, page Page) ([]BidReview, error) {
+		return c.ListBidReviews(ctx, tenderID, authorUsername, page)
+	})
+}
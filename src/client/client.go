@@ -0,0 +1,203 @@
+// Package client - типизированный клиент Tender Management API.
+//
+// Клиент покрывает маршруты routes.RegisterRoutes, подставляет имя пользователя
+// в параметры авторизации, повторяет идемпотентные вызовы и возвращает *Error
+// с кодом и причиной из errorResponse сервера.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const idempotencyKeyHeader = "Idempotency-Key"
+
+// RequestEditorFn изменяет запрос перед отправкой, например добавляет заголовки авторизации шлюза
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+	username   string
+	editors    []RequestEditorFn
+	retry      RetryPolicy
+}
+
+type Option func(*Client)
+
+// WithHTTPClient заменяет http.DefaultClient
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithUsername задает пользователя, от имени которого выполняются запросы
+func WithUsername(username string) Option {
+	return func(c *Client) {
+		c.username = username
+	}
+}
+
+func WithRequestEditor(fn RequestEditorFn) Option {
+	return func(c *Client) {
+		c.editors = append(c.editors, fn)
+	}
+}
+
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Client) {
+		c.retry = policy
+	}
+}
+
+// New создает клиент; baseURL включает префикс API, например http://localhost:8080/api
+func New(baseURL string, options ...Option) *Client {
+	c := &Client{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		httpClient: http.DefaultClient,
+		retry:      DefaultRetryPolicy,
+	}
+	for _, option := range options {
+		option(c)
+	}
+	return c
+}
+
+// As возвращает копию клиента, работающую от имени другого пользователя
+func (c *Client) As(username string) *Client {
+	clone := *c
+	clone.username = username
+	return &clone
+}
+
+// request описывает вызов API
+type request struct {
+	method string
+	path   string
+	query  url.Values
+	body   interface{}
+	// authParam - параметр запроса, в который подставляется имя пользователя клиента
+	authParam string
+	// idempotent - вызов можно безопасно повторить
+	idempotent bool
+	// idempotencyKey - сервер принимает Idempotency-Key, с ним повтор POST тоже безопасен
+	idempotencyKey bool
+}
+
+func (c *Client) do(ctx context.Context, req request, out interface{}) error {
+	var payload []byte
+	if req.body != nil {
+		var err error
+		if payload, err = json.Marshal(req.body); err != nil {
+			return fmt.Errorf("encode request: %w", err)
+		}
+	}
+
+	query := url.Values{}
+	for key, values := range req.query {
+		query[key] = values
+	}
+	if req.authParam != "" && c.username != "" && query.Get(req.authParam) == "" {
+		query.Set(req.authParam, c.username)
+	}
+	target := c.baseURL + req.path
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+
+	var key string
+	if req.idempotencyKey {
+		key = uuid.NewString()
+	}
+	retryable := req.idempotent || key != ""
+
+	for attempt := 1; ; attempt++ {
+		httpReq, err := http.NewRequestWithContext(ctx, req.method, target, bytes.NewReader(payload))
+		if err != nil {
+			return err
+		}
+		if payload != nil {
+			httpReq.Header.Set("Content-Type", "application/json")
+		}
+		if key != "" {
+			httpReq.Header.Set(idempotencyKeyHeader, key)
+		}
+		for _, editor := range c.editors {
+			if err := editor(ctx, httpReq); err != nil {
+				return err
+			}
+		}
+
+		resp, err := c.httpClient.Do(httpReq)
+		if err != nil {
+			if ctx.Err() != nil || !retryable || attempt >= c.retry.MaxAttempts {
+				return err
+			}
+			if err := c.retry.wait(ctx, attempt, 0); err != nil {
+				return err
+			}
+			continue
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return err
+		}
+
+		if retryable && attempt < c.retry.MaxAttempts && isRetryableStatus(resp.StatusCode) {
+			if err := c.retry.wait(ctx, attempt, retryAfter(resp)); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return newError(resp.StatusCode, body)
+		}
+		if out == nil || resp.StatusCode == http.StatusNoContent || len(body) == 0 {
+			return nil
+		}
+		if err := json.Unmarshal(body, out); err != nil {
+			return fmt.Errorf("decode response: %w", err)
+		}
+		return nil
+	}
+}
+
+// Ping проверяет готовность сервера
+func (c *Client) Ping(ctx context.Context) error {
+	return c.do(ctx, request{method: http.MethodGet, path: "/ping", idempotent: true}, nil)
+}
+
+func retryAfter(resp *http.Response) time.Duration {
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		return time.Until(at)
+	}
+	return 0
+}
+
+func pathEscape(segments ...string) string {
+	escaped := make([]string, len(segments))
+	for i, segment := range segments {
+		escaped[i] = url.PathEscape(segment)
+	}
+	return "/" + strings.Join(escaped, "/")
+}
@@ -0,0 +1,217 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fastRetry повторяет без заметных задержек и не ограничивает Retry-After
+var fastRetry = RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}
+
+// recordingServer отвечает по очереди статусами из statuses (после них - 200 {}) и запоминает запросы
+type recordingServer struct {
+	*httptest.Server
+	mu       sync.Mutex
+	requests []*http.Request
+}
+
+func newRecordingServer(t *testing.T, header http.Header, statuses ...int) *recordingServer {
+	s := &recordingServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.requests = append(s.requests, r)
+		attempt := len(s.requests)
+		s.mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		if attempt <= len(statuses) {
+			for key, values := range header {
+				w.Header()[key] = values
+			}
+			w.WriteHeader(statuses[attempt-1])
+			fmt.Fprintf(w, `{"reason":"attempt %d failed"}`, attempt)
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *recordingServer) calls() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.requests)
+}
+
+func TestRetryHonorsRetryAfter(t *testing.T) {
+	server := newRecordingServer(t, http.Header{"Retry-After": {"1"}}, http.StatusTooManyRequests)
+	c := New(server.URL, WithRetryPolicy(fastRetry))
+
+	start := time.Now()
+	if err := c.Ping(context.Background()); err != nil {
+		t.Fatalf("Ping: %v", err)
+	}
+	if server.calls() != 2 {
+		t.Errorf("calls = %d, want 2", server.calls())
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("retried after %v, want Retry-After of 1s", elapsed)
+	}
+}
+
+func TestRetryOnServerErrors(t *testing.T) {
+	server := newRecordingServer(t, nil, http.StatusBadGateway, http.StatusServiceUnavailable)
+	c := New(server.URL, WithRetryPolicy(fastRetry))
+	if err := c.Ping(context.Background()); err != nil {
+		t.Fatalf("Ping: %v", err)
+	}
+	if server.calls() != 3 {
+		t.Errorf("calls = %d, want 3", server.calls())
+	}
+
+	// Попытки заканчиваются - возвращается последняя ошибка
+	server = newRecordingServer(t, nil, http.StatusGatewayTimeout, http.StatusGatewayTimeout, http.StatusGatewayTimeout)
+	c = New(server.URL, WithRetryPolicy(fastRetry))
+	var apiErr *Error
+	if err := c.Ping(context.Background()); !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusGatewayTimeout {
+		t.Errorf("Ping error = %v, want 504", err)
+	}
+	if server.calls() != 3 {
+		t.Errorf("calls = %d, want 3", server.calls())
+	}
+}
+
+func TestNoRetryOnClientErrors(t *testing.T) {
+	server := newRecordingServer(t, nil, http.StatusBadRequest)
+	c := New(server.URL, WithRetryPolicy(fastRetry))
+	if err := c.Ping(context.Background()); !errors.Is(err, ErrBadRequest) {
+		t.Errorf("Ping error = %v, want ErrBadRequest", err)
+	}
+	if server.calls() != 1 {
+		t.Errorf("calls = %d, want 1", server.calls())
+	}
+}
+
+func TestRetryOfPost(t *testing.T) {
+	ctx := context.Background()
+
+	// Без Idempotency-Key повтор POST мог бы создать запись дважды
+	server := newRecordingServer(t, nil, http.StatusServiceUnavailable)
+	c := New(server.URL, WithRetryPolicy(fastRetry), WithUsername("admin"))
+	if _, err := c.CreateEmployee(ctx, NewEmployee{Username: "new"}); err == nil {
+		t.Error("CreateEmployee succeeded, want the 503 error")
+	}
+	if server.calls() != 1 {
+		t.Errorf("CreateEmployee calls = %d, want 1", server.calls())
+	}
+
+	server = newRecordingServer(t, nil, http.StatusServiceUnavailable)
+	c = New(server.URL, WithRetryPolicy(fastRetry), WithUsername("admin"))
+	if _, err := c.CreateTender(ctx, NewTender{Name: "Тендер"}); err != nil {
+		t.Fatalf("CreateTender: %v", err)
+	}
+	if server.calls() != 2 {
+		t.Fatalf("CreateTender calls = %d, want 2", server.calls())
+	}
+	first, second := server.requests[0].Header.Get(idempotencyKeyHeader), server.requests[1].Header.Get(idempotencyKeyHeader)
+	if first == "" || first != second {
+		t.Errorf("Idempotency-Key = %q then %q, want the same key", first, second)
+	}
+}
+
+func TestPaginationStopsOnShortPage(t *testing.T) {
+	const total = 2*maxPageSize + 3
+	var offsets []int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		offsets = append(offsets, offset)
+		employees := []Employee{}
+		for i := offset; i < min(offset+limit, total); i++ {
+			employees = append(employees, Employee{Username: "user" + strconv.Itoa(i)})
+		}
+		_ = json.NewEncoder(w).Encode(employees)
+	}))
+	t.Cleanup(server.Close)
+	c := New(server.URL, WithUsername("admin"))
+
+	count := 0
+	for employee, err := range c.AllEmployees(context.Background()) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		if employee.Username != "user"+strconv.Itoa(count) {
+			t.Fatalf("employee %d = %s", count, employee.Username)
+		}
+		count++
+	}
+	if count != total || fmt.Sprint(offsets) != fmt.Sprint([]int{0, maxPageSize, 2 * maxPageSize}) {
+		t.Errorf("got %d employees with offsets %v, want %d in 3 pages", count, offsets, total)
+	}
+
+	// Прерванный обход не запрашивает следующих страниц
+	offsets = nil
+	for range c.AllEmployees(context.Background()) {
+		break
+	}
+	if len(offsets) != 1 {
+		t.Errorf("offsets after break = %v, want one page", offsets)
+	}
+}
+
+func TestPaginationStopsOnError(t *testing.T) {
+	server := newRecordingServer(t, nil, http.StatusForbidden)
+	c := New(server.URL, WithUsername("viewer"))
+
+	var errs []error
+	for _, err := range c.AllEmployees(context.Background()) {
+		errs = append(errs, err)
+	}
+	if len(errs) != 1 || !errors.Is(errs[0], ErrForbidden) {
+		t.Errorf("errors = %v, want one ErrForbidden", errs)
+	}
+}
+
+func TestErrorMapping(t *testing.T) {
+	cases := []struct {
+		status int
+		want   error
+	}{
+		{http.StatusUnauthorized, ErrUnauthorized},
+		{http.StatusForbidden, ErrForbidden},
+		{http.StatusNotFound, ErrNotFound},
+		{http.StatusConflict, ErrConflict},
+	}
+	for _, tc := range cases {
+		t.Run(http.StatusText(tc.status), func(t *testing.T) {
+			server := newRecordingServer(t, nil, tc.status)
+			c := New(server.URL, WithUsername("user"))
+
+			_, err := c.GetEmployee(context.Background(), "550e8400-e29b-41d4-a716-446655440000")
+			if !errors.Is(err, tc.want) {
+				t.Errorf("error = %v, want %v", err, tc.want)
+			}
+			for _, other := range []error{ErrUnauthorized, ErrForbidden, ErrNotFound, ErrConflict} {
+				if other != tc.want && errors.Is(err, other) {
+					t.Errorf("error %v also matches %v", err, other)
+				}
+			}
+			var apiErr *Error
+			if !errors.As(err, &apiErr) || apiErr.Reason != "attempt 1 failed" {
+				t.Errorf("error = %#v, want *Error with the reason from the response", err)
+			}
+			if got := server.requests[0].URL.Query().Get("username"); got != "user" {
+				t.Errorf("username = %q, want the client user", got)
+			}
+		})
+	}
+}
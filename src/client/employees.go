@@ -0,0 +1,104 @@
+package client
+
+import (
+	"context"
+	"iter"
+	"net/http"
+)
+
+type Employee struct {
+	ID        string `json:"id"`
+	Username  string `json:"username"`
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+	Email     string `json:"email"`
+	Locale    string `json:"locale"`
+	IsAdmin   bool   `json:"isAdmin"`
+	CreatedAt string `json:"createdAt"`
+}
+
+type NewEmployee struct {
+	Username  string `json:"username"`
+	FirstName string `json:"firstName,omitempty"`
+	LastName  string `json:"lastName,omitempty"`
+	Email     string `json:"email,omitempty"`
+	Locale    string `json:"locale,omitempty"`
+	IsAdmin   bool   `json:"isAdmin,omitempty"`
+}
+
+// EmployeeEdit - пустые поля не изменяются
+type EmployeeEdit struct {
+	Username  string `json:"username,omitempty"`
+	FirstName string `json:"firstName,omitempty"`
+	LastName  string `json:"lastName,omitempty"`
+	Email     string `json:"email,omitempty"`
+	Locale    string `json:"locale,omitempty"`
+}
+
+func (c *Client) ListEmployees(ctx context.Context, page Page) ([]Employee, error) {
+	var employees []Employee
+	err := c.do(ctx, request{
+		method:     http.MethodGet,
+		path:       "/employees",
+		query:      page.values(),
+		authParam:  "username",
+		idempotent: true,
+	}, &employees)
+	return employees, err
+}
+
+func (c *Client) AllEmployees(ctx context.Context) iter.Seq2[Employee, error] {
+	return paginate(ctx, c.ListEmployees)
+}
+
+func (c *Client) GetEmployee(ctx context.Context, employeeID string) (*Employee, error) {
+	var employee Employee
+	err := c.do(ctx, request{
+		method:     http.MethodGet,
+		path:       pathEscape("employees", employeeID),
+		authParam:  "username",
+		idempotent: true,
+	}, &employee)
+	if err != nil {
+		return nil, err
+	}
+	return &employee, nil
+}
+
+func (c *Client) CreateEmployee(ctx context.Context, employee NewEmployee) (*Employee, error) {
+	var created Employee
+	err := c.do(ctx, request{
+		method:    http.MethodPost,
+		path:      "/employees/new",
+		body:      employee,
+		authParam: "username",
+	}, &created)
+	if err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (c *Client) EditEmployee(ctx context.Context, employeeID string, edit EmployeeEdit) (*Employee, error) {
+	var employee Employee
+	err := c.do(ctx, request{
+		method:     http.MethodPatch,
+		path:       pathEscape("employees", employeeID, "edit"),
+		body:       edit,
+		authParam:  "username",
+		idempotent: true,
+	}, &employee)
+	if err != nil {
+		return nil, err
+	}
+	return &employee, nil
+}
+
+func (c *Client) DeleteEmployee(ctx context.Context, employeeID string) error {
+	return c.do(ctx, request{
+		method:     http.MethodDelete,
+		path:       pathEscape("employees", employeeID),
+		authParam:  "username",
+		idempotent: true,
+	}, nil)
+}
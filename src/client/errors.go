@@ -0,0 +1,58 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Ошибки по кодам ответа, сравниваются через errors.Is
+var (
+	ErrBadRequest      = errors.New("bad request")
+	ErrUnauthorized    = errors.New("user does not exist or is not specified")
+	ErrForbidden       = errors.New("not enough rights")
+	ErrNotFound        = errors.New("not found")
+	ErrConflict        = errors.New("conflict")
+	ErrTooManyRequests = errors.New("too many requests")
+)
+
+// Error - ответ сервера с кодом не из 2xx; Reason берется из errorResponse
+type Error struct {
+	StatusCode int
+	Reason     string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("tender api: %d %s: %s", e.StatusCode, http.StatusText(e.StatusCode), e.Reason)
+}
+
+func (e *Error) Is(target error) bool {
+	switch e.StatusCode {
+	case http.StatusBadRequest:
+		return target == ErrBadRequest
+	case http.StatusUnauthorized:
+		return target == ErrUnauthorized
+	case http.StatusForbidden:
+		return target == ErrForbidden
+	case http.StatusNotFound:
+		return target == ErrNotFound
+	case http.StatusConflict, http.StatusUnprocessableEntity:
+		return target == ErrConflict
+	case http.StatusTooManyRequests:
+		return target == ErrTooManyRequests
+	}
+	return false
+}
+
+func newError(statusCode int, body []byte) error {
+	var response struct {
+		Reason string `json:"reason"`
+	}
+	reason := strings.TrimSpace(string(body))
+	if err := json.Unmarshal(body, &response); err == nil && response.Reason != "" {
+		reason = response.Reason
+	}
+	return &Error{StatusCode: statusCode, Reason: reason}
+}
@@ -0,0 +1,145 @@
+package client
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"net/url"
+)
+
+type Organization struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Type        string `json:"type"`
+	CreatedAt   string `json:"createdAt"`
+}
+
+type NewOrganization struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Type        string `json:"type"`
+}
+
+// OrganizationEdit - пустые поля не изменяются
+type OrganizationEdit struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Type        string `json:"type,omitempty"`
+}
+
+type Responsible struct {
+	ID             string   `json:"id"`
+	OrganizationID string   `json:"organizationId"`
+	Role           string   `json:"role"`
+	Employee       Employee `json:"employee"`
+}
+
+func (c *Client) ListOrganizations(ctx context.Context, page Page) ([]Organization, error) {
+	var organizations []Organization
+	err := c.do(ctx, request{
+		method:     http.MethodGet,
+		path:       "/organizations",
+		query:      page.values(),
+		authParam:  "username",
+		idempotent: true,
+	}, &organizations)
+	return organizations, err
+}
+
+func (c *Client) AllOrganizations(ctx context.Context) iter.Seq2[Organization, error] {
+	return paginate(ctx, c.ListOrganizations)
+}
+
+func (c *Client) GetOrganization(ctx context.Context, organizationID string) (*Organization, error) {
+	var organization Organization
+	err := c.do(ctx, request{
+		method:     http.MethodGet,
+		path:       pathEscape("organizations", organizationID),
+		authParam:  "username",
+		idempotent: true,
+	}, &organization)
+	if err != nil {
+		return nil, err
+	}
+	return &organization, nil
+}
+
+func (c *Client) CreateOrganization(ctx context.Context, organization NewOrganization) (*Organization, error) {
+	var created Organization
+	err := c.do(ctx, request{
+		method:    http.MethodPost,
+		path:      "/organizations/new",
+		body:      organization,
+		authParam: "username",
+	}, &created)
+	if err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (c *Client) EditOrganization(ctx context.Context, organizationID string, edit OrganizationEdit) (*Organization, error) {
+	var organization Organization
+	err := c.do(ctx, request{
+		method:     http.MethodPatch,
+		path:       pathEscape("organizations", organizationID, "edit"),
+		body:       edit,
+		authParam:  "username",
+		idempotent: true,
+	}, &organization)
+	if err != nil {
+		return nil, err
+	}
+	return &organization, nil
+}
+
+func (c *Client) DeleteOrganization(ctx context.Context, organizationID string) error {
+	return c.do(ctx, request{
+		method:     http.MethodDelete,
+		path:       pathEscape("organizations", organizationID),
+		authParam:  "username",
+		idempotent: true,
+	}, nil)
+}
+
+func (c *Client) ListResponsibles(ctx context.Context, organizationID string) ([]Responsible, error) {
+	var responsibles []Responsible
+	err := c.do(ctx, request{
+		method:     http.MethodGet,
+		path:       pathEscape("organizations", organizationID, "responsibles"),
+		authParam:  "username",
+		idempotent: true,
+	}, &responsibles)
+	return responsibles, err
+}
+
+// AssignResponsible назначает сотрудника ответственным; пустая роль - Viewer на стороне сервера
+func (c *Client) AssignResponsible(ctx context.Context, organizationID string, employeeID string, role string) (*Responsible, error) {
+	query := url.Values{}
+	if role != "" {
+		query.Set("role", role)
+	}
+
+	var responsible Responsible
+	err := c.do(ctx, request{
+		method:     http.MethodPut,
+		path:       pathEscape("organizations", organizationID, "responsibles", employeeID),
+		query:      query,
+		authParam:  "username",
+		idempotent: true,
+	}, &responsible)
+	if err != nil {
+		return nil, err
+	}
+	return &responsible, nil
+}
+
+func (c *Client) RemoveResponsible(ctx context.Context, organizationID string, employeeID string) error {
+	return c.do(ctx, request{
+		method:     http.MethodDelete,
+		path:       pathEscape("organizations", organizationID, "responsibles", employeeID),
+		authParam:  "username",
+		idempotent: true,
+	}, nil)
+}
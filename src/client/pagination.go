@@ -0,0 +1,53 @@
+package client
+
+import (
+	"context"
+	"iter"
+	"net/url"
+	"strconv"
+)
+
+// maxPageSize - максимальный limit из спецификации, итераторы запрашивают страницы такого размера
+const maxPageSize = 50
+
+// Page - параметры пагинации; нулевые значения не передаются, сервер подставляет свои
+type Page struct {
+	Limit  int
+	Offset int
+}
+
+func (p Page) values() url.Values {
+	query := url.Values{}
+	if p.Limit > 0 {
+		query.Set("limit", strconv.Itoa(p.Limit))
+	}
+	if p.Offset > 0 {
+		query.Set("offset", strconv.Itoa(p.Offset))
+	}
+	return query
+}
+
+// paginate обходит все страницы, пока сервер не вернет неполную.
+// Ошибка отдается последним элементом, после нее обход прекращается.
+func paginate[T any](ctx context.Context, fetch func(ctx context.Context, page Page) ([]T, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		page := Page{Limit: maxPageSize}
+		for {
+			items, err := fetch(ctx, page)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if len(items) < page.Limit {
+				return
+			}
+			page.Offset += len(items)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package client
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+	"zadanie_6105/src/api"
+)
+
+type (
+	Tender     = api.Tender
+	NewTender  = api.CreateTenderJSONRequestBody
+	TenderEdit = api.EditTenderJSONRequestBody
+)
+
+// ListTenders возвращает страницу опубликованных тендеров, отфильтрованных по видам услуг
+func (c *Client) ListTenders(ctx context.Context, page Page, serviceTypes ...api.TenderServiceType) ([]Tender, error) {
+	query := page.values()
+	for _, serviceType := range serviceTypes {
+		query.Add("service_type", string(serviceType))
+	}
+
+	var tenders []Tender
+	err := c.do(ctx, request{method: http.MethodGet, path: "/tenders", query: query, idempotent: true}, &tenders)
+	return tenders, err
+}
+
+func (c *Client) AllTenders(ctx context.Context, serviceTypes ...api.TenderServiceType) iter.Seq2[Tender, error] {
+	return paginate(ctx, func(ctx context.Context, page Page) ([]Tender, error) {
+		return c.ListTenders(ctx, page, serviceTypes...)
+	})
+}
+
+// MyTenders возвращает тендеры организаций, за которые отвечает пользователь клиента
+func (c *Client) MyTenders(ctx context.Context, page Page) ([]Tender, error) {
+	var tenders []Tender
+	err := c.do(ctx, request{
+		method:     http.MethodGet,
+		path:       "/tenders/my",
+		query:      page.values(),
+		authParam:  "username",
+		idempotent: true,
+	}, &tenders)
+	return tenders, err
+}
+
+func (c *Client) AllMyTenders(ctx context.Context) iter.Seq2[Tender, error] {
+	return paginate(ctx, c.MyTenders)
+}
+
+// CreateTender создает тендер; пустой CreatorUsername заменяется пользователем клиента
+func (c *Client) CreateTender(ctx context.Context, tender NewTender) (*Tender, error) {
+	if tender.CreatorUsername == "" {
+		tender.CreatorUsername = c.username
+	}
+
+	var created Tender
+	err := c.do(ctx, request{
+		method:         http.MethodPost,
+		path:           "/tenders/new",
+		body:           tender,
+		idempotencyKey: true,
+	}, &created)
+	if err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (c *Client) TenderStatus(ctx context.Context, tenderID string) (api.TenderStatus, error) {
+	var status api.TenderStatus
+	err := c.do(ctx, request{
+		method:     http.MethodGet,
+		path:       pathEscape("tenders", tenderID, "status"),
+		authParam:  "username",
+		idempotent: true,
+	}, &status)
+	return status, err
+}
+
+func (c *Client) SetTenderStatus(ctx context.Context, tenderID string, status api.TenderStatus) (*Tender, error) {
+	var tender Tender
+	err := c.do(ctx, request{
+		method:     http.MethodPut,
+		path:       pathEscape("tenders", tenderID, "status"),
+		query:      url.Values{"status": {string(status)}},
+		authParam:  "username",
+		idempotent: true,
+	}, &tender)
+	if err != nil {
+		return nil, err
+	}
+	return &tender, nil
+}
+
+// EditTender создает новую версию тендера, поэтому не повторяется
+func (c *Client) EditTender(ctx context.Context, tenderID string, edit TenderEdit) (*Tender, error) {
+	var tender Tender
+	err := c.do(ctx, request{
+		method:    http.MethodPatch,
+		path:      pathEscape("tenders", tenderID, "edit"),
+		body:      edit,
+		authParam: "username",
+	}, &tender)
+	if err != nil {
+		return nil, err
+	}
+	return &tender, nil
+}
+
+// RollbackTender создает новую версию с параметрами указанной, поэтому не повторяется
+func (c *Client) RollbackTender(ctx context.Context, tenderID string, version int32) (*Tender, error) {
+	var tender Tender
+	err := c.do(ctx, request{
+		method:    http.MethodPut,
+		path:      pathEscape("tenders", tenderID, "rollback", strconv.Itoa(int(version))),
+		authParam: "username",
+	}, &tender)
+	if err != nil {
+		return nil, err
+	}
+	return &tender, nil
+}
@@ -0,0 +1,138 @@
+package database
+
+import (
+	"fmt"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"os"
+	"strings"
+	"zadanie_6105/src/logging"
+	"zadanie_6105/src/metrics"
+	"zadanie_6105/src/models"
+	"zadanie_6105/src/repository"
+	"zadanie_6105/src/tracing"
+)
+
+// ConnectionString собирает строку подключения из переменных окружения POSTGRES_*
+func ConnectionString() string {
+	return fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%s sslmode=disable",
+		os.Getenv("POSTGRES_HOST"),
+		os.Getenv("POSTGRES_USERNAME"),
+		os.Getenv("POSTGRES_PASSWORD"),
+		os.Getenv("POSTGRES_DATABASE"),
+		os.Getenv("POSTGRES_PORT"))
+}
+
+// Open подключается к PostgreSQL и подключает плагины метрик и трассировки
+func Open(connStr string) (*gorm.DB, error) {
+	db, err := gorm.Open(postgres.Open(connStr), &gorm.Config{Logger: logging.NewGormLogger()})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := db.Use(metrics.GormPlugin{}); err != nil {
+		return nil, fmt.Errorf("register metrics plugin: %w", err)
+	}
+	if err := db.Use(tracing.GormPlugin{}); err != nil {
+		return nil, fmt.Errorf("register tracing plugin: %w", err)
+	}
+	return db, nil
+}
+
+// Migrate создает и обновляет таблицы сервиса. Таблицы organization и
+// organization_responsible создаются скриптом из задания.
+func Migrate(db *gorm.DB) error {
+	// До появления tender_versions и bid_versions все версии хранились в tenders и bids
+	legacyTenders := db.Migrator().HasTable(&models.Tender{}) && !db.Migrator().HasTable(&models.TenderVersion{})
+	legacyBids := db.Migrator().HasTable(&models.Bid{}) && !db.Migrator().HasTable(&models.BidVersion{})
+
+	tables := []interface{}{
+		&models.Tender{},
+		&models.TenderVersion{},
+		&models.Bid{},
+		&models.BidVersion{},
+		&models.BidFeedback{},
+		&models.Employee{},
+		&models.EmailMessage{},
+		&models.OrganizationResponsible{},
+		&models.BidDecision{},
+		&models.BidShortlist{},
+		&models.RateLimitBucket{},
+		&models.IdempotencyKey{},
+		&models.CacheEntry{},
+		&models.TenderProtocol{},
+		&models.ServiceType{},
+		&models.SavedSearch{},
+		&models.TenderMatch{},
+		&models.TenderAuction{},
+		&models.AuctionOffer{},
+		&models.TenderSeal{},
+		&models.AuditEvent{},
+	}
+	for _, table := range tables {
+		if err := db.AutoMigrate(table); err != nil {
+			return err
+		}
+	}
+
+	// Индекс полнотекстового поиска по выражению, которое использует repository.ListPublished
+	if err := db.Exec("CREATE INDEX IF NOT EXISTS idx_tenders_search ON tenders USING GIN (" +
+		repository.TenderSearchVector + ")").Error; err != nil {
+		return fmt.Errorf("create tender search index: %w", err)
+	}
+
+	// Справочник заполняется прежними видами услуг; уже измененные записи не трогаются
+	defaults := append([]models.ServiceType(nil), models.DefaultServiceTypes...)
+	if err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&defaults).Error; err != nil {
+		return fmt.Errorf("seed service types: %w", err)
+	}
+
+	if legacyTenders {
+		if err := splitHistory(db, &models.Tender{}, &models.TenderVersion{}); err != nil {
+			return fmt.Errorf("split tender versions: %w", err)
+		}
+	}
+	if legacyBids {
+		if err := splitHistory(db, &models.Bid{}, &models.BidVersion{}); err != nil {
+			return fmt.Errorf("split bid versions: %w", err)
+		}
+	}
+	return nil
+}
+
+// splitHistory переносит все версии, кроме последней, из таблицы current в таблицу history
+// и делает id первичным ключом current вместо version_id
+func splitHistory(db *gorm.DB, current interface{}, history interface{}) error {
+	currentStmt := &gorm.Statement{DB: db}
+	if err := currentStmt.Parse(current); err != nil {
+		return err
+	}
+	historyStmt := &gorm.Statement{DB: db}
+	if err := historyStmt.Parse(history); err != nil {
+		return err
+	}
+	table := currentStmt.Schema.Table
+	columns := strings.Join(historyStmt.Schema.DBNames, ", ")
+
+	// Версия устарела, если у того же id есть версия новее. created_at и version_id
+	// различают версии с одинаковым номером, созданные гонкой до переноса.
+	outdated := fmt.Sprintf(`EXISTS (SELECT 1 FROM %[1]s t1 WHERE t1.id = t.id
+		AND (t1.version, t1.created_at, t1.version_id) > (t.version, t.created_at, t.version_id))`, table)
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		statements := []string{
+			fmt.Sprintf("INSERT INTO %s (%s) SELECT %s FROM %s t WHERE %s",
+				historyStmt.Schema.Table, columns, columns, table, outdated),
+			fmt.Sprintf("DELETE FROM %s t WHERE %s", table, outdated),
+			fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT IF EXISTS %s_pkey", table, table),
+			fmt.Sprintf("ALTER TABLE %s ADD PRIMARY KEY (id)", table),
+		}
+		for _, statement := range statements {
+			if err := tx.Exec(statement).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
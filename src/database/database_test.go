@@ -0,0 +1,137 @@
+package database_test
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"strings"
+	"testing"
+	"zadanie_6105/src/database"
+	"zadanie_6105/src/repository"
+	"zadanie_6105/src/testharness"
+)
+
+func TestMain(m *testing.M) {
+	testharness.Main(m)
+}
+
+// Прежняя схема: все версии тендера в tenders с первичным ключом version_id
+const legacyTenders = `CREATE TABLE tenders (
+	id UUID DEFAULT uuid_generate_v4(),
+	version_id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+	name VARCHAR(100) NOT NULL,
+	description TEXT NOT NULL,
+	service_type VARCHAR(20) NOT NULL,
+	status VARCHAR(20) DEFAULT 'Created' NOT NULL,
+	organization_id VARCHAR(100) NOT NULL,
+	version INTEGER DEFAULT 1 NOT NULL,
+	created_at TIMESTAMPTZ
+)`
+
+// Прежняя схема: все версии предложения в bids с первичным ключом version_id
+const legacyBids = `CREATE TABLE bids (
+	id UUID DEFAULT uuid_generate_v4(),
+	version_id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+	name VARCHAR(100) NOT NULL,
+	description TEXT NOT NULL,
+	status VARCHAR(20) DEFAULT 'Created' NOT NULL,
+	tender_id UUID NOT NULL,
+	author_type VARCHAR(20) NOT NULL,
+	author_id UUID NOT NULL,
+	version INTEGER DEFAULT 1 NOT NULL,
+	created_at TIMESTAMPTZ
+)`
+
+func TestMigrateSplitsLegacyVersions(t *testing.T) {
+	h := testharness.New(t)
+	organizationID := h.Fixtures.Alpha.ID.String()
+	authorID := h.Fixtures.Bidder.ID.String()
+
+	statements := []string{
+		`DROP TABLE tenders`,
+		`DROP TABLE tender_versions`,
+		`DROP TABLE bids`,
+		`DROP TABLE bid_versions`,
+		legacyTenders,
+		legacyBids,
+		`INSERT INTO tenders (id, name, description, service_type, status, organization_id, version, created_at) VALUES
+			('00000000-0000-4000-8000-000000000001', 'A', 'v1', 'Delivery', 'Created', '` + organizationID + `', 1, now() - interval '3 hours'),
+			('00000000-0000-4000-8000-000000000001', 'A', 'v2', 'Delivery', 'Published', '` + organizationID + `', 2, now() - interval '2 hours'),
+			('00000000-0000-4000-8000-000000000001', 'A', 'v3', 'Delivery', 'Published', '` + organizationID + `', 3, now() - interval '1 hour'),
+			('00000000-0000-4000-8000-000000000002', 'B', 'v1', 'Delivery', 'Published', '` + organizationID + `', 1, now())`,
+		`INSERT INTO bids (id, name, description, status, tender_id, author_type, author_id, version, created_at) VALUES
+			('00000000-0000-4000-8000-000000000011', 'X', 'v1', 'Created', '00000000-0000-4000-8000-000000000001', 'User', '` + authorID + `', 1, now() - interval '3 hours'),
+			('00000000-0000-4000-8000-000000000011', 'X', 'v2', 'Published', '00000000-0000-4000-8000-000000000001', 'User', '` + authorID + `', 2, now() - interval '2 hours'),
+			('00000000-0000-4000-8000-000000000011', 'X', 'v3', 'Published', '00000000-0000-4000-8000-000000000001', 'User', '` + authorID + `', 3, now() - interval '1 hour'),
+			('00000000-0000-4000-8000-000000000012', 'Y', 'v1', 'Published', '00000000-0000-4000-8000-000000000001', 'User', '` + authorID + `', 1, now())`,
+	}
+	for _, statement := range statements {
+		if err := h.DB.Exec(statement).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := database.Migrate(h.DB); err != nil {
+		t.Fatalf("Migrate: %v", err)
+	}
+	// Повторный запуск не должен ничего менять
+	if err := database.Migrate(h.DB); err != nil {
+		t.Fatalf("second Migrate: %v", err)
+	}
+
+	var current, history int64
+	h.DB.Table("tenders").Count(&current)
+	h.DB.Table("tender_versions").Count(&history)
+	if current != 2 || history != 2 {
+		t.Errorf("tenders = %d, tender_versions = %d, want 2 and 2", current, history)
+	}
+
+	tenders := repository.NewPostgres(h.DB).Tenders
+	published, err := tenders.ListPublished(context.Background(), nil, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(published) != 2 || published[0].Description != "v3" {
+		t.Fatalf("ListPublished = %+v, want current versions of A and B", published)
+	}
+	versions, err := tenders.ListVersions(context.Background(), published[0].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, version := range versions {
+		if version.Version != int32(i+1) {
+			t.Errorf("ListVersions = %+v, want versions 1, 2, 3", versions)
+			break
+		}
+	}
+	if len(versions) != 3 {
+		t.Errorf("ListVersions returned %d versions, want 3", len(versions))
+	}
+
+	h.DB.Table("bids").Count(&current)
+	h.DB.Table("bid_versions").Count(&history)
+	if current != 2 || history != 2 {
+		t.Errorf("bids = %d, bid_versions = %d, want 2 and 2", current, history)
+	}
+
+	bids := repository.NewPostgres(h.DB).Bids
+	bidID := uuid.MustParse("00000000-0000-4000-8000-000000000011")
+	bid, err := bids.GetLast(context.Background(), bidID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bid.Version != 3 || bid.Description != "v3" {
+		t.Errorf("GetLast = version %d %q, want version 3", bid.Version, bid.Description)
+	}
+	bidVersions, err := bids.ListVersions(context.Background(), bidID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	descriptions := make([]string, len(bidVersions))
+	for i, version := range bidVersions {
+		descriptions[i] = fmt.Sprintf("%d:%s", version.Version, version.Description)
+	}
+	if got := strings.Join(descriptions, ","); got != "1:v1,2:v2,3:v3" {
+		t.Errorf("bid ListVersions = %s, want 1:v1,2:v2,3:v3", got)
+	}
+}
@@ -0,0 +1,212 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+	"zadanie_6105/src/api"
+	"zadanie_6105/src/models"
+	"zadanie_6105/src/services"
+)
+
+// maxAuctionExtension - наибольшее продление аукциона ставкой в последние секунды
+const maxAuctionExtension = 3600
+
+func formatAuctionToExport(auction *models.TenderAuction) api.Auction {
+	return api.Auction{
+		TenderId:         auction.TenderId.String(),
+		StartPrice:       auction.StartPrice,
+		MinDecrement:     auction.MinDecrement,
+		StartsAt:         auction.StartsAt.Format(time.RFC3339),
+		EndsAt:           auction.EndsAt.Format(time.RFC3339),
+		ExtensionSeconds: auction.ExtensionSeconds,
+	}
+}
+
+// formatAuctionStandingToExport показывает автору место предложения без цен конкурентов
+func formatAuctionStandingToExport(standing *services.AuctionStanding) api.AuctionStanding {
+	return api.AuctionStanding{
+		BidId:        standing.BidId.String(),
+		Price:        standing.Price,
+		Rank:         standing.Rank,
+		Participants: standing.Participants,
+		EndsAt:       standing.EndsAt.Format(time.RFC3339),
+	}
+}
+
+func formatAuctionRankingToExport(offers []models.AuctionOffer) []api.AuctionOffer {
+	result := make([]api.AuctionOffer, len(offers))
+	for i, offer := range offers {
+		result[i] = api.AuctionOffer{
+			Rank:      i + 1,
+			BidId:     offer.BidId.String(),
+			AuthorId:  offer.AuthorId.String(),
+			Price:     offer.Price,
+			CreatedAt: offer.CreatedAt.Format(time.RFC3339),
+		}
+	}
+	return result
+}
+
+// auctionError проверяет настройки аукциона и возвращает текст ошибки
+func auctionError(body *api.ConfigureAuctionJSONRequestBody) string {
+	if body.StartsAt.IsZero() || body.EndsAt.IsZero() {
+		return "startsAt and endsAt are required"
+	}
+	if body.MinDecrement <= 0 {
+		return "minDecrement must be positive"
+	}
+	if body.StartPrice != nil && *body.StartPrice <= 0 {
+		return "startPrice must be positive"
+	}
+	if !body.EndsAt.After(body.StartsAt) {
+		return "endsAt must be after startsAt"
+	}
+	if !body.EndsAt.After(time.Now()) {
+		return "endsAt must be in the future"
+	}
+	if body.ExtensionSeconds != nil && (*body.ExtensionSeconds < 0 || *body.ExtensionSeconds > maxAuctionExtension) {
+		return "extensionSeconds must be between 0 and 3600"
+	}
+	return ""
+}
+
+// GetAuction отдает настройки аукциона: ответственным - всегда, остальным - по опубликованному тендеру
+func (s *Server) GetAuction(w http.ResponseWriter, r *http.Request, tenderId api.TenderId, params api.GetAuctionParams) {
+	service := s.service.WithContext(r.Context())
+	username := ""
+	if params.Username != nil {
+		username = *params.Username
+	}
+
+	isResponsible := false
+	if username != "" {
+		var err error
+		if isResponsible, err = service.AuthorizeTender(username, tenderId, services.PermissionTenderView); err != nil {
+			writeServiceError(w, r, err)
+			return
+		}
+	}
+	if !isResponsible {
+		isPublished, err := service.CheckIfTenderPublished(tenderId)
+		if err != nil {
+			writeServiceError(w, r, err)
+			return
+		}
+		if !isPublished {
+			if !requireUsername(w, username) {
+				return
+			}
+			writeError(w, http.StatusForbidden, "This user is not responsible")
+			return
+		}
+	}
+
+	auction, err := service.GetAuction(tenderId)
+	if err != nil {
+		writeServiceError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, formatAuctionToExport(auction))
+}
+
+// ConfigureAuction создает или меняет аукцион тендера вида услуг Delivery
+func (s *Server) ConfigureAuction(w http.ResponseWriter, r *http.Request, tenderId api.TenderId, params api.ConfigureAuctionParams) {
+	service := s.service.WithContext(r.Context())
+	var body api.ConfigureAuctionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	if reason := auctionError(&body); reason != "" {
+		writeError(w, http.StatusBadRequest, reason)
+		return
+	}
+	if !authorizeTenderAction(w, r, service, params.Username, tenderId, services.PermissionTenderEdit) {
+		return
+	}
+
+	auction := models.TenderAuction{
+		StartPrice:   body.StartPrice,
+		MinDecrement: body.MinDecrement,
+		StartsAt:     body.StartsAt.UTC(),
+		EndsAt:       body.EndsAt.UTC(),
+	}
+	if body.ExtensionSeconds != nil {
+		auction.ExtensionSeconds = *body.ExtensionSeconds
+	}
+	configured, err := service.ConfigureAuction(tenderId, &auction)
+	if err != nil {
+		writeServiceError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, formatAuctionToExport(configured))
+}
+
+// GetAuctionRanking отдает ответственным лучшие ставки всех участников
+func (s *Server) GetAuctionRanking(w http.ResponseWriter, r *http.Request, tenderId api.TenderId, params api.GetAuctionRankingParams) {
+	service := s.service.WithContext(r.Context())
+	if !authorizeTenderAction(w, r, service, params.Username, tenderId, services.PermissionBidView) {
+		return
+	}
+
+	offers, err := service.GetAuctionRanking(tenderId)
+	if err != nil {
+		writeServiceError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, formatAuctionRankingToExport(offers))
+}
+
+// authorizeAuctionBidder проверяет, что пользователь - автор предложения
+func authorizeAuctionBidder(w http.ResponseWriter, r *http.Request, service *services.Service, username string, bidId string) bool {
+	if !requireUsername(w, username) {
+		return false
+	}
+	isAuthor, err := service.AuthorizeBidAuthor(username, bidId)
+	return checkAccess(w, r, isAuthor, err)
+}
+
+// PlaceAuctionOffer принимает ставку автора и отдает место предложения после нее
+func (s *Server) PlaceAuctionOffer(w http.ResponseWriter, r *http.Request, bidId api.BidId, params api.PlaceAuctionOfferParams) {
+	service := s.service.WithContext(r.Context())
+	var body api.PlaceAuctionOfferJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	if body.Price <= 0 {
+		writeError(w, http.StatusBadRequest, "Price must be positive")
+		return
+	}
+	if !authorizeAuctionBidder(w, r, service, params.Username, bidId) {
+		return
+	}
+
+	standing, err := service.PlaceAuctionOffer(bidId, body.Price)
+	if err != nil {
+		writeServiceError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, formatAuctionStandingToExport(standing))
+}
+
+// GetAuctionStanding отдает автору место его предложения в аукционе
+func (s *Server) GetAuctionStanding(w http.ResponseWriter, r *http.Request, bidId api.BidId, params api.GetAuctionStandingParams) {
+	service := s.service.WithContext(r.Context())
+	if !authorizeAuctionBidder(w, r, service, params.Username, bidId) {
+		return
+	}
+
+	standing, err := service.GetAuctionStanding(bidId)
+	if err != nil {
+		writeServiceError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, formatAuctionStandingToExport(standing))
+}
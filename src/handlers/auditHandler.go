@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+	"zadanie_6105/src/api"
+	"zadanie_6105/src/models"
+	"zadanie_6105/src/services"
+)
+
+func formatAuditEventsToExport(events []models.AuditEvent) []api.AuditEvent {
+	result := make([]api.AuditEvent, len(events))
+	for i, event := range events {
+		result[i] = api.AuditEvent{
+			Id:        event.ID.String(),
+			Action:    event.Action,
+			CreatedAt: event.CreatedAt.Format(time.RFC3339),
+		}
+		if event.Details != nil {
+			result[i].Details = &events[i].Details
+		}
+		if event.EmployeeId != nil {
+			employeeId := event.EmployeeId.String()
+			result[i].EmployeeId = &employeeId
+		}
+	}
+	return result
+}
+
+// GetAuditEvents отдает ответственным журнал аудита тендера
+func (s *Server) GetAuditEvents(w http.ResponseWriter, r *http.Request, tenderId api.TenderId, params api.GetAuditEventsParams) {
+	service := s.service.WithContext(r.Context())
+	limit, offset, ok := checkPagination(w, params.Limit, params.Offset)
+	if !ok {
+		return
+	}
+	if !authorizeTenderAction(w, r, service, params.Username, tenderId, services.PermissionTenderView) {
+		return
+	}
+
+	events, err := service.GetAuditEvents(tenderId, limit, offset)
+	if err != nil {
+		writeServiceError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, formatAuditEventsToExport(events))
+}
@@ -3,48 +3,54 @@ package handlers
 import (
 	"encoding/json"
 	"github.com/google/uuid"
-	"github.com/gorilla/mux"
 	"net/http"
-	"strconv"
 	"time"
+	"zadanie_6105/src/api"
 	"zadanie_6105/src/models"
 	"zadanie_6105/src/services"
 )
 
-func formatBidToExport(bid *models.Bid) map[string]interface{} {
-	result := map[string]interface{}{
-		"id":          bid.ID.String(),
-		"name":        bid.Name,
-		"description": bid.Description,
-		"status":      bid.Status,
-		"tenderId":    bid.TenderId.String(),
-		"authorType":  bid.AuthorType,
-		"authorId":    bid.AuthorId.String(),
-		"version":     bid.Version,
-		"createdAt":   bid.CreatedAt.Format(time.RFC3339),
+const maxFeedbackLength = 1000
+
+// formatBidToExport переводит предложение в ответ API; для запечатанного отмечает Sealed и Commitment
+func formatBidToExport(bid *models.Bid) api.Bid {
+	result := api.Bid{
+		Id:          bid.ID.String(),
+		Name:        bid.Name,
+		Description: bid.Description,
+		Status:      api.BidStatus(bid.Status),
+		TenderId:    bid.TenderId.String(),
+		AuthorType:  api.BidAuthorType(bid.AuthorType),
+		AuthorId:    bid.AuthorId.String(),
+		Round:       bid.Round,
+		Sealed:      bid.Sealed != nil,
+		Version:     bid.Version,
+		CreatedAt:   bid.CreatedAt.Format(time.RFC3339),
+	}
+	if bid.Commitment != "" {
+		result.Commitment = &bid.Commitment
 	}
 	return result
 }
 
-func formatBidsToExport(bids *[]models.Bid) []map[string]interface{} {
-	result := make([]map[string]interface{}, len(*bids))
+func formatBidsToExport(bids *[]models.Bid) []api.Bid {
+	result := make([]api.Bid, len(*bids))
 	for i, bid := range *bids {
 		result[i] = formatBidToExport(&bid)
 	}
 	return result
 }
 
-func formatFeedbackToExport(feedback *models.BidFeedback) map[string]interface{} {
-	result := map[string]interface{}{
-		"id":          feedback.ID.String(),
-		"description": feedback.Description,
-		"createdAt":   feedback.CreatedAt.Format(time.RFC3339),
+func formatFeedbackToExport(feedback *models.BidFeedback) api.BidReview {
+	return api.BidReview{
+		Id:          feedback.ID.String(),
+		Description: feedback.Description,
+		CreatedAt:   feedback.CreatedAt.Format(time.RFC3339),
 	}
-	return result
 }
 
-func formatFeedbacksToExport(feedbacks *[]models.BidFeedback) []map[string]interface{} {
-	result := make([]map[string]interface{}, len(*feedbacks))
+func formatFeedbacksToExport(feedbacks *[]models.BidFeedback) []api.BidReview {
+	result := make([]api.BidReview, len(*feedbacks))
 	for i, feedback := range *feedbacks {
 		result[i] = formatFeedbackToExport(&feedback)
 	}
@@ -66,473 +72,321 @@ func checkDecision(s string) bool {
 	return checkParam(s, &list)
 }
 
-func CreateBid(service *services.Service) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		var bid models.Bid
-		err := json.NewDecoder(r.Body).Decode(&bid)
-		if err != nil {
-			http.Error(w, "Invalid request payload", http.StatusBadRequest)
-			return
-		}
-
-		if !checkAuthorType(bid.AuthorType) {
-			http.Error(w, `Author type can be only "Organization", "User"`, http.StatusBadRequest)
-			return
-		}
-
-		isPublished, err := service.CheckIfTenderPublished(bid.TenderId.String())
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusNotFound)
-			return
-		}
-		if !isPublished {
-			http.Error(w, `Tender is not published`, http.StatusNotFound)
-			return
-		}
-
-		isExist, err := service.CheckEmployeeExistence(bid.AuthorId.String())
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusUnauthorized)
-			return
-		}
-		if !isExist {
-			http.Error(w, `User is not exist`, http.StatusUnauthorized)
-			return
-		}
-
-		err = service.CreateBid(&bid)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-			return
-		}
-
-		response := formatBidToExport(&bid)
-
-		w.Header().Set("Content-Type", "application/json")
-		if err := json.NewEncoder(w).Encode(response); err != nil {
-			http.Error(w, "Failed to encode response", http.StatusInternalServerError)
-		}
+// checkBidRound пишет 403, если автор предложения не прошел в текущий раунд тендера
+func checkBidRound(w http.ResponseWriter, r *http.Request, service *services.Service, bidId string) bool {
+	canBid, err := service.AuthorizeBidRound(bidId)
+	if err != nil {
+		writeServiceError(w, r, err)
+		return false
 	}
-}
-
-func GetBidsByUser(service *services.Service) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		limitStr := r.URL.Query().Get("limit")
-		offsetStr := r.URL.Query().Get("offset")
-		username := r.URL.Query().Get("username")
-		limit := 5
-		offset := 0
-
-		if limitStr != "" {
-			if l, err := strconv.Atoi(limitStr); err == nil {
-				limit = l
-			} else {
-				http.Error(w, "Invalid paginationLimit", http.StatusBadRequest)
-				return
-			}
-		}
-		if offsetStr != "" {
-			if o, err := strconv.Atoi(offsetStr); err == nil {
-				offset = o
-			} else {
-				http.Error(w, "Invalid paginationOffset", http.StatusBadRequest)
-				return
-			}
-		}
-		if username == "" {
-			http.Error(w, "Username required", http.StatusUnauthorized)
-			return
-		}
-
-		bids, err := service.GetBidsByUser(username, limit, offset)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-		response := formatBidsToExport(bids)
-
-		w.Header().Set("Content-Type", "application/json")
-		if err := json.NewEncoder(w).Encode(response); err != nil {
-			http.Error(w, "Failed to encode response", http.StatusInternalServerError)
-		}
+	if !canBid {
+		writeError(w, http.StatusForbidden, "Author is not shortlisted for the current round")
+		return false
 	}
+	return true
 }
 
-func GetBidsByTender(service *services.Service) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		vars := mux.Vars(r)
-		tenderID := vars["tenderId"]
-		limitStr := r.URL.Query().Get("limit")
-		offsetStr := r.URL.Query().Get("offset")
-		username := r.URL.Query().Get("username")
-		limit := 5
-		offset := 0
-
-		if limitStr != "" {
-			if l, err := strconv.Atoi(limitStr); err == nil {
-				limit = l
-			} else {
-				http.Error(w, "Invalid paginationLimit", http.StatusBadRequest)
-				return
-			}
-		}
-		if offsetStr != "" {
-			if o, err := strconv.Atoi(offsetStr); err == nil {
-				offset = o
-			} else {
-				http.Error(w, "Invalid paginationOffset", http.StatusBadRequest)
-				return
-			}
-		}
-
-		if username == "" {
-			http.Error(w, "Username required", http.StatusUnauthorized)
-			return
-		}
-		isResponsible, err := service.CheckIfUserIsResponsibleForTender(username, tenderID)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusUnauthorized)
-			return
-		}
-		if !isResponsible {
-			http.Error(w, "This user is not responsible", http.StatusForbidden)
-			return
-		}
-
-		bids, err := service.GetBidsByTender(tenderID, limit, offset)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-		response := formatBidsToExport(bids)
-
-		w.Header().Set("Content-Type", "application/json")
-		if err := json.NewEncoder(w).Encode(response); err != nil {
-			http.Error(w, "Failed to encode response", http.StatusInternalServerError)
-		}
+func (s *Server) CreateBid(w http.ResponseWriter, r *http.Request) {
+	service := s.service.WithContext(r.Context())
+	var body api.CreateBidJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid request payload")
+		return
 	}
-}
-
-func GetBidStatus(service *services.Service) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		vars := mux.Vars(r)
-		bidID := vars["bidId"]
-		username := r.URL.Query().Get("username")
 
-		if username == "" {
-			http.Error(w, "Username required", http.StatusUnauthorized)
-			return
-		}
+	if !checkAuthorType(string(body.AuthorType)) {
+		writeError(w, http.StatusBadRequest, `Author type can be only "Organization", "User"`)
+		return
+	}
+	if body.Name == "" || body.Description == "" {
+		writeError(w, http.StatusBadRequest, "Name and description are required")
+		return
+	}
+	if !checkLength(w, "name", body.Name, maxNameLength) ||
+		!checkLength(w, "description", body.Description, maxDescriptionLength) {
+		return
+	}
 
-		isResponsible, err := service.CheckIfUserIsResponsibleForBid(username, bidID)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusUnauthorized)
-			return
-		}
-		if !isResponsible {
-			http.Error(w, "This user is not responsible", http.StatusForbidden)
-			return
-		}
+	authorID, err := uuid.Parse(body.AuthorId)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "User does not exist")
+		return
+	}
+	isExist, err := service.CheckEmployeeExistence(body.AuthorId)
+	if err != nil || !isExist {
+		writeError(w, http.StatusUnauthorized, "User does not exist")
+		return
+	}
 
-		status, err := service.GetBidStatus(bidID)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusNotFound)
-			return
-		}
+	isPublished, err := service.CheckIfTenderPublished(body.TenderId)
+	if err != nil {
+		writeServiceError(w, r, err)
+		return
+	}
+	if !isPublished {
+		writeError(w, http.StatusNotFound, "Tender is not published")
+		return
+	}
+	canBid, err := service.AuthorizeRoundBidder(body.TenderId, authorID)
+	if err != nil {
+		writeServiceError(w, r, err)
+		return
+	}
+	if !canBid {
+		writeError(w, http.StatusForbidden, "Author is not shortlisted for the current round")
+		return
+	}
 
-		_, err = w.Write([]byte(status))
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-			return
-		}
-		w.WriteHeader(http.StatusOK)
+	bid := models.Bid{
+		Name:        body.Name,
+		Description: body.Description,
+		TenderId:    uuid.MustParse(body.TenderId),
+		AuthorType:  string(body.AuthorType),
+		AuthorId:    authorID,
+	}
+	if err := service.CreateBid(&bid); err != nil {
+		writeServiceError(w, r, err)
+		return
 	}
-}
 
-func UpdateBidStatus(service *services.Service) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		vars := mux.Vars(r)
-		bidID := vars["bidId"]
-		username := r.URL.Query().Get("username")
-		status := r.URL.Query().Get("status")
+	writeJSON(w, http.StatusOK, formatBidToExport(&bid))
+}
 
-		if !checkBidStatus(status) {
-			http.Error(w, `Status can be only "Created", "Published", "Canceled"`, http.StatusBadRequest)
-		}
+func (s *Server) GetUserBids(w http.ResponseWriter, r *http.Request, params api.GetUserBidsParams) {
+	service := s.service.WithContext(r.Context())
+	limit, offset, ok := checkPagination(w, params.Limit, params.Offset)
+	if !ok {
+		return
+	}
+	if params.Username == nil || *params.Username == "" {
+		writeError(w, http.StatusUnauthorized, "Username required")
+		return
+	}
 
-		if username == "" {
-			http.Error(w, "Username required", http.StatusUnauthorized)
-			return
-		}
+	bids, err := service.GetBidsByUser(*params.Username, limit, offset)
+	if err != nil {
+		writeServiceError(w, r, err)
+		return
+	}
 
-		isResponsible, err := service.CheckIfUserIsResponsibleForBid(username, bidID)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusUnauthorized)
-			return
-		}
-		if !isResponsible {
-			http.Error(w, "This user is not responsible", http.StatusForbidden)
-			return
-		}
+	writeJSON(w, http.StatusOK, formatBidsToExport(bids))
+}
 
-		bid, err := service.UpdateBidStatus(bidID, status)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusNotFound)
-			return
-		}
+func (s *Server) GetBidsForTender(w http.ResponseWriter, r *http.Request, tenderId api.TenderId, params api.GetBidsForTenderParams) {
+	service := s.service.WithContext(r.Context())
+	limit, offset, ok := checkPagination(w, params.Limit, params.Offset)
+	if !ok {
+		return
+	}
 
-		response := formatBidToExport(bid)
+	isResponsible, err := service.AuthorizeTender(params.Username, tenderId, services.PermissionBidView)
+	if !checkAccess(w, r, isResponsible, err) {
+		return
+	}
 
-		w.Header().Set("Content-Type", "application/json")
-		if err := json.NewEncoder(w).Encode(response); err != nil {
-			http.Error(w, "Failed to encode response", http.StatusInternalServerError)
-		}
+	bids, err := service.GetBidsByTender(tenderId, limit, offset)
+	if err != nil {
+		writeServiceError(w, r, err)
+		return
 	}
+
+	writeJSON(w, http.StatusOK, formatBidsToExport(bids))
 }
 
-func UpdateBid(service *services.Service) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
+func (s *Server) GetBidStatus(w http.ResponseWriter, r *http.Request, bidId api.BidId, params api.GetBidStatusParams) {
+	service := s.service.WithContext(r.Context())
 
-		vars := mux.Vars(r)
-		bidID := vars["bidId"]
-		username := r.URL.Query().Get("username")
+	isAllowed, err := service.AuthorizeBidViewer(params.Username, bidId)
+	if !checkAccess(w, r, isAllowed, err) {
+		return
+	}
 
-		var edit models.BidEdit
-		err := json.NewDecoder(r.Body).Decode(&edit)
-		if err != nil {
-			http.Error(w, "Invalid request payload", http.StatusBadRequest)
-			return
-		}
+	status, err := service.GetBidStatus(bidId)
+	if err != nil {
+		writeServiceError(w, r, err)
+		return
+	}
 
-		// Check username
-		if username == "" {
-			http.Error(w, "Username required", http.StatusUnauthorized)
-			return
-		}
+	writeJSON(w, http.StatusOK, status)
+}
 
-		isResponsible, err := service.CheckIfUserIsResponsibleForBid(username, bidID)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusUnauthorized)
-			return
-		}
-		if !isResponsible {
-			http.Error(w, "This user is not responsible", http.StatusForbidden)
-			return
-		}
+func (s *Server) UpdateBidStatus(w http.ResponseWriter, r *http.Request, bidId api.BidId, params api.UpdateBidStatusParams) {
+	service := s.service.WithContext(r.Context())
 
-		bid, err := service.UpdateBid(bidID, &edit)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-			return
-		}
+	if !checkBidStatus(string(params.Status)) {
+		writeError(w, http.StatusBadRequest, `Status can be only "Created", "Published", "Canceled"`)
+		return
+	}
 
-		response := formatBidToExport(bid)
+	isAuthor, err := service.AuthorizeBidAuthor(params.Username, bidId)
+	if !checkAccess(w, r, isAuthor, err) {
+		return
+	}
 
-		w.Header().Set("Content-Type", "application/json")
-		if err := json.NewEncoder(w).Encode(response); err != nil {
-			http.Error(w, "Failed to encode response", http.StatusInternalServerError)
-		}
+	bid, err := service.UpdateBidStatus(bidId, string(params.Status))
+	if err != nil {
+		writeServiceError(w, r, err)
+		return
 	}
+
+	writeJSON(w, http.StatusOK, formatBidToExport(bid))
 }
 
-func SubmitBid(service *services.Service) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		vars := mux.Vars(r)
-		bidID := vars["bidId"]
-		username := r.URL.Query().Get("username")
-		decisionStr := r.URL.Query().Get("decision")
+func (s *Server) EditBid(w http.ResponseWriter, r *http.Request, bidId api.BidId, params api.EditBidParams) {
+	service := s.service.WithContext(r.Context())
 
-		if !checkDecision(decisionStr) {
-			http.Error(w, `Status can be only "Approved", "Rejected"`, http.StatusBadRequest)
-		}
-		decision := decisionStr == "Approved"
+	var body api.EditBidJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
 
-		if username == "" {
-			http.Error(w, "Username required", http.StatusUnauthorized)
+	// Check body
+	var edit models.BidEdit
+	if body.Name != nil {
+		if *body.Name == "" {
+			writeError(w, http.StatusBadRequest, "Name can not be empty")
 			return
 		}
-
-		isResponsible, err := service.CheckIfUserIsResponsibleForTenderByBidID(username, bidID)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusUnauthorized)
+		if !checkLength(w, "name", *body.Name, maxNameLength) {
 			return
 		}
-		if !isResponsible {
-			http.Error(w, "This user is not responsible", http.StatusForbidden)
+		edit.Name = *body.Name
+	}
+	if body.Description != nil {
+		if *body.Description == "" {
+			writeError(w, http.StatusBadRequest, "Description can not be empty")
 			return
 		}
-
-		bid, err := service.SubmitBid(bidID, decision)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusNotFound)
+		if !checkLength(w, "description", *body.Description, maxDescriptionLength) {
 			return
 		}
+		edit.Description = *body.Description
+	}
 
-		response := formatBidToExport(bid)
+	isAuthor, err := service.AuthorizeBidAuthor(params.Username, bidId)
+	if !checkAccess(w, r, isAuthor, err) {
+		return
+	}
+	if !checkBidRound(w, r, service, bidId) {
+		return
+	}
 
-		w.Header().Set("Content-Type", "application/json")
-		if err := json.NewEncoder(w).Encode(response); err != nil {
-			http.Error(w, "Failed to encode response", http.StatusInternalServerError)
-		}
+	bid, err := service.UpdateBid(bidId, &edit)
+	if err != nil {
+		writeServiceError(w, r, err)
+		return
 	}
+
+	writeJSON(w, http.StatusOK, formatBidToExport(bid))
 }
 
-func RollbackBid(service *services.Service) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-
-		vars := mux.Vars(r)
-		bidID := vars["bidId"]
-		versionStr := vars["version"]
-		username := r.URL.Query().Get("username")
-		var version int32
-
-		// Check version
-		if versionStr != "" {
-			if v, err := strconv.Atoi(versionStr); err == nil {
-				version = int32(v)
-			} else {
-				http.Error(w, "Invalid version", http.StatusBadRequest)
-				return
-			}
-		}
+func (s *Server) SubmitBidDecision(w http.ResponseWriter, r *http.Request, bidId api.BidId, params api.SubmitBidDecisionParams) {
+	service := s.service.WithContext(r.Context())
 
-		// Check username
-		if username == "" {
-			http.Error(w, "Username required", http.StatusUnauthorized)
-			return
-		}
+	if !checkDecision(string(params.Decision)) {
+		writeError(w, http.StatusBadRequest, `Decision can be only "Approved", "Rejected"`)
+		return
+	}
 
-		isResponsible, err := service.CheckIfUserIsResponsibleForBid(username, bidID)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusUnauthorized)
-			return
-		}
-		if !isResponsible {
-			http.Error(w, "This user is not responsible", http.StatusForbidden)
-			return
-		}
+	isResponsible, err := service.AuthorizeTenderByBidID(params.Username, bidId, services.PermissionBidDecide)
+	if !checkAccess(w, r, isResponsible, err) {
+		return
+	}
 
-		bid, err := service.RollbackBid(bidID, version)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-			return
-		}
+	bid, err := service.SubmitBid(bidId, params.Username, params.Decision == "Approved")
+	if err != nil {
+		writeServiceError(w, r, err)
+		return
+	}
 
-		response := formatBidToExport(bid)
+	writeJSON(w, http.StatusOK, formatBidToExport(bid))
+}
 
-		w.Header().Set("Content-Type", "application/json")
-		if err := json.NewEncoder(w).Encode(response); err != nil {
-			http.Error(w, "Failed to encode response", http.StatusInternalServerError)
-		}
+func (s *Server) RollbackBid(w http.ResponseWriter, r *http.Request, bidId api.BidId, version int32, params api.RollbackBidParams) {
+	service := s.service.WithContext(r.Context())
+
+	// Check version
+	if version < 1 {
+		writeError(w, http.StatusBadRequest, "Invalid version")
+		return
 	}
-}
 
-func CreateFeedback(service *services.Service) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		vars := mux.Vars(r)
-		bidIDStr := vars["bidId"]
-		username := r.URL.Query().Get("username")
-		description := r.URL.Query().Get("bidFeedback")
+	isAuthor, err := service.AuthorizeBidAuthor(params.Username, bidId)
+	if !checkAccess(w, r, isAuthor, err) {
+		return
+	}
+	if !checkBidRound(w, r, service, bidId) {
+		return
+	}
 
-		if username == "" {
-			http.Error(w, "Username required", http.StatusUnauthorized)
-			return
-		}
+	bid, err := service.RollbackBid(bidId, version)
+	if err != nil {
+		writeServiceError(w, r, err)
+		return
+	}
 
-		isResponsible, err := service.CheckIfUserIsResponsibleForTenderByBidID(username, bidIDStr)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusUnauthorized)
-			return
-		}
-		if !isResponsible {
-			http.Error(w, "This user is not responsible", http.StatusForbidden)
-			return
-		}
+	writeJSON(w, http.StatusOK, formatBidToExport(bid))
+}
 
-		bidID, err := uuid.Parse(bidIDStr)
-		if err != nil {
-			http.Error(w, "Invalid request", http.StatusBadRequest)
-			return
-		}
-		feedback := models.BidFeedback{
-			Description: description,
-			BidId:       bidID,
-		}
+func (s *Server) SubmitBidFeedback(w http.ResponseWriter, r *http.Request, bidId api.BidId, params api.SubmitBidFeedbackParams) {
+	service := s.service.WithContext(r.Context())
 
-		bid, err := service.CreateFeedback(&feedback)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusNotFound)
-			return
-		}
+	if params.BidFeedback == "" {
+		writeError(w, http.StatusBadRequest, "Feedback can not be empty")
+		return
+	}
+	if !checkLength(w, "bidFeedback", params.BidFeedback, maxFeedbackLength) {
+		return
+	}
 
-		response := formatBidToExport(bid)
+	isResponsible, err := service.AuthorizeTenderByBidID(params.Username, bidId, services.PermissionBidFeedback)
+	if !checkAccess(w, r, isResponsible, err) {
+		return
+	}
 
-		w.Header().Set("Content-Type", "application/json")
-		if err := json.NewEncoder(w).Encode(response); err != nil {
-			http.Error(w, "Failed to encode response", http.StatusInternalServerError)
-		}
+	feedback := models.BidFeedback{
+		Description: params.BidFeedback,
+		BidId:       uuid.MustParse(bidId),
+	}
+	bid, err := service.CreateFeedback(&feedback)
+	if err != nil {
+		writeServiceError(w, r, err)
+		return
 	}
-}
 
-func GetFeedbacks(service *services.Service) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		vars := mux.Vars(r)
-		tenderID := vars["tenderId"]
-		limitStr := r.URL.Query().Get("limit")
-		offsetStr := r.URL.Query().Get("offset")
-		author := r.URL.Query().Get("authorUsername")
-		requester := r.URL.Query().Get("requesterUsername")
-		limit := 5
-		offset := 0
-
-		if limitStr != "" {
-			if l, err := strconv.Atoi(limitStr); err == nil {
-				limit = l
-			} else {
-				http.Error(w, "Invalid paginationLimit", http.StatusBadRequest)
-				return
-			}
-		}
-		if offsetStr != "" {
-			if o, err := strconv.Atoi(offsetStr); err == nil {
-				offset = o
-			} else {
-				http.Error(w, "Invalid paginationOffset", http.StatusBadRequest)
-				return
-			}
-		}
+	writeJSON(w, http.StatusOK, formatBidToExport(bid))
+}
 
-		if requester == "" {
-			http.Error(w, "Username required", http.StatusUnauthorized)
-			return
-		}
-		isResponsible, err := service.CheckIfUserIsResponsibleForTender(requester, tenderID)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusUnauthorized)
-			return
-		}
-		if !isResponsible {
-			http.Error(w, "This user is not responsible", http.StatusForbidden)
-			return
-		}
+// GetBidReviews отдает ответственному за тендер отзывы на все предложения автора,
+// если автор подал предложение на этот тендер
+func (s *Server) GetBidReviews(w http.ResponseWriter, r *http.Request, tenderId api.TenderId, params api.GetBidReviewsParams) {
+	service := s.service.WithContext(r.Context())
+	limit, offset, ok := checkPagination(w, params.Limit, params.Offset)
+	if !ok {
+		return
+	}
 
-		isAuthor, err := service.CheckIfBidByUserExist(author, tenderID)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-			return
-		}
-		if !isAuthor {
-			http.Error(w, "This user is not author", http.StatusBadRequest)
-			return
-		}
+	isResponsible, err := service.AuthorizeTender(params.RequesterUsername, tenderId, services.PermissionBidView)
+	if !checkAccess(w, r, isResponsible, err) {
+		return
+	}
 
-		feedbacks, err := service.GetFeedbacks(author, limit, offset)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-		response := formatFeedbacksToExport(feedbacks)
+	isAuthor, err := service.CheckIfBidByUserExist(params.AuthorUsername, tenderId)
+	if err != nil {
+		writeServiceError(w, r, err)
+		return
+	}
+	if !isAuthor {
+		writeError(w, http.StatusNotFound, "This user has no bids for the tender")
+		return
+	}
 
-		w.Header().Set("Content-Type", "application/json")
-		if err := json.NewEncoder(w).Encode(response); err != nil {
-			http.Error(w, "Failed to encode response", http.StatusInternalServerError)
-		}
+	feedbacks, err := service.GetFeedbacks(params.AuthorUsername, limit, offset)
+	if err != nil {
+		writeServiceError(w, r, err)
+		return
 	}
+
+	writeJSON(w, http.StatusOK, formatFeedbacksToExport(feedbacks))
 }
@@ -0,0 +1,238 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/gorilla/mux"
+	"net/http"
+	"net/mail"
+	"strconv"
+	"time"
+	"zadanie_6105/src/models"
+	"zadanie_6105/src/services"
+)
+
+func formatEmployeeToExport(employee *models.Employee) map[string]interface{} {
+	result := map[string]interface{}{
+		"id":        employee.ID.String(),
+		"username":  employee.Username,
+		"firstName": employee.FirstName,
+		"lastName":  employee.LastName,
+		"email":     employee.Email,
+		"locale":    employee.Locale,
+		"isAdmin":   employee.IsAdmin,
+		"createdAt": employee.CreatedAt.Format(time.RFC3339),
+	}
+	return result
+}
+
+func formatEmployeesToExport(employees *[]models.Employee) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(*employees))
+	for i, employee := range *employees {
+		result[i] = formatEmployeeToExport(&employee)
+	}
+	return result
+}
+
+func checkLocale(s string) bool {
+	list := []string{"ru", "en"}
+	return checkParam(s, &list)
+}
+
+// checkEmployeeEdit проверяет поля, общие для создания и редактирования сотрудника
+func checkEmployeeEdit(edit *models.EmployeeEdit) error {
+	if len(edit.Username) > 50 || len(edit.FirstName) > 50 || len(edit.LastName) > 50 {
+		return errors.New("username, first name and last name must be at most 50 characters")
+	}
+	if edit.Email != "" {
+		if _, err := mail.ParseAddress(edit.Email); err != nil || len(edit.Email) > 100 {
+			return errors.New("invalid email")
+		}
+	}
+	if edit.Locale != "" && !checkLocale(edit.Locale) {
+		return errors.New(`locale can be only "ru", "en"`)
+	}
+	return nil
+}
+
+func GetEmployees(service *services.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		service := service.WithContext(r.Context())
+		limitStr := r.URL.Query().Get("limit")
+		offsetStr := r.URL.Query().Get("offset")
+		username := r.URL.Query().Get("username")
+		limit := 5
+		offset := 0
+
+		if limitStr != "" {
+			if l, err := strconv.Atoi(limitStr); err == nil {
+				limit = l
+			} else {
+				writeError(w, http.StatusBadRequest, "Invalid paginationLimit")
+				return
+			}
+		}
+		if offsetStr != "" {
+			if o, err := strconv.Atoi(offsetStr); err == nil {
+				offset = o
+			} else {
+				writeError(w, http.StatusBadRequest, "Invalid paginationOffset")
+				return
+			}
+		}
+
+		if !checkAdmin(w, service, username) {
+			return
+		}
+
+		employees, err := service.GetEmployees(limit, offset)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		response := formatEmployeesToExport(employees)
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			writeError(w, http.StatusInternalServerError, "Failed to encode response")
+		}
+	}
+}
+
+func GetEmployee(service *services.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		service := service.WithContext(r.Context())
+		vars := mux.Vars(r)
+		employeeID := vars["employeeId"]
+		username := r.URL.Query().Get("username")
+
+		if !checkAdmin(w, service, username) {
+			return
+		}
+
+		employee, err := service.GetEmployee(employeeID)
+		if err != nil {
+			writeError(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		response := formatEmployeeToExport(employee)
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			writeError(w, http.StatusInternalServerError, "Failed to encode response")
+		}
+	}
+}
+
+func CreateEmployee(service *services.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		service := service.WithContext(r.Context())
+		username := r.URL.Query().Get("username")
+
+		var employee models.Employee
+		err := json.NewDecoder(r.Body).Decode(&employee)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "Invalid request payload")
+			return
+		}
+
+		// Check body
+		if employee.Username == "" {
+			writeError(w, http.StatusBadRequest, "Username is required")
+			return
+		}
+		err = checkEmployeeEdit(&models.EmployeeEdit{
+			Username:  employee.Username,
+			FirstName: employee.FirstName,
+			LastName:  employee.LastName,
+			Email:     employee.Email,
+			Locale:    employee.Locale,
+		})
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		if !checkAdmin(w, service, username) {
+			return
+		}
+
+		err = service.CreateEmployee(&employee)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		response := formatEmployeeToExport(&employee)
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			writeError(w, http.StatusInternalServerError, "Failed to encode response")
+		}
+	}
+}
+
+func UpdateEmployee(service *services.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		service := service.WithContext(r.Context())
+		vars := mux.Vars(r)
+		employeeID := vars["employeeId"]
+		username := r.URL.Query().Get("username")
+
+		var edit models.EmployeeEdit
+		err := json.NewDecoder(r.Body).Decode(&edit)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "Invalid request payload")
+			return
+		}
+
+		// Check body
+		if err := checkEmployeeEdit(&edit); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		if !checkAdmin(w, service, username) {
+			return
+		}
+
+		employee, err := service.UpdateEmployee(employeeID, &edit)
+		if err != nil {
+			writeError(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		response := formatEmployeeToExport(employee)
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			writeError(w, http.StatusInternalServerError, "Failed to encode response")
+		}
+	}
+}
+
+func DeleteEmployee(service *services.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		service := service.WithContext(r.Context())
+		vars := mux.Vars(r)
+		employeeID := vars["employeeId"]
+		username := r.URL.Query().Get("username")
+
+		if !checkAdmin(w, service, username) {
+			return
+		}
+
+		err := service.DeleteEmployee(employeeID)
+		if errors.Is(err, services.ErrLastResponsible) {
+			writeError(w, http.StatusConflict, err.Error())
+			return
+		}
+		if err != nil {
+			writeError(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"net/http"
+	"zadanie_6105/src/api"
+	"zadanie_6105/src/cache"
+	"zadanie_6105/src/logging"
+	"zadanie_6105/src/models"
+	"zadanie_6105/src/services"
+	"zadanie_6105/src/tabular"
+)
+
+// archiveTables раскладывает архив тендера по таблицам выгрузки; строки читаются из архива
+// во время записи
+func archiveTables(archive *services.TenderArchive) []tabular.Table {
+	tenders := tabular.Table{
+		Name:   "tender",
+		Header: []string{"id", "version", "name", "description", "serviceType", "status", "organizationId", "deadline", "budget", "region", "rounds", "round", "sealed", "createdAt"},
+		Rows: func(write func(row []interface{}) error) error {
+			return archive.Tenders(func(tender *models.Tender) error {
+				var deadline, budget, region interface{}
+				if tender.Deadline != nil {
+					deadline = *tender.Deadline
+				}
+				if tender.Budget != nil {
+					budget = *tender.Budget
+				}
+				if tender.Region != "" {
+					region = tender.Region
+				}
+				return write([]interface{}{
+					tender.ID.String(), tender.Version, tender.Name, tender.Description, tender.ServiceType,
+					tender.Status, tender.OrganizationId, deadline, budget, region, tender.Rounds, tender.Round, tender.Sealed, tender.CreatedAt,
+				})
+			})
+		},
+	}
+
+	bids := tabular.Table{
+		Name:   "bid",
+		Header: []string{"id", "version", "tenderId", "name", "description", "status", "authorType", "authorId", "round", "commitment", "createdAt"},
+		Rows: func(write func(row []interface{}) error) error {
+			return archive.Bids(func(bid *models.Bid) error {
+				return write([]interface{}{
+					bid.ID.String(), bid.Version, bid.TenderId.String(), bid.Name, bid.Description,
+					bid.Status, bid.AuthorType, bid.AuthorId.String(), bid.Round, bid.Commitment, bid.CreatedAt,
+				})
+			})
+		},
+	}
+
+	decisions := tabular.Table{
+		Name:   "decision",
+		Header: []string{"id", "bidId", "employeeId", "decision", "createdAt"},
+		Rows: func(write func(row []interface{}) error) error {
+			return archive.Decisions(func(decision *models.BidDecision) error {
+				return write([]interface{}{
+					decision.ID.String(), decision.BidId.String(), decision.EmployeeId.String(), decision.Decision, decision.CreatedAt,
+				})
+			})
+		},
+	}
+
+	feedbacks := tabular.Table{
+		Name:   "feedback",
+		Header: []string{"id", "bidId", "description", "createdAt"},
+		Rows: func(write func(row []interface{}) error) error {
+			return archive.Feedbacks(func(feedback *models.BidFeedback) error {
+				return write([]interface{}{
+					feedback.ID.String(), feedback.BidId.String(), feedback.Description, feedback.CreatedAt,
+				})
+			})
+		},
+	}
+
+	return []tabular.Table{tenders, bids, decisions, feedbacks}
+}
+
+// ExportTender выгружает тендер со всеми версиями, предложениями, голосами и отзывами.
+// Выгрузка пишется в ответ по мере чтения из хранилища.
+// Формат задается параметром format (csv, xlsx, jsonl) или заголовком Accept.
+// Доступ - как к списку предложений тендера.
+func (s *Server) ExportTender(w http.ResponseWriter, r *http.Request, tenderId api.TenderId, params api.ExportTenderParams) {
+	service := s.service.WithContext(r.Context())
+
+	var format string
+	if params.Format != nil {
+		format = string(*params.Format)
+		if tabular.ContentType(format) == "" {
+			writeError(w, http.StatusBadRequest, `Format can be only "csv", "xlsx", "jsonl"`)
+			return
+		}
+	} else {
+		var ok bool
+		if format, ok = tabular.NegotiateFormat(r.Header.Get("Accept")); !ok {
+			writeError(w, http.StatusNotAcceptable, "Export is available as CSV, XLSX and JSON Lines")
+			return
+		}
+	}
+
+	if params.Username == "" {
+		writeError(w, http.StatusUnauthorized, "Username required")
+		return
+	}
+	isResponsible, err := service.AuthorizeTender(params.Username, tenderId, services.PermissionBidView)
+	if !checkAccess(w, r, isResponsible, err) {
+		return
+	}
+
+	archive, err := service.GetTenderArchive(tenderId)
+	if err != nil {
+		writeServiceError(w, r, err)
+		return
+	}
+
+	cache.Streaming(w)
+	w.Header().Set("Content-Type", tabular.ContentType(format))
+	w.Header().Set("Content-Disposition", `attachment; filename="tender-`+tenderId+`.`+format+`"`)
+	w.WriteHeader(http.StatusOK)
+	if err := tabular.Write(w, format, archiveTables(archive)); err != nil {
+		// Заголовки уже отправлены, остается только записать ошибку в журнал
+		logging.FromContext(r.Context()).Error("Failed to write export", "error", err)
+	}
+}
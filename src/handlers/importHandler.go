@@ -0,0 +1,248 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"zadanie_6105/src/api"
+	"zadanie_6105/src/models"
+	"zadanie_6105/src/services"
+	"zadanie_6105/src/tabular"
+)
+
+const (
+	maxImportSize = 10 << 20
+	maxImportRows = 1000
+)
+
+// Колонки файла импорта; регистр в заголовке не важен
+var (
+	importColumns         = []string{"name", "description", "serviceType", "organizationId", "deadline", "budget", "region", "rounds"}
+	requiredImportColumns = []string{"name", "description", "serviceType", "organizationId"}
+)
+
+// readImportFile достает файл из multipart-поля file или из тела запроса
+func readImportFile(r *http.Request, filename string) ([][]string, error) {
+	contentType := r.Header.Get("Content-Type")
+	if strings.HasPrefix(contentType, "multipart/form-data") {
+		if err := r.ParseMultipartForm(maxImportSize); err != nil {
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				return nil, err
+			}
+			return nil, errors.New("Invalid multipart form")
+		}
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			return nil, errors.New("Form field file is required")
+		}
+		defer file.Close()
+
+		format, err := tabular.DetectFormat(header.Header.Get("Content-Type"), header.Filename)
+		if err != nil {
+			return nil, err
+		}
+		return tabular.Read(file, format)
+	}
+
+	format, err := tabular.DetectFormat(contentType, filename)
+	if err != nil {
+		return nil, err
+	}
+	return tabular.Read(r.Body, format)
+}
+
+// importHeader сопоставляет колонки с их индексами в файле
+func importHeader(header []string) (map[string]int, error) {
+	columns := map[string]int{}
+	for i, title := range header {
+		if title == "" {
+			continue
+		}
+		column := importColumnName(title)
+		if column == "" {
+			return nil, fmt.Errorf("Unknown column %s", title)
+		}
+		if _, ok := columns[column]; ok {
+			return nil, fmt.Errorf("Column %s is duplicated", column)
+		}
+		columns[column] = i
+	}
+	for _, column := range requiredImportColumns {
+		if _, ok := columns[column]; !ok {
+			return nil, fmt.Errorf("Column %s is required", column)
+		}
+	}
+	return columns, nil
+}
+
+func importColumnName(title string) string {
+	for _, column := range importColumns {
+		if strings.EqualFold(title, column) {
+			return column
+		}
+	}
+	return ""
+}
+
+// parseImportRow собирает тендер из строки файла; ошибки разбора и проверки возвращаются списком
+func parseImportRow(row []string, columns map[string]int, username string, serviceTypes map[string]bool) (models.Tender, []string) {
+	value := func(column string) string {
+		i, ok := columns[column]
+		if !ok || i >= len(row) {
+			return ""
+		}
+		return row[i]
+	}
+
+	tender := models.Tender{
+		Name:            value("name"),
+		Description:     value("description"),
+		ServiceType:     value("serviceType"),
+		OrganizationId:  value("organizationId"),
+		Region:          value("region"),
+		CreatorUsername: username,
+		// Статус, раунд и версия - как у нового тендера, чтобы предпросмотр совпадал с результатом
+		Status:  "Created",
+		Rounds:  1,
+		Round:   1,
+		Version: 1,
+	}
+	var errs []string
+	if deadline := value("deadline"); deadline != "" {
+		parsed, err := tabular.ParseTime(deadline)
+		if err != nil {
+			errs = append(errs, "Deadline must be a date")
+		} else {
+			tender.Deadline = &parsed
+		}
+	}
+	if budget := value("budget"); budget != "" {
+		parsed, err := strconv.ParseFloat(budget, 64)
+		if err != nil {
+			errs = append(errs, "Budget must be a number")
+		} else {
+			tender.Budget = &parsed
+		}
+	}
+	if rounds := value("rounds"); rounds != "" {
+		parsed, err := strconv.ParseInt(rounds, 10, 32)
+		if err != nil {
+			errs = append(errs, "Rounds must be a number")
+		} else {
+			tender.Rounds = int32(parsed)
+		}
+	}
+	if reason := validateTender(&tender, serviceTypes); reason != "" {
+		errs = append(errs, reason)
+	}
+	return tender, errs
+}
+
+// ImportTenders создает тендеры из CSV или XLSX. Каждая строка проверяется как в CreateTender;
+// с dry_run=true возвращается только отчет, иначе тендеры создаются все вместе или ни один.
+func (s *Server) ImportTenders(w http.ResponseWriter, r *http.Request, params api.ImportTendersParams) {
+	service := s.service.WithContext(r.Context())
+	if params.Username == "" {
+		writeError(w, http.StatusUnauthorized, "Username required")
+		return
+	}
+	dryRun := params.DryRun != nil && *params.DryRun
+	filename := ""
+	if params.Filename != nil {
+		filename = *params.Filename
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxImportSize)
+	rows, err := readImportFile(r, filename)
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			writeError(w, http.StatusRequestEntityTooLarge, "File is too large")
+			return
+		}
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if len(rows) == 0 {
+		writeError(w, http.StatusBadRequest, "File is empty")
+		return
+	}
+	columns, err := importHeader(rows[0])
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	serviceTypes, err := service.ServiceTypeCodes()
+	if err != nil {
+		writeServiceError(w, r, err)
+		return
+	}
+
+	report := api.ImportReport{DryRun: dryRun, Rows: []api.ImportRow{}}
+	var tenders []models.Tender
+	// Права проверяются один раз на организацию
+	allowed := map[string]bool{}
+	failed := false
+	for i, row := range rows[1:] {
+		if tabular.IsEmpty(row) {
+			continue
+		}
+		if len(report.Rows) == maxImportRows {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("File can contain at most %d tenders", maxImportRows))
+			return
+		}
+
+		tender, errs := parseImportRow(row, columns, params.Username, serviceTypes)
+		if len(errs) == 0 {
+			isResponsible, ok := allowed[tender.OrganizationId]
+			if !ok {
+				isResponsible, err = service.Authorize(params.Username, tender.OrganizationId, services.PermissionTenderCreate)
+				if err != nil {
+					writeServiceError(w, r, err)
+					return
+				}
+				allowed[tender.OrganizationId] = isResponsible
+			}
+			if !isResponsible {
+				errs = append(errs, "This user is not responsible")
+			}
+		}
+
+		// Номер строки в файле, заголовок - строка 1
+		importRow := api.ImportRow{Row: i + 2}
+		if len(errs) > 0 {
+			importRow.Errors = &errs
+			failed = true
+		}
+		report.Rows = append(report.Rows, importRow)
+		tenders = append(tenders, tender)
+	}
+	if len(report.Rows) == 0 {
+		writeError(w, http.StatusBadRequest, "File has no tenders")
+		return
+	}
+
+	if failed && !dryRun {
+		reason := "Some rows are invalid, nothing was imported"
+		report.Reason = &reason
+		writeJSON(w, http.StatusBadRequest, report)
+		return
+	}
+	if !dryRun {
+		if err := service.ImportTenders(tenders); err != nil {
+			writeServiceError(w, r, err)
+			return
+		}
+		report.Imported = len(tenders)
+	}
+	for i := range report.Rows {
+		if report.Rows[i].Errors == nil {
+			tender := formatTenderToExport(&tenders[i])
+			report.Rows[i].Tender = &tender
+		}
+	}
+	writeJSON(w, http.StatusOK, report)
+}
@@ -0,0 +1,355 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/gorilla/mux"
+	"net/http"
+	"strconv"
+	"time"
+	"zadanie_6105/src/models"
+	"zadanie_6105/src/services"
+)
+
+func formatOrganizationToExport(organization *models.Organization) map[string]interface{} {
+	result := map[string]interface{}{
+		"id":          organization.ID.String(),
+		"name":        organization.Name,
+		"description": organization.Description,
+		"type":        organization.Type,
+		"createdAt":   organization.CreatedAt.Format(time.RFC3339),
+	}
+	return result
+}
+
+func formatOrganizationsToExport(organizations *[]models.Organization) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(*organizations))
+	for i, organization := range *organizations {
+		result[i] = formatOrganizationToExport(&organization)
+	}
+	return result
+}
+
+func formatResponsibleToExport(responsible *models.OrganizationResponsible) map[string]interface{} {
+	result := map[string]interface{}{
+		"id":             responsible.ID.String(),
+		"organizationId": responsible.OrganizationID.String(),
+		"role":           responsible.Role,
+		"employee":       formatEmployeeToExport(&responsible.Employee),
+	}
+	return result
+}
+
+func formatResponsiblesToExport(responsibles *[]models.OrganizationResponsible) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(*responsibles))
+	for i, responsible := range *responsibles {
+		result[i] = formatResponsibleToExport(&responsible)
+	}
+	return result
+}
+
+func checkOrganizationType(s string) bool {
+	list := []string{"IE", "LLC", "JSC"}
+	return checkParam(s, &list)
+}
+
+// checkAdmin пишет ошибку в ответ и возвращает false, если пользователь не администратор
+func checkAdmin(w http.ResponseWriter, service *services.Service, username string) bool {
+	if username == "" {
+		writeError(w, http.StatusUnauthorized, "Username required")
+		return false
+	}
+
+	isAdmin, err := service.AuthorizeAdmin(username)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, err.Error())
+		return false
+	}
+	if !isAdmin {
+		writeError(w, http.StatusForbidden, "This user is not admin")
+		return false
+	}
+	return true
+}
+
+// checkResponsibleManager пропускает администраторов сервиса и организации
+func checkResponsibleManager(w http.ResponseWriter, service *services.Service, username string, organizationID string) bool {
+	if username == "" {
+		writeError(w, http.StatusUnauthorized, "Username required")
+		return false
+	}
+
+	canManage, err := service.Authorize(username, organizationID, services.PermissionResponsibleManage)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, err.Error())
+		return false
+	}
+	if !canManage {
+		writeError(w, http.StatusForbidden, "This user is not responsible")
+		return false
+	}
+	return true
+}
+
+func GetOrganizations(service *services.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		service := service.WithContext(r.Context())
+		limitStr := r.URL.Query().Get("limit")
+		offsetStr := r.URL.Query().Get("offset")
+		username := r.URL.Query().Get("username")
+		limit := 5
+		offset := 0
+
+		if limitStr != "" {
+			if l, err := strconv.Atoi(limitStr); err == nil {
+				limit = l
+			} else {
+				writeError(w, http.StatusBadRequest, "Invalid paginationLimit")
+				return
+			}
+		}
+		if offsetStr != "" {
+			if o, err := strconv.Atoi(offsetStr); err == nil {
+				offset = o
+			} else {
+				writeError(w, http.StatusBadRequest, "Invalid paginationOffset")
+				return
+			}
+		}
+
+		if !checkAdmin(w, service, username) {
+			return
+		}
+
+		organizations, err := service.GetOrganizations(limit, offset)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		response := formatOrganizationsToExport(organizations)
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			writeError(w, http.StatusInternalServerError, "Failed to encode response")
+		}
+	}
+}
+
+func GetOrganization(service *services.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		service := service.WithContext(r.Context())
+		vars := mux.Vars(r)
+		organizationID := vars["organizationId"]
+		username := r.URL.Query().Get("username")
+
+		if !checkAdmin(w, service, username) {
+			return
+		}
+
+		organization, err := service.GetOrganization(organizationID)
+		if err != nil {
+			writeError(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		response := formatOrganizationToExport(organization)
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			writeError(w, http.StatusInternalServerError, "Failed to encode response")
+		}
+	}
+}
+
+func CreateOrganization(service *services.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		service := service.WithContext(r.Context())
+		username := r.URL.Query().Get("username")
+
+		var organization models.Organization
+		err := json.NewDecoder(r.Body).Decode(&organization)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "Invalid request payload")
+			return
+		}
+
+		// Check body
+		if organization.Name == "" || len(organization.Name) > 100 {
+			writeError(w, http.StatusBadRequest, "Name is required and must be at most 100 characters")
+			return
+		}
+		if !checkOrganizationType(organization.Type) {
+			writeError(w, http.StatusBadRequest, `Organization type can be only "IE", "LLC", "JSC"`)
+			return
+		}
+
+		if !checkAdmin(w, service, username) {
+			return
+		}
+
+		err = service.CreateOrganization(&organization)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		response := formatOrganizationToExport(&organization)
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			writeError(w, http.StatusInternalServerError, "Failed to encode response")
+		}
+	}
+}
+
+func UpdateOrganization(service *services.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		service := service.WithContext(r.Context())
+		vars := mux.Vars(r)
+		organizationID := vars["organizationId"]
+		username := r.URL.Query().Get("username")
+
+		var edit models.OrganizationEdit
+		err := json.NewDecoder(r.Body).Decode(&edit)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "Invalid request payload")
+			return
+		}
+
+		// Check body
+		if len(edit.Name) > 100 {
+			writeError(w, http.StatusBadRequest, "Name must be at most 100 characters")
+			return
+		}
+		if edit.Type != "" && !checkOrganizationType(edit.Type) {
+			writeError(w, http.StatusBadRequest, `Organization type can be only "IE", "LLC", "JSC"`)
+			return
+		}
+
+		if !checkAdmin(w, service, username) {
+			return
+		}
+
+		organization, err := service.UpdateOrganization(organizationID, &edit)
+		if err != nil {
+			writeError(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		response := formatOrganizationToExport(organization)
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			writeError(w, http.StatusInternalServerError, "Failed to encode response")
+		}
+	}
+}
+
+func DeleteOrganization(service *services.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		service := service.WithContext(r.Context())
+		vars := mux.Vars(r)
+		organizationID := vars["organizationId"]
+		username := r.URL.Query().Get("username")
+
+		if !checkAdmin(w, service, username) {
+			return
+		}
+
+		err := service.DeleteOrganization(organizationID)
+		if err != nil {
+			writeError(w, http.StatusConflict, err.Error())
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func GetResponsibles(service *services.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		service := service.WithContext(r.Context())
+		vars := mux.Vars(r)
+		organizationID := vars["organizationId"]
+		username := r.URL.Query().Get("username")
+
+		if !checkResponsibleManager(w, service, username, organizationID) {
+			return
+		}
+
+		responsibles, err := service.GetResponsibles(organizationID)
+		if err != nil {
+			writeError(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		response := formatResponsiblesToExport(responsibles)
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			writeError(w, http.StatusInternalServerError, "Failed to encode response")
+		}
+	}
+}
+
+func AssignResponsible(service *services.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		service := service.WithContext(r.Context())
+		vars := mux.Vars(r)
+		organizationID := vars["organizationId"]
+		employeeID := vars["employeeId"]
+		username := r.URL.Query().Get("username")
+		role := r.URL.Query().Get("role")
+
+		if role == "" {
+			role = models.RoleViewer
+		}
+		if !services.IsValidRole(role) {
+			writeError(w, http.StatusBadRequest, `Role can be only "OrgAdmin", "TenderAuthor", "Approver", "Viewer"`)
+			return
+		}
+
+		if !checkResponsibleManager(w, service, username, organizationID) {
+			return
+		}
+
+		responsible, err := service.AssignResponsible(organizationID, employeeID, role)
+		if err != nil {
+			writeError(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		response := formatResponsibleToExport(responsible)
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			writeError(w, http.StatusInternalServerError, "Failed to encode response")
+		}
+	}
+}
+
+func RemoveResponsible(service *services.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		service := service.WithContext(r.Context())
+		vars := mux.Vars(r)
+		organizationID := vars["organizationId"]
+		employeeID := vars["employeeId"]
+		username := r.URL.Query().Get("username")
+
+		if !checkResponsibleManager(w, service, username, organizationID) {
+			return
+		}
+
+		err := service.RemoveResponsible(organizationID, employeeID)
+		if errors.Is(err, services.ErrLastResponsible) {
+			writeError(w, http.StatusConflict, err.Error())
+			return
+		}
+		if err != nil {
+			writeError(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
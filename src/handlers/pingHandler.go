@@ -1,14 +0,0 @@
-package handlers
-
-import (
-	"net/http"
-)
-
-func Ping(w http.ResponseWriter, r *http.Request) {
-	_, err := w.Write([]byte("ok"))
-	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-	w.WriteHeader(http.StatusOK)
-}
@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"zadanie_6105/src/api"
+	"zadanie_6105/src/services"
+)
+
+// GetTenderProtocol отдает PDF-протокол закрытого тендера ответственным организации
+func (s *Server) GetTenderProtocol(w http.ResponseWriter, r *http.Request, tenderId api.TenderId, params api.GetTenderProtocolParams) {
+	service := s.service.WithContext(r.Context())
+	if params.Username == "" {
+		writeError(w, http.StatusUnauthorized, "Username required")
+		return
+	}
+	isResponsible, err := service.AuthorizeTender(params.Username, tenderId, services.PermissionBidView)
+	if !checkAccess(w, r, isResponsible, err) {
+		return
+	}
+
+	protocol, err := service.GetTenderProtocol(tenderId)
+	if err != nil {
+		writeServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", `inline; filename="protocol-`+tenderId+`.pdf"`)
+	w.Header().Set("Content-Length", strconv.Itoa(len(protocol.Content)))
+	w.Header().Set("Last-Modified", protocol.CreatedAt.UTC().Format(http.TimeFormat))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(protocol.Content)
+}
@@ -0,0 +1,133 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+	"zadanie_6105/src/api"
+	"zadanie_6105/src/models"
+	"zadanie_6105/src/services"
+)
+
+func formatShortlistToExport(shortlist []models.BidShortlist) []api.ShortlistEntry {
+	result := make([]api.ShortlistEntry, len(shortlist))
+	for i, entry := range shortlist {
+		result[i] = api.ShortlistEntry{
+			BidId:      entry.BidId.String(),
+			AuthorId:   entry.AuthorId.String(),
+			Round:      entry.Round,
+			EmployeeId: entry.EmployeeId.String(),
+			CreatedAt:  entry.CreatedAt.Format(time.RFC3339),
+		}
+	}
+	return result
+}
+
+// checkRound проверяет номер раунда из запроса; 0 в сервисе означает текущий раунд
+func checkRound(w http.ResponseWriter, round int32) bool {
+	if round < 1 {
+		writeError(w, http.StatusBadRequest, "Invalid round")
+		return false
+	}
+	return true
+}
+
+// authorizeTenderAction проверяет имя пользователя и его право в организации тендера
+func authorizeTenderAction(w http.ResponseWriter, r *http.Request, service *services.Service, username string, tenderId string, permission services.Permission) bool {
+	if !requireUsername(w, username) {
+		return false
+	}
+	isResponsible, err := service.AuthorizeTender(username, tenderId, permission)
+	return checkAccess(w, r, isResponsible, err)
+}
+
+// GetShortlist отдает шорт-лист раунда; без параметра round - текущего
+func (s *Server) GetShortlist(w http.ResponseWriter, r *http.Request, tenderId api.TenderId, params api.GetShortlistParams) {
+	service := s.service.WithContext(r.Context())
+	var round int32
+	if params.Round != nil {
+		if round = *params.Round; !checkRound(w, round) {
+			return
+		}
+	}
+	if !authorizeTenderAction(w, r, service, params.Username, tenderId, services.PermissionBidView) {
+		return
+	}
+
+	shortlist, err := service.GetShortlist(tenderId, round)
+	if err != nil {
+		writeServiceError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, formatShortlistToExport(shortlist))
+}
+
+// ShortlistBid добавляет предложение в шорт-лист текущего раунда и отдает шорт-лист
+func (s *Server) ShortlistBid(w http.ResponseWriter, r *http.Request, tenderId api.TenderId, bidId api.BidId, params api.ShortlistBidParams) {
+	service := s.service.WithContext(r.Context())
+	if !authorizeTenderAction(w, r, service, params.Username, tenderId, services.PermissionBidDecide) {
+		return
+	}
+
+	entry, err := service.ShortlistBid(tenderId, bidId, params.Username)
+	if err != nil {
+		writeServiceError(w, r, err)
+		return
+	}
+
+	shortlist, err := service.GetShortlist(tenderId, entry.Round)
+	if err != nil {
+		writeServiceError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, formatShortlistToExport(shortlist))
+}
+
+func (s *Server) RemoveFromShortlist(w http.ResponseWriter, r *http.Request, tenderId api.TenderId, bidId api.BidId, params api.RemoveFromShortlistParams) {
+	service := s.service.WithContext(r.Context())
+	if !authorizeTenderAction(w, r, service, params.Username, tenderId, services.PermissionBidDecide) {
+		return
+	}
+
+	if err := service.RemoveFromShortlist(tenderId, bidId); err != nil {
+		writeServiceError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// AdvanceTenderRound открывает следующий раунд для авторов из шорт-листа
+func (s *Server) AdvanceTenderRound(w http.ResponseWriter, r *http.Request, tenderId api.TenderId, params api.AdvanceTenderRoundParams) {
+	service := s.service.WithContext(r.Context())
+	if !authorizeTenderAction(w, r, service, params.Username, tenderId, services.PermissionTenderEdit) {
+		return
+	}
+
+	tender, err := service.AdvanceTenderRound(tenderId)
+	if err != nil {
+		writeServiceError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, formatTenderToExport(tender))
+}
+
+// GetRoundBids отдает опубликованные предложения в версиях, поданных в раунде
+func (s *Server) GetRoundBids(w http.ResponseWriter, r *http.Request, tenderId api.TenderId, round api.TenderRound, params api.GetRoundBidsParams) {
+	service := s.service.WithContext(r.Context())
+	if !checkRound(w, round) {
+		return
+	}
+	if !authorizeTenderAction(w, r, service, params.Username, tenderId, services.PermissionBidView) {
+		return
+	}
+
+	bids, err := service.GetRoundBids(tenderId, round)
+	if err != nil {
+		writeServiceError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, formatBidsToExport(&bids))
+}
@@ -0,0 +1,260 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+	"zadanie_6105/src/api"
+	"zadanie_6105/src/models"
+)
+
+func formatSavedSearchToExport(search *models.SavedSearch) api.SavedSearch {
+	return api.SavedSearch{
+		Id:                   search.ID.String(),
+		Name:                 search.Name,
+		Filter:               formatTenderFilterToExport(&search.Filter),
+		IncludeSubcategories: search.IncludeSubcategories,
+		Notify:               search.Notify,
+		CreatedAt:            search.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func formatTenderFilterToExport(filter *models.TenderFilter) api.TenderFilter {
+	result := api.TenderFilter{
+		CreatedFrom:  filter.CreatedFrom,
+		CreatedTo:    filter.CreatedTo,
+		DeadlineFrom: filter.DeadlineFrom,
+		DeadlineTo:   filter.DeadlineTo,
+		BudgetMin:    filter.BudgetMin,
+		BudgetMax:    filter.BudgetMax,
+	}
+	if len(filter.ServiceTypes) > 0 {
+		result.ServiceTypes = &filter.ServiceTypes
+	}
+	if len(filter.OrganizationIDs) > 0 {
+		result.OrganizationIds = &filter.OrganizationIDs
+	}
+	if len(filter.OrganizationTypes) > 0 {
+		organizationTypes := make([]api.TenderFilterOrganizationTypes, len(filter.OrganizationTypes))
+		for i, organizationType := range filter.OrganizationTypes {
+			organizationTypes[i] = api.TenderFilterOrganizationTypes(organizationType)
+		}
+		result.OrganizationTypes = &organizationTypes
+	}
+	if len(filter.Regions) > 0 {
+		result.Regions = &filter.Regions
+	}
+	if filter.Search != "" {
+		result.Search = &filter.Search
+	}
+	if filter.Sort != "" {
+		sort := api.TenderFilterSort(filter.Sort)
+		result.Sort = &sort
+	}
+	if filter.Descending {
+		result.Descending = &filter.Descending
+	}
+	return result
+}
+
+// parseTenderFilter переводит фильтр из тела запроса в фильтр сервиса
+func parseTenderFilter(filter *api.TenderFilter) models.TenderFilter {
+	result := models.TenderFilter{
+		CreatedFrom:  filter.CreatedFrom,
+		CreatedTo:    filter.CreatedTo,
+		DeadlineFrom: filter.DeadlineFrom,
+		DeadlineTo:   filter.DeadlineTo,
+		BudgetMin:    filter.BudgetMin,
+		BudgetMax:    filter.BudgetMax,
+	}
+	if filter.ServiceTypes != nil {
+		result.ServiceTypes = *filter.ServiceTypes
+	}
+	if filter.OrganizationIds != nil {
+		result.OrganizationIDs = *filter.OrganizationIds
+	}
+	if filter.OrganizationTypes != nil {
+		for _, organizationType := range *filter.OrganizationTypes {
+			result.OrganizationTypes = append(result.OrganizationTypes, string(organizationType))
+		}
+	}
+	if filter.Regions != nil {
+		result.Regions = *filter.Regions
+	}
+	if filter.Search != nil {
+		result.Search = *filter.Search
+	}
+	if filter.Sort != nil {
+		result.Sort = string(*filter.Sort)
+	}
+	if filter.Descending != nil {
+		result.Descending = *filter.Descending
+	}
+	return result
+}
+
+// checkSavedSearch проверяет название и фильтр сохраненного поиска
+func checkSavedSearch(w http.ResponseWriter, name *string, filter *models.TenderFilter) bool {
+	if name != nil {
+		if *name == "" {
+			writeError(w, http.StatusBadRequest, "Name can not be empty")
+			return false
+		}
+		if !checkLength(w, "name", *name, maxNameLength) {
+			return false
+		}
+	}
+	if filter != nil {
+		filter.Search = strings.TrimSpace(filter.Search)
+		if reason := tenderFilterError(filter); reason != "" {
+			writeError(w, http.StatusBadRequest, reason)
+			return false
+		}
+	}
+	return true
+}
+
+// requireUsername пишет 401, если имя пользователя не передано
+func requireUsername(w http.ResponseWriter, username string) bool {
+	if username == "" {
+		writeError(w, http.StatusUnauthorized, "Username required")
+		return false
+	}
+	return true
+}
+
+// GetSavedSearches отдает поиски пользователя username
+func (s *Server) GetSavedSearches(w http.ResponseWriter, r *http.Request, params api.GetSavedSearchesParams) {
+	service := s.service.WithContext(r.Context())
+	if !requireUsername(w, params.Username) {
+		return
+	}
+
+	searches, err := service.GetSavedSearches(params.Username)
+	if err != nil {
+		writeServiceError(w, r, err)
+		return
+	}
+
+	response := make([]api.SavedSearch, len(searches))
+	for i := range searches {
+		response[i] = formatSavedSearchToExport(&searches[i])
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (s *Server) GetSavedSearch(w http.ResponseWriter, r *http.Request, searchId api.SavedSearchId, params api.GetSavedSearchParams) {
+	service := s.service.WithContext(r.Context())
+	if !requireUsername(w, params.Username) {
+		return
+	}
+
+	search, err := service.GetSavedSearch(params.Username, searchId)
+	if err != nil {
+		writeServiceError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, formatSavedSearchToExport(search))
+}
+
+func (s *Server) CreateSavedSearch(w http.ResponseWriter, r *http.Request, params api.CreateSavedSearchParams) {
+	service := s.service.WithContext(r.Context())
+
+	var body api.CreateSavedSearchJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	search := models.SavedSearch{Name: body.Name}
+	if body.Filter != nil {
+		search.Filter = parseTenderFilter(body.Filter)
+	}
+	if body.IncludeSubcategories != nil {
+		search.IncludeSubcategories = *body.IncludeSubcategories
+	}
+	if body.Notify != nil {
+		search.Notify = *body.Notify
+	}
+	if !checkSavedSearch(w, &search.Name, &search.Filter) {
+		return
+	}
+	if !requireUsername(w, params.Username) {
+		return
+	}
+
+	if err := service.CreateSavedSearch(params.Username, &search); err != nil {
+		writeServiceError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, formatSavedSearchToExport(&search))
+}
+
+func (s *Server) EditSavedSearch(w http.ResponseWriter, r *http.Request, searchId api.SavedSearchId, params api.EditSavedSearchParams) {
+	service := s.service.WithContext(r.Context())
+
+	var body api.EditSavedSearchJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	edit := models.SavedSearchEdit{
+		Name:                 body.Name,
+		IncludeSubcategories: body.IncludeSubcategories,
+		Notify:               body.Notify,
+	}
+	if body.Filter != nil {
+		filter := parseTenderFilter(body.Filter)
+		edit.Filter = &filter
+	}
+	if !checkSavedSearch(w, edit.Name, edit.Filter) {
+		return
+	}
+	if !requireUsername(w, params.Username) {
+		return
+	}
+
+	search, err := service.UpdateSavedSearch(params.Username, searchId, &edit)
+	if err != nil {
+		writeServiceError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, formatSavedSearchToExport(search))
+}
+
+func (s *Server) DeleteSavedSearch(w http.ResponseWriter, r *http.Request, searchId api.SavedSearchId, params api.DeleteSavedSearchParams) {
+	service := s.service.WithContext(r.Context())
+	if !requireUsername(w, params.Username) {
+		return
+	}
+
+	if err := service.DeleteSavedSearch(params.Username, searchId); err != nil {
+		writeServiceError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RunSavedSearch отдает опубликованные тендеры по сохраненному поиску, как GET /api/tenders
+func (s *Server) RunSavedSearch(w http.ResponseWriter, r *http.Request, searchId api.SavedSearchId, params api.RunSavedSearchParams) {
+	service := s.service.WithContext(r.Context())
+	limit, offset, ok := checkPagination(w, params.Limit, params.Offset)
+	if !ok {
+		return
+	}
+	if !requireUsername(w, params.Username) {
+		return
+	}
+
+	page, err := service.RunSavedSearch(params.Username, searchId, limit, offset)
+	if err != nil {
+		writeServiceError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, formatTendersToExport(&page.Tenders))
+}
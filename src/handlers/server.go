@@ -0,0 +1,127 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"zadanie_6105/src/api"
+	"zadanie_6105/src/logging"
+	"zadanie_6105/src/services"
+)
+
+const (
+	defaultLimit = 5
+	maxLimit     = 50
+)
+
+// Server реализует api.ServerInterface, сгенерированный по задание/openapi.yml
+type Server struct {
+	service *services.Service
+}
+
+var _ api.ServerInterface = (*Server)(nil)
+
+func NewServer(service *services.Service) *Server {
+	return &Server{service: service}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	body, err := json.Marshal(v)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "Failed to encode response")
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(body)
+}
+
+// writeError отвечает ошибкой в формате errorResponse из спецификации
+func writeError(w http.ResponseWriter, status int, reason string) {
+	body, _ := json.Marshal(api.ErrorResponse{Reason: reason})
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(body)
+}
+
+// writeServiceError выбирает код ответа по ошибке сервиса
+func writeServiceError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, services.ErrUserNotFound):
+		writeError(w, http.StatusUnauthorized, err.Error())
+	case errors.Is(err, services.ErrNotFound):
+		writeError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, services.ErrConflict):
+		writeError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, services.ErrInvalidInput):
+		writeError(w, http.StatusBadRequest, err.Error())
+	default:
+		logging.FromContext(r.Context()).Error("Request failed", "error", err)
+		writeError(w, http.StatusInternalServerError, "Internal server error")
+	}
+}
+
+// checkAccess пишет ошибку в ответ и возвращает false, если проверка прав не пройдена
+func checkAccess(w http.ResponseWriter, r *http.Request, allowed bool, err error) bool {
+	if err != nil {
+		writeServiceError(w, r, err)
+		return false
+	}
+	if !allowed {
+		writeError(w, http.StatusForbidden, "This user is not responsible")
+		return false
+	}
+	return true
+}
+
+// checkPagination подставляет значения по умолчанию и проверяет границы из спецификации
+func checkPagination(w http.ResponseWriter, limit, offset *int32) (int, int, bool) {
+	l, o := defaultLimit, 0
+	if limit != nil {
+		if *limit < 0 || *limit > maxLimit {
+			writeError(w, http.StatusBadRequest, "Invalid paginationLimit")
+			return 0, 0, false
+		}
+		l = int(*limit)
+	}
+	if offset != nil {
+		if *offset < 0 {
+			writeError(w, http.StatusBadRequest, "Invalid paginationOffset")
+			return 0, 0, false
+		}
+		o = int(*offset)
+	}
+	return l, o, true
+}
+
+// checkLength проверяет ограничения maxLength из спецификации
+func checkLength(w http.ResponseWriter, field string, value string, maxLength int) bool {
+	if reason := lengthError(field, value, maxLength); reason != "" {
+		writeError(w, http.StatusBadRequest, reason)
+		return false
+	}
+	return true
+}
+
+func lengthError(field string, value string, maxLength int) string {
+	if len([]rune(value)) > maxLength {
+		return field + " is too long"
+	}
+	return ""
+}
+
+// HandleRequestError отвечает на ошибки разбора параметров в сгенерированной обертке.
+// Отсутствие имени пользователя - это 401, как и для неизвестного пользователя.
+func HandleRequestError(w http.ResponseWriter, r *http.Request, err error) {
+	var required *api.RequiredParamError
+	if errors.As(err, &required) && (required.ParamName == "username" || required.ParamName == "requesterUsername") {
+		writeError(w, http.StatusUnauthorized, "Username required")
+		return
+	}
+	writeError(w, http.StatusBadRequest, err.Error())
+}
+
+func (s *Server) CheckServer(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain")
+	_, _ = w.Write([]byte("ok"))
+}
@@ -0,0 +1,174 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+	"zadanie_6105/src/api"
+	"zadanie_6105/src/mailer"
+	"zadanie_6105/src/models"
+)
+
+const maxServiceTypeNameLength = 100
+
+// Код вида услуг - как tenderServiceType в спецификации
+var serviceTypeCode = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_-]{0,49}$`)
+
+func formatServiceTypeToExport(serviceType *models.ServiceType, locale string) api.ServiceType {
+	return api.ServiceType{
+		Code:       serviceType.Code,
+		ParentCode: serviceType.ParentCode,
+		Name:       serviceType.Name(locale, mailer.DefaultLocale),
+		Names:      serviceType.Names,
+		CreatedAt:  serviceType.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// requestLocale выбирает язык названий: параметр locale, затем первый поддерживаемый
+// язык из Accept-Language, иначе язык по умолчанию
+func requestLocale(r *http.Request, locale *api.Locale) string {
+	if locale != nil && checkLocale(*locale) {
+		return *locale
+	}
+	for _, part := range strings.Split(r.Header.Get("Accept-Language"), ",") {
+		tag, _, _ := strings.Cut(strings.TrimSpace(part), ";")
+		language, _, _ := strings.Cut(strings.ToLower(tag), "-")
+		if checkLocale(language) {
+			return language
+		}
+	}
+	return mailer.DefaultLocale
+}
+
+// checkServiceTypeNames проверяет языки и длину названий
+func checkServiceTypeNames(names map[string]string) string {
+	for locale, name := range names {
+		if !checkLocale(locale) {
+			return fmt.Sprintf(`Unknown locale %q, names can be only "ru", "en"`, locale)
+		}
+		if reason := lengthError("name", name, maxServiceTypeNameLength); reason != "" {
+			return reason
+		}
+	}
+	return ""
+}
+
+// GetServiceTypes отдает справочник видов услуг всем пользователям
+func (s *Server) GetServiceTypes(w http.ResponseWriter, r *http.Request, params api.GetServiceTypesParams) {
+	service := s.service.WithContext(r.Context())
+
+	serviceTypes, err := service.GetServiceTypes()
+	if err != nil {
+		writeServiceError(w, r, err)
+		return
+	}
+
+	locale := requestLocale(r, params.Locale)
+	response := make([]api.ServiceType, len(serviceTypes))
+	for i := range serviceTypes {
+		response[i] = formatServiceTypeToExport(&serviceTypes[i], locale)
+	}
+	w.Header().Set("Vary", "Accept-Language")
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (s *Server) GetServiceType(w http.ResponseWriter, r *http.Request, code api.TenderServiceType, params api.GetServiceTypeParams) {
+	service := s.service.WithContext(r.Context())
+
+	serviceType, err := service.GetServiceType(code)
+	if err != nil {
+		writeServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Vary", "Accept-Language")
+	writeJSON(w, http.StatusOK, formatServiceTypeToExport(serviceType, requestLocale(r, params.Locale)))
+}
+
+func (s *Server) CreateServiceType(w http.ResponseWriter, r *http.Request, params api.CreateServiceTypeParams) {
+	service := s.service.WithContext(r.Context())
+
+	var body api.CreateServiceTypeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	// Check body
+	if !serviceTypeCode.MatchString(body.Code) {
+		writeError(w, http.StatusBadRequest, "Code must start with a letter and contain at most 50 letters, digits, '_' and '-'")
+		return
+	}
+	serviceType := models.ServiceType{Code: body.Code, Names: body.Names}
+	if body.ParentCode != nil && *body.ParentCode != "" {
+		serviceType.ParentCode = body.ParentCode
+	}
+	if len(serviceType.Names) == 0 {
+		writeError(w, http.StatusBadRequest, "At least one name is required")
+		return
+	}
+	if reason := checkServiceTypeNames(serviceType.Names); reason != "" {
+		writeError(w, http.StatusBadRequest, reason)
+		return
+	}
+
+	if !checkAdmin(w, service, params.Username) {
+		return
+	}
+
+	if err := service.CreateServiceType(&serviceType); err != nil {
+		writeServiceError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, formatServiceTypeToExport(&serviceType, requestLocale(r, params.Locale)))
+}
+
+func (s *Server) EditServiceType(w http.ResponseWriter, r *http.Request, code api.TenderServiceType, params api.EditServiceTypeParams) {
+	service := s.service.WithContext(r.Context())
+
+	var body api.EditServiceTypeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	edit := models.ServiceTypeEdit{ParentCode: body.ParentCode}
+	if body.Names != nil {
+		edit.Names = *body.Names
+	}
+	if reason := checkServiceTypeNames(edit.Names); reason != "" {
+		writeError(w, http.StatusBadRequest, reason)
+		return
+	}
+
+	if !checkAdmin(w, service, params.Username) {
+		return
+	}
+
+	serviceType, err := service.UpdateServiceType(code, &edit)
+	if err != nil {
+		writeServiceError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, formatServiceTypeToExport(serviceType, requestLocale(r, params.Locale)))
+}
+
+// DeleteServiceType удаляет вид услуг, если у него нет подкатегорий и тендеров
+func (s *Server) DeleteServiceType(w http.ResponseWriter, r *http.Request, code api.TenderServiceType, params api.DeleteServiceTypeParams) {
+	service := s.service.WithContext(r.Context())
+
+	if !checkAdmin(w, service, params.Username) {
+		return
+	}
+
+	if err := service.DeleteServiceType(code); err != nil {
+		writeServiceError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -2,31 +2,51 @@ package handlers
 
 import (
 	"encoding/json"
-	"github.com/gorilla/mux"
+	"fmt"
 	"net/http"
-	"strconv"
 	"strings"
 	"time"
+	"zadanie_6105/src/api"
 	"zadanie_6105/src/models"
 	"zadanie_6105/src/services"
 )
 
-func formatTenderToExport(tender *models.Tender) map[string]interface{} {
-	result := map[string]interface{}{
-		"id":             tender.ID.String(),
-		"name":           tender.Name,
-		"description":    tender.Description,
-		"serviceType":    tender.ServiceType,
-		"status":         tender.Status,
-		"organizationId": tender.OrganizationId,
-		"version":        tender.Version,
-		"createdAt":      tender.CreatedAt.Format(time.RFC3339),
+const (
+	maxNameLength        = 100
+	maxDescriptionLength = 500
+	maxIDLength          = 100
+	maxRegionLength      = 100
+	maxSearchLength      = 100
+	maxRounds            = 5
+)
+
+func formatTenderToExport(tender *models.Tender) api.Tender {
+	result := api.Tender{
+		Id:             tender.ID.String(),
+		Name:           tender.Name,
+		Description:    tender.Description,
+		ServiceType:    tender.ServiceType,
+		Status:         api.TenderStatus(tender.Status),
+		OrganizationId: tender.OrganizationId,
+		Rounds:         tender.Rounds,
+		Round:          tender.Round,
+		Sealed:         tender.Sealed,
+		Version:        tender.Version,
+		CreatedAt:      tender.CreatedAt.Format(time.RFC3339),
+		Budget:         tender.Budget,
+	}
+	if tender.Deadline != nil {
+		deadline := tender.Deadline.Format(time.RFC3339)
+		result.Deadline = &deadline
+	}
+	if tender.Region != "" {
+		result.Region = &tender.Region
 	}
 	return result
 }
 
-func formatTendersToExport(tenders *[]models.Tender) []map[string]interface{} {
-	result := make([]map[string]interface{}, len(*tenders))
+func formatTendersToExport(tenders *[]models.Tender) []api.Tender {
+	result := make([]api.Tender, len(*tenders))
 	for i, tender := range *tenders {
 		result[i] = formatTenderToExport(&tender)
 	}
@@ -47,309 +67,430 @@ func checkTenderStatus(s string) bool {
 	return checkParam(s, &list)
 }
 
-func checkServiceType(s string) bool {
-	list := []string{"Construction", "Delivery", "Manufacture"}
-	return checkParam(s, &list)
+// serviceTypeError возвращает причину отказа, если вида услуг нет в справочнике
+func serviceTypeError(serviceType string, serviceTypes map[string]bool) string {
+	if !serviceTypes[serviceType] {
+		return fmt.Sprintf("Unknown service type %q", serviceType)
+	}
+	return ""
 }
 
-func GetTenders(service *services.Service) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		limitStr := r.URL.Query().Get("limit")
-		offsetStr := r.URL.Query().Get("offset")
-		limit := 5
-		offset := 0
-
-		if limitStr != "" {
-			if l, err := strconv.Atoi(limitStr); err == nil {
-				limit = l
-			} else {
-				http.Error(w, "Invalid paginationLimit", http.StatusBadRequest)
-				return
-			}
-		}
-		if offsetStr != "" {
-			if o, err := strconv.Atoi(offsetStr); err == nil {
-				offset = o
-			} else {
-				http.Error(w, "Invalid paginationOffset", http.StatusBadRequest)
-				return
-			}
-		}
-
-		serviceTypeStr := r.URL.Query().Get("service_type")
-		var serviceTypes []string
-		if serviceTypeStr != "" {
-			serviceTypes = strings.Split(serviceTypeStr, ",")
-		}
+// validateTender проверяет поля нового тендера и возвращает причину отказа или пустую строку.
+// Правила общие для CreateTender и импорта из файла, serviceTypes - коды справочника.
+func validateTender(tender *models.Tender, serviceTypes map[string]bool) string {
+	if reason := serviceTypeError(tender.ServiceType, serviceTypes); reason != "" {
+		return reason
+	}
+	if tender.Name == "" || tender.Description == "" || tender.OrganizationId == "" {
+		return "Name, description and organizationId are required"
+	}
+	if reason := lengthError("name", tender.Name, maxNameLength); reason != "" {
+		return reason
+	}
+	if reason := lengthError("description", tender.Description, maxDescriptionLength); reason != "" {
+		return reason
+	}
+	if reason := lengthError("organizationId", tender.OrganizationId, maxIDLength); reason != "" {
+		return reason
+	}
+	if tender.Budget != nil && *tender.Budget < 0 {
+		return "Budget can not be negative"
+	}
+	if reason := lengthError("region", tender.Region, maxRegionLength); reason != "" {
+		return reason
+	}
+	if reason := roundsError(tender.Rounds); reason != "" {
+		return reason
+	}
+	if tender.Sealed && (tender.Deadline == nil || !tender.Deadline.After(time.Now())) {
+		return "Sealed tender requires a deadline in the future"
+	}
+	// Вскрытие закрывает прием предложений, поэтому следующим раундам их не получить
+	if tender.Sealed && tender.Rounds > 1 {
+		return "Sealed tender can have only one round"
+	}
+	return ""
+}
 
-		tenders, err := service.GetTenders(serviceTypes, limit, offset)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-		response := formatTendersToExport(tenders)
+func roundsError(rounds int32) string {
+	if rounds < 1 || rounds > maxRounds {
+		return fmt.Sprintf("Rounds must be from 1 to %d", maxRounds)
+	}
+	return ""
+}
 
-		w.Header().Set("Content-Type", "application/json")
-		if err := json.NewEncoder(w).Encode(response); err != nil {
-			http.Error(w, "Failed to encode response", http.StatusInternalServerError)
-		}
+// parseDeadline разбирает срок из тела запроса; nil означает, что срок не задан
+func parseDeadline(value *string) (*time.Time, bool) {
+	if value == nil {
+		return nil, true
+	}
+	deadline, err := time.Parse(time.RFC3339, *value)
+	if err != nil {
+		return nil, false
 	}
+	return &deadline, true
 }
 
-func CreateTender(service *services.Service) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		var tender models.Tender
-		err := json.NewDecoder(r.Body).Decode(&tender)
-		if err != nil {
-			http.Error(w, "Invalid request payload", http.StatusBadRequest)
-			return
+// tenderFilterError проверяет фильтр тендеров и возвращает причину отказа или пустую строку.
+// Правила общие для публичного списка и сохраненных поисков; виды услуг проверяет сервис.
+func tenderFilterError(filter *models.TenderFilter) string {
+	for _, organizationID := range filter.OrganizationIDs {
+		if organizationID == "" {
+			return "organization_id can not be empty"
 		}
-
-		if !checkServiceType(tender.ServiceType) {
-			http.Error(w, `Service type can be only "Construction", "Delivery", "Manufacture"`, http.StatusBadRequest)
-			return
+		if reason := lengthError("organization_id", organizationID, maxIDLength); reason != "" {
+			return reason
 		}
-
-		isResponsible, err := service.CheckIfUserIsResponsible(tender.CreatorUsername, tender.OrganizationId)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusUnauthorized)
-			return
+	}
+	for _, organizationType := range filter.OrganizationTypes {
+		if !checkOrganizationType(organizationType) {
+			return `Organization type can be only "IE", "LLC", "JSC"`
 		}
-		if !isResponsible {
-			http.Error(w, "This user is not responsible", http.StatusForbidden)
-			return
+	}
+	for _, region := range filter.Regions {
+		if region == "" {
+			return "region can not be empty"
 		}
-
-		err = service.CreateTender(&tender)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-			return
+		if reason := lengthError("region", region, maxRegionLength); reason != "" {
+			return reason
 		}
+	}
 
-		response := formatTenderToExport(&tender)
+	if filter.CreatedFrom != nil && filter.CreatedTo != nil && filter.CreatedFrom.After(*filter.CreatedTo) {
+		return "created_from can not be later than created_to"
+	}
+	if filter.DeadlineFrom != nil && filter.DeadlineTo != nil && filter.DeadlineFrom.After(*filter.DeadlineTo) {
+		return "deadline_from can not be later than deadline_to"
+	}
+	if filter.BudgetMin != nil && *filter.BudgetMin < 0 || filter.BudgetMax != nil && *filter.BudgetMax < 0 {
+		return "Budget can not be negative"
+	}
+	if filter.BudgetMin != nil && filter.BudgetMax != nil && *filter.BudgetMin > *filter.BudgetMax {
+		return "budget_min can not be greater than budget_max"
+	}
 
-		w.Header().Set("Content-Type", "application/json")
-		if err := json.NewEncoder(w).Encode(response); err != nil {
-			http.Error(w, "Failed to encode response", http.StatusInternalServerError)
-		}
+	if reason := lengthError("search", filter.Search, maxSearchLength); reason != "" {
+		return reason
+	}
+	list := []string{models.TenderSortName, models.TenderSortCreatedAt, models.TenderSortDeadline, models.TenderSortBudget}
+	if filter.Sort != "" && !checkParam(filter.Sort, &list) {
+		return `Sort can be only "name", "createdAt", "deadline", "budget" with optional "-" prefix`
 	}
+	return ""
 }
 
-func GetTendersByUser(service *services.Service) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		limitStr := r.URL.Query().Get("limit")
-		offsetStr := r.URL.Query().Get("offset")
-		username := r.URL.Query().Get("username")
-		limit := 5
-		offset := 0
-
-		if limitStr != "" {
-			if l, err := strconv.Atoi(limitStr); err == nil {
-				limit = l
-			} else {
-				http.Error(w, "Invalid paginationLimit", http.StatusBadRequest)
-				return
-			}
-		}
-		if offsetStr != "" {
-			if o, err := strconv.Atoi(offsetStr); err == nil {
-				offset = o
-			} else {
-				http.Error(w, "Invalid paginationOffset", http.StatusBadRequest)
-				return
-			}
-		}
-		if username == "" {
-			http.Error(w, "Username required", http.StatusUnauthorized)
-			return
-		}
-
-		tenders, err := service.GetTendersByUser(username, limit, offset)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusBadRequest)
+// checkTenderFilter собирает фильтр из параметров публичного списка и проверяет его.
+// Коды видов услуг проверяются по справочнику и при необходимости дополняются подкатегориями.
+func checkTenderFilter(w http.ResponseWriter, r *http.Request, service *services.Service, params *api.GetTendersParams) (*models.TenderFilter, bool) {
+	filter := &models.TenderFilter{
+		CreatedFrom:  params.CreatedFrom,
+		CreatedTo:    params.CreatedTo,
+		DeadlineFrom: params.DeadlineFrom,
+		DeadlineTo:   params.DeadlineTo,
+		BudgetMin:    params.BudgetMin,
+		BudgetMax:    params.BudgetMax,
+	}
+	if params.OrganizationId != nil {
+		filter.OrganizationIDs = *params.OrganizationId
+	}
+	if params.OrganizationType != nil {
+		for _, organizationType := range *params.OrganizationType {
+			filter.OrganizationTypes = append(filter.OrganizationTypes, string(organizationType))
 		}
-		response := formatTendersToExport(tenders)
+	}
+	if params.Region != nil {
+		filter.Regions = *params.Region
+	}
+	if params.Search != nil {
+		filter.Search = strings.TrimSpace(*params.Search)
+	}
+	if params.Sort != nil {
+		filter.Sort = strings.TrimPrefix(string(*params.Sort), "-")
+		filter.Descending = filter.Sort != string(*params.Sort)
+	}
+	if reason := tenderFilterError(filter); reason != "" {
+		writeError(w, http.StatusBadRequest, reason)
+		return nil, false
+	}
 
-		w.Header().Set("Content-Type", "application/json")
-		if err := json.NewEncoder(w).Encode(response); err != nil {
-			http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+	if params.ServiceType != nil {
+		includeSubcategories := params.IncludeSubcategories != nil && *params.IncludeSubcategories
+		var err error
+		if filter.ServiceTypes, err = service.ResolveServiceTypes(*params.ServiceType, includeSubcategories); err != nil {
+			writeServiceError(w, r, err)
+			return nil, false
 		}
 	}
+	return filter, true
 }
 
-func GetTenderStatus(service *services.Service) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		vars := mux.Vars(r)
-		tenderID := vars["tenderId"]
-		username := r.URL.Query().Get("username")
+func (s *Server) GetTenders(w http.ResponseWriter, r *http.Request, params api.GetTendersParams) {
+	service := s.service.WithContext(r.Context())
+	limit, offset, ok := checkPagination(w, params.Limit, params.Offset)
+	if !ok {
+		return
+	}
+	filter, ok := checkTenderFilter(w, r, service, &params)
+	if !ok {
+		return
+	}
 
-		if username == "" {
-			http.Error(w, "Username required", http.StatusUnauthorized)
-			return
-		}
+	page, err := service.GetTenderPage(filter, limit, offset)
+	if err != nil {
+		writeServiceError(w, r, err)
+		return
+	}
 
-		isResponsible, err := service.CheckIfUserIsResponsibleForTender(username, tenderID)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusUnauthorized)
-			return
-		}
-		if !isResponsible {
-			http.Error(w, "This user is not responsible", http.StatusForbidden)
-			return
-		}
+	// ETag и ответ 304 добавляет cache.Conditional
+	w.Header().Set("Last-Modified", page.ModifiedAt.UTC().Format(http.TimeFormat))
+	writeJSON(w, http.StatusOK, formatTendersToExport(&page.Tenders))
+}
 
-		status, err := service.GetTenderStatus(tenderID)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusNotFound)
-			return
-		}
+func (s *Server) CreateTender(w http.ResponseWriter, r *http.Request) {
+	service := s.service.WithContext(r.Context())
+	var body api.CreateTenderJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
 
-		_, err = w.Write([]byte(status))
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-			return
-		}
-		w.WriteHeader(http.StatusOK)
+	deadline, ok := parseDeadline(body.Deadline)
+	if !ok {
+		writeError(w, http.StatusBadRequest, "Deadline must be in RFC3339 format")
+		return
+	}
+	tender := models.Tender{
+		Name:            body.Name,
+		Description:     body.Description,
+		ServiceType:     body.ServiceType,
+		OrganizationId:  body.OrganizationId,
+		CreatorUsername: body.CreatorUsername,
+		Deadline:        deadline,
+		Budget:          body.Budget,
+		Rounds:          1,
+	}
+	if body.Region != nil {
+		tender.Region = *body.Region
+	}
+	if body.Rounds != nil {
+		tender.Rounds = *body.Rounds
+	}
+	if body.Sealed != nil {
+		tender.Sealed = *body.Sealed
+	}
+	serviceTypes, err := service.ServiceTypeCodes()
+	if err != nil {
+		writeServiceError(w, r, err)
+		return
 	}
+	if reason := validateTender(&tender, serviceTypes); reason != "" {
+		writeError(w, http.StatusBadRequest, reason)
+		return
+	}
+	if body.CreatorUsername == "" {
+		writeError(w, http.StatusUnauthorized, "Username required")
+		return
+	}
+
+	isResponsible, err := service.Authorize(body.CreatorUsername, body.OrganizationId, services.PermissionTenderCreate)
+	if !checkAccess(w, r, isResponsible, err) {
+		return
+	}
+
+	if err := service.CreateTender(&tender); err != nil {
+		writeServiceError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, formatTenderToExport(&tender))
 }
 
-func UpdateTenderStatus(service *services.Service) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		vars := mux.Vars(r)
-		tenderID := vars["tenderId"]
-		username := r.URL.Query().Get("username")
-		status := r.URL.Query().Get("status")
+func (s *Server) GetUserTenders(w http.ResponseWriter, r *http.Request, params api.GetUserTendersParams) {
+	service := s.service.WithContext(r.Context())
+	limit, offset, ok := checkPagination(w, params.Limit, params.Offset)
+	if !ok {
+		return
+	}
+	if params.Username == nil || *params.Username == "" {
+		writeError(w, http.StatusUnauthorized, "Username required")
+		return
+	}
 
-		if !checkTenderStatus(status) {
-			http.Error(w, `Status can be only "Created", "Published", "Closed"`, http.StatusBadRequest)
-		}
+	tenders, err := service.GetTendersByUser(*params.Username, limit, offset)
+	if err != nil {
+		writeServiceError(w, r, err)
+		return
+	}
 
-		if username == "" {
-			http.Error(w, "Username required", http.StatusUnauthorized)
-			return
-		}
+	writeJSON(w, http.StatusOK, formatTendersToExport(tenders))
+}
+
+// GetTenderStatus отдает статус опубликованного тендера всем,
+// а неопубликованного - только ответственным организации
+func (s *Server) GetTenderStatus(w http.ResponseWriter, r *http.Request, tenderId api.TenderId, params api.GetTenderStatusParams) {
+	service := s.service.WithContext(r.Context())
 
-		isResponsible, err := service.CheckIfUserIsResponsibleForTender(username, tenderID)
+	if params.Username != nil && *params.Username != "" {
+		isResponsible, err := service.AuthorizeTender(*params.Username, tenderId, services.PermissionTenderView)
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusUnauthorized)
+			writeServiceError(w, r, err)
 			return
 		}
-		if !isResponsible {
-			http.Error(w, "This user is not responsible", http.StatusForbidden)
+		if isResponsible {
+			status, err := service.GetTenderStatus(tenderId)
+			if err != nil {
+				writeServiceError(w, r, err)
+				return
+			}
+			writeJSON(w, http.StatusOK, status)
 			return
 		}
+	}
 
-		tender, err := service.UpdateTenderStatus(tenderID, status)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusNotFound)
-			return
+	isPublished, err := service.CheckIfTenderPublished(tenderId)
+	if err != nil {
+		writeServiceError(w, r, err)
+		return
+	}
+	if !isPublished {
+		if params.Username == nil || *params.Username == "" {
+			writeError(w, http.StatusUnauthorized, "Username required")
+		} else {
+			writeError(w, http.StatusForbidden, "This user is not responsible")
 		}
+		return
+	}
 
-		response := formatTenderToExport(tender)
+	writeJSON(w, http.StatusOK, api.TenderStatusPublished)
+}
 
-		w.Header().Set("Content-Type", "application/json")
-		if err := json.NewEncoder(w).Encode(response); err != nil {
-			http.Error(w, "Failed to encode response", http.StatusInternalServerError)
-		}
+func (s *Server) UpdateTenderStatus(w http.ResponseWriter, r *http.Request, tenderId api.TenderId, params api.UpdateTenderStatusParams) {
+	service := s.service.WithContext(r.Context())
+
+	if !checkTenderStatus(string(params.Status)) {
+		writeError(w, http.StatusBadRequest, `Status can be only "Created", "Published", "Closed"`)
+		return
 	}
-}
 
-func UpdateTender(service *services.Service) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
+	isResponsible, err := service.AuthorizeTender(params.Username, tenderId, services.PermissionTenderEdit)
+	if !checkAccess(w, r, isResponsible, err) {
+		return
+	}
 
-		vars := mux.Vars(r)
-		tenderID := vars["tenderId"]
-		username := r.URL.Query().Get("username")
+	tender, err := service.UpdateTenderStatus(tenderId, string(params.Status))
+	if err != nil {
+		writeServiceError(w, r, err)
+		return
+	}
 
-		var edit models.TenderEdit
-		err := json.NewDecoder(r.Body).Decode(&edit)
-		if err != nil {
-			http.Error(w, "Invalid request payload", http.StatusBadRequest)
-			return
-		}
+	writeJSON(w, http.StatusOK, formatTenderToExport(tender))
+}
+
+func (s *Server) EditTender(w http.ResponseWriter, r *http.Request, tenderId api.TenderId, params api.EditTenderParams) {
+	service := s.service.WithContext(r.Context())
+
+	var body api.EditTenderJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
 
-		// Check body
-		if !checkServiceType(edit.ServiceType) {
-			http.Error(w, `Service type can be only "Construction", "Delivery", "Manufacture"`, http.StatusBadRequest)
+	// Check body
+	var edit models.TenderEdit
+	if body.Name != nil {
+		if *body.Name == "" {
+			writeError(w, http.StatusBadRequest, "Name can not be empty")
 			return
 		}
-
-		// Check username
-		if username == "" {
-			http.Error(w, "Username required", http.StatusUnauthorized)
+		if !checkLength(w, "name", *body.Name, maxNameLength) {
 			return
 		}
-
-		isResponsible, err := service.CheckIfUserIsResponsibleForTender(username, tenderID)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusUnauthorized)
+		edit.Name = *body.Name
+	}
+	if body.Description != nil {
+		if *body.Description == "" {
+			writeError(w, http.StatusBadRequest, "Description can not be empty")
 			return
 		}
-		if !isResponsible {
-			http.Error(w, "This user is not responsible", http.StatusForbidden)
+		if !checkLength(w, "description", *body.Description, maxDescriptionLength) {
 			return
 		}
-
-		tender, err := service.UpdateTender(tenderID, &edit)
+		edit.Description = *body.Description
+	}
+	if body.ServiceType != nil {
+		serviceTypes, err := service.ServiceTypeCodes()
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusBadRequest)
+			writeServiceError(w, r, err)
 			return
 		}
-
-		response := formatTenderToExport(tender)
-
-		w.Header().Set("Content-Type", "application/json")
-		if err := json.NewEncoder(w).Encode(response); err != nil {
-			http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+		if reason := serviceTypeError(*body.ServiceType, serviceTypes); reason != "" {
+			writeError(w, http.StatusBadRequest, reason)
+			return
 		}
+		edit.ServiceType = *body.ServiceType
 	}
-}
-
-func RollbackTender(service *services.Service) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-
-		vars := mux.Vars(r)
-		tenderID := vars["tenderId"]
-		versionStr := vars["version"]
-		username := r.URL.Query().Get("username")
-		var version int32
-
-		// Check version
-		if versionStr != "" {
-			if v, err := strconv.Atoi(versionStr); err == nil {
-				version = int32(v)
-			} else {
-				http.Error(w, "Invalid version", http.StatusBadRequest)
-				return
-			}
-		}
-
-		// Check username
-		if username == "" {
-			http.Error(w, "Username required", http.StatusUnauthorized)
+	if body.Deadline != nil {
+		deadline, ok := parseDeadline(body.Deadline)
+		if !ok {
+			writeError(w, http.StatusBadRequest, "Deadline must be in RFC3339 format")
 			return
 		}
-
-		isResponsible, err := service.CheckIfUserIsResponsibleForTender(username, tenderID)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusUnauthorized)
+		edit.Deadline = deadline
+	}
+	if body.Budget != nil {
+		if *body.Budget < 0 {
+			writeError(w, http.StatusBadRequest, "Budget can not be negative")
 			return
 		}
-		if !isResponsible {
-			http.Error(w, "This user is not responsible", http.StatusForbidden)
+		edit.Budget = body.Budget
+	}
+	if body.Region != nil {
+		if !checkLength(w, "region", *body.Region, maxRegionLength) {
 			return
 		}
-
-		tender, err := service.RollbackTender(tenderID, version)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusBadRequest)
+		edit.Region = body.Region
+	}
+	if body.Rounds != nil {
+		if reason := roundsError(*body.Rounds); reason != "" {
+			writeError(w, http.StatusBadRequest, reason)
 			return
 		}
+		edit.Rounds = body.Rounds
+	}
 
-		response := formatTenderToExport(tender)
+	isResponsible, err := service.AuthorizeTender(params.Username, tenderId, services.PermissionTenderEdit)
+	if !checkAccess(w, r, isResponsible, err) {
+		return
+	}
 
-		w.Header().Set("Content-Type", "application/json")
-		if err := json.NewEncoder(w).Encode(response); err != nil {
-			http.Error(w, "Failed to encode response", http.StatusInternalServerError)
-		}
+	tender, err := service.UpdateTender(tenderId, &edit)
+	if err != nil {
+		writeServiceError(w, r, err)
+		return
 	}
+
+	writeJSON(w, http.StatusOK, formatTenderToExport(tender))
+}
+
+func (s *Server) RollbackTender(w http.ResponseWriter, r *http.Request, tenderId api.TenderId, version int32, params api.RollbackTenderParams) {
+	service := s.service.WithContext(r.Context())
+
+	// Check version
+	if version < 1 {
+		writeError(w, http.StatusBadRequest, "Invalid version")
+		return
+	}
+
+	isResponsible, err := service.AuthorizeTender(params.Username, tenderId, services.PermissionTenderEdit)
+	if !checkAccess(w, r, isResponsible, err) {
+		return
+	}
+
+	tender, err := service.RollbackTender(tenderId, version)
+	if err != nil {
+		writeServiceError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, formatTenderToExport(tender))
 }
@@ -0,0 +1,72 @@
+package idempotency
+
+import (
+	"context"
+	"sync"
+	"time"
+	"zadanie_6105/src/models"
+)
+
+type MemoryStore struct {
+	mu   sync.Mutex
+	keys map[string]*models.IdempotencyKey
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{keys: make(map[string]*models.IdempotencyKey)}
+}
+
+func (s *MemoryStore) Begin(_ context.Context, key string, requestHash string, ttl time.Duration) (*models.IdempotencyKey, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if existing, ok := s.keys[key]; ok && existing.ExpiresAt.After(now) {
+		record := *existing
+		return &record, false, nil
+	}
+
+	record := &models.IdempotencyKey{
+		Key:         key,
+		RequestHash: requestHash,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(ttl),
+	}
+	s.keys[key] = record
+	result := *record
+	return &result, true, nil
+}
+
+func (s *MemoryStore) Complete(_ context.Context, key string, statusCode int, contentType string, body []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if record, ok := s.keys[key]; ok {
+		record.Completed = true
+		record.StatusCode = statusCode
+		record.ContentType = contentType
+		record.ResponseBody = body
+	}
+	return nil
+}
+
+func (s *MemoryStore) Release(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.keys, key)
+	return nil
+}
+
+func (s *MemoryStore) Cleanup(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	for key, record := range s.keys {
+		if !record.ExpiresAt.After(now) {
+			delete(s.keys, key)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,211 @@
+package idempotency
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"github.com/gorilla/mux"
+	"io"
+	"net"
+	"net/http"
+	"time"
+	"zadanie_6105/src/logging"
+)
+
+const (
+	KeyHeader      = "Idempotency-Key"
+	ReplayedHeader = "Idempotent-Replayed"
+	DefaultTTL     = 24 * time.Hour
+
+	maxKeyLength  = 255
+	maxBodyLength = 1 << 20
+)
+
+type responseRecorder struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	if r.status == 0 {
+		r.status = status
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	if r.status == 0 {
+		r.status = http.StatusOK
+	}
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
+
+// Handler делает обработчик идемпотентным по заголовку Idempotency-Key: повторный запрос
+// с тем же ключом получает сохраненный ответ, а запрос с тем же ключом и другим телом - 422.
+// Ключи у каждого пользователя свои, у анонимных запросов - у каждого IP адреса.
+func Handler(store Store, ttl time.Duration, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clientKey := r.Header.Get(KeyHeader)
+		if clientKey == "" {
+			next(w, r)
+			return
+		}
+		if len(clientKey) > maxKeyLength {
+			writeError(w, http.StatusBadRequest, "Idempotency-Key is too long")
+			return
+		}
+
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxBodyLength+1))
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "Invalid request payload")
+			return
+		}
+		if len(body) > maxBodyLength {
+			writeError(w, http.StatusRequestEntityTooLarge, "Request payload is too large")
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		key := scopedKey(r, body, clientKey)
+		requestHash := hashRequest(r, body)
+		record, created, err := store.Begin(r.Context(), key, requestHash, ttl)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "Failed to check idempotency key")
+			return
+		}
+
+		if !created {
+			if record.RequestHash != requestHash {
+				writeError(w, http.StatusUnprocessableEntity, "Idempotency-Key was already used with a different request")
+				return
+			}
+			if !record.Completed {
+				writeError(w, http.StatusConflict, "A request with this Idempotency-Key is still in progress")
+				return
+			}
+			if record.ContentType != "" {
+				w.Header().Set("Content-Type", record.ContentType)
+			}
+			w.Header().Set(ReplayedHeader, "true")
+			w.WriteHeader(record.StatusCode)
+			_, _ = w.Write(record.ResponseBody)
+			return
+		}
+
+		defer func() {
+			if p := recover(); p != nil {
+				// Обработчик упал, не ответив: ключ освобождается, иначе повторы получали бы 409 до конца ttl
+				if err := store.Release(context.WithoutCancel(r.Context()), key); err != nil {
+					logging.FromContext(r.Context()).Error("Failed to release idempotency key", "error", err)
+				}
+				panic(p)
+			}
+		}()
+		recorder := &responseRecorder{ResponseWriter: w}
+		next(recorder, r)
+
+		// Ответ сохраняется отдельно от запроса, чтобы отмена клиентом не оставила ключ занятым
+		ctx := context.WithoutCancel(r.Context())
+		if recorder.status == 0 {
+			recorder.status = http.StatusOK
+		}
+		if recorder.status >= http.StatusInternalServerError {
+			err = store.Release(ctx, key)
+		} else {
+			err = store.Complete(ctx, key, recorder.status, w.Header().Get("Content-Type"), recorder.body.Bytes())
+		}
+		if err != nil {
+			logging.FromContext(ctx).Error("Failed to save idempotent response", "error", err)
+		}
+	}
+}
+
+// Middleware применяет Handler только к маршрутам из списка вида "POST /api/tenders/new";
+// маршрут определяется по шаблону пути gorilla/mux
+func Middleware(store Store, ttl time.Duration, routes ...string) func(http.Handler) http.Handler {
+	idempotentRoutes := make(map[string]bool, len(routes))
+	for _, route := range routes {
+		idempotentRoutes[route] = true
+	}
+
+	return func(next http.Handler) http.Handler {
+		idempotent := Handler(store, ttl, next.ServeHTTP)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if current := mux.CurrentRoute(r); current != nil {
+				if template, err := current.GetPathTemplate(); err == nil && idempotentRoutes[r.Method+" "+template] {
+					idempotent(w, r)
+					return
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, reason string) {
+	body, _ := json.Marshal(map[string]string{"reason": reason})
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(body)
+}
+
+// scopedKey привязывает ключ клиента к пользователю из параметров или тела запроса,
+// а для анонимного запроса - к IP адресу, чтобы чужой ключ не совпал с ним
+func scopedKey(r *http.Request, body []byte, key string) string {
+	caller := ""
+	query := r.URL.Query()
+	for _, name := range []string{"username", "requesterUsername"} {
+		if username := query.Get(name); username != "" {
+			caller = "user:" + username
+			break
+		}
+	}
+	if caller == "" {
+		var identity struct {
+			CreatorUsername string `json:"creatorUsername"`
+			AuthorId        string `json:"authorId"`
+		}
+		_ = json.Unmarshal(body, &identity)
+		switch {
+		case identity.CreatorUsername != "":
+			caller = "user:" + identity.CreatorUsername
+		case identity.AuthorId != "":
+			caller = "id:" + identity.AuthorId
+		default:
+			host, _, err := net.SplitHostPort(r.RemoteAddr)
+			if err != nil {
+				host = r.RemoteAddr
+			}
+			caller = "ip:" + host
+		}
+	}
+	hash := sha256.Sum256([]byte(caller + "\n" + key))
+	return hex.EncodeToString(hash[:])
+}
+
+func hashRequest(r *http.Request, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(r.Method + " " + r.URL.Path + "?" + r.URL.RawQuery + "\n"))
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func RunCleanup(ctx context.Context, store Store, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := store.Cleanup(ctx); err != nil {
+				logging.FromContext(ctx).Error("Failed to clean up idempotency keys", "error", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package idempotency
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// После паники обработчика повтор с тем же ключом выполняется заново, а не получает 409
+func TestHandlerReleasesKeyOnPanic(t *testing.T) {
+	store := NewMemoryStore()
+	calls := 0
+	handler := Handler(store, DefaultTTL, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			panic("handler failed")
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+	do := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest("POST", "/api/tenders/new", strings.NewReader(`{"name":"Тендер"}`))
+		req.Header.Set(KeyHeader, "key-1")
+		rec := httptest.NewRecorder()
+		handler(rec, req)
+		return rec
+	}
+
+	func() {
+		defer func() {
+			if p := recover(); p != "handler failed" {
+				t.Errorf("recovered %v, want the handler panic", p)
+			}
+		}()
+		do()
+	}()
+	if rec := do(); rec.Code != http.StatusCreated {
+		t.Fatalf("retry after panic: %d %s, want 201", rec.Code, rec.Body.String())
+	}
+	if rec := do(); rec.Code != http.StatusCreated || rec.Header().Get(ReplayedHeader) != "true" || calls != 2 {
+		t.Errorf("replay: %d, replayed %q, calls %d, want stored 201 without a new call", rec.Code, rec.Header().Get(ReplayedHeader), calls)
+	}
+}
+
+// Один и тот же ключ у разных пользователей и у разных анонимных адресов не пересекается
+func TestHandlerScopesKeyByCaller(t *testing.T) {
+	store := NewMemoryStore()
+	calls := 0
+	handler := Handler(store, DefaultTTL, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusCreated)
+	})
+	do := func(target, body, remoteAddr string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("POST", target, strings.NewReader(body))
+		req.Header.Set(KeyHeader, "shared-key")
+		req.RemoteAddr = remoteAddr
+		rec := httptest.NewRecorder()
+		handler(rec, req)
+		return rec
+	}
+
+	requests := []struct{ target, body, remoteAddr string }{
+		{"/api/tenders/new", `{"creatorUsername":"alice"}`, "10.0.0.1:1000"},
+		{"/api/tenders/new", `{"creatorUsername":"bob"}`, "10.0.0.1:1000"},
+		{"/api/bids/new", `{"authorId":"e1d3"}`, "10.0.0.1:1000"},
+		{"/api/bids/1/submit_decision?username=carol", "", "10.0.0.1:1000"},
+		{"/api/tenders/new", `{}`, "10.0.0.1:1000"},
+		{"/api/tenders/new", `{}`, "10.0.0.2:1000"},
+	}
+	for _, request := range requests {
+		if rec := do(request.target, request.body, request.remoteAddr); rec.Code != http.StatusCreated || rec.Header().Get(ReplayedHeader) != "" {
+			t.Errorf("POST %s %s from %s: %d, replayed %q, want a new 201", request.target, request.body, request.remoteAddr,
+				rec.Code, rec.Header().Get(ReplayedHeader))
+		}
+	}
+	if rec := do("/api/tenders/new", `{"creatorUsername":"alice"}`, "10.0.0.9:1000"); rec.Header().Get(ReplayedHeader) != "true" {
+		t.Errorf("retry by the same user: replayed %q, want true", rec.Header().Get(ReplayedHeader))
+	}
+	if calls != len(requests) {
+		t.Errorf("handler calls = %d, want %d", calls, len(requests))
+	}
+}
@@ -0,0 +1,72 @@
+package idempotency
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+	"zadanie_6105/src/models"
+)
+
+type PostgresStore struct {
+	db *gorm.DB
+}
+
+func NewPostgresStore(db *gorm.DB) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+func (s *PostgresStore) Begin(ctx context.Context, key string, requestHash string, ttl time.Duration) (*models.IdempotencyKey, bool, error) {
+	db := s.db.WithContext(ctx)
+	now := time.Now()
+
+	// Просроченный ключ можно занять заново
+	err := db.Where("key = ? AND expires_at <= ?", key, now).
+		Delete(&models.IdempotencyKey{}).Error
+	if err != nil {
+		return nil, false, err
+	}
+
+	record := models.IdempotencyKey{
+		Key:         key,
+		RequestHash: requestHash,
+		ExpiresAt:   now.Add(ttl),
+	}
+	result := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&record)
+	if result.Error != nil {
+		return nil, false, result.Error
+	}
+	if result.RowsAffected == 1 {
+		return &record, true, nil
+	}
+
+	var existing models.IdempotencyKey
+	if err := db.Where("key = ?", key).First(&existing).Error; err != nil {
+		return nil, false, err
+	}
+	return &existing, false, nil
+}
+
+func (s *PostgresStore) Complete(ctx context.Context, key string, statusCode int, contentType string, body []byte) error {
+	return s.db.WithContext(ctx).
+		Model(&models.IdempotencyKey{}).
+		Where("key = ?", key).
+		Updates(map[string]interface{}{
+			"completed":     true,
+			"status_code":   statusCode,
+			"content_type":  contentType,
+			"response_body": body,
+		}).Error
+}
+
+func (s *PostgresStore) Release(ctx context.Context, key string) error {
+	return s.db.WithContext(ctx).
+		Where("key = ?", key).
+		Delete(&models.IdempotencyKey{}).Error
+}
+
+func (s *PostgresStore) Cleanup(ctx context.Context) error {
+	return s.db.WithContext(ctx).
+		Where("expires_at <= ?", time.Now()).
+		Delete(&models.IdempotencyKey{}).Error
+}
@@ -0,0 +1,18 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+	"zadanie_6105/src/models"
+)
+
+type Store interface {
+	// Begin резервирует ключ. Если ключ уже занят, возвращает существующую запись и false.
+	Begin(ctx context.Context, key string, requestHash string, ttl time.Duration) (*models.IdempotencyKey, bool, error)
+	// Complete сохраняет ответ, который будет повторно отдаваться по этому ключу
+	Complete(ctx context.Context, key string, statusCode int, contentType string, body []byte) error
+	// Release освобождает ключ, если ответ сохранять не нужно
+	Release(ctx context.Context, key string) error
+	// Cleanup удаляет просроченные ключи
+	Cleanup(ctx context.Context) error
+}
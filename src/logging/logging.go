@@ -0,0 +1,26 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+	"os"
+)
+
+type loggerKey struct{}
+
+// Init делает JSON логгер логгером по умолчанию, в том числе для стандартного пакета log
+func Init() {
+	slog.SetDefault(slog.New(slog.NewJSONHandler(os.Stdout, nil)))
+}
+
+func WithLogger(ctx context.Context, logger *slog.Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, logger)
+}
+
+// FromContext возвращает логгер запроса или логгер по умолчанию
+func FromContext(ctx context.Context) *slog.Logger {
+	if logger, ok := ctx.Value(loggerKey{}).(*slog.Logger); ok {
+		return logger
+	}
+	return slog.Default()
+}
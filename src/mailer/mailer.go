@@ -0,0 +1,21 @@
+package mailer
+
+import "log/slog"
+
+type Message struct {
+	To      []string
+	Subject string
+	Body    string
+}
+
+type Mailer interface {
+	Send(msg Message) error
+}
+
+// LogMailer используется, когда SMTP не настроен: письма только пишутся в лог
+type LogMailer struct{}
+
+func (LogMailer) Send(msg Message) error {
+	slog.Info("Email is not sent, SMTP is not configured", "to", msg.To, "subject", msg.Subject)
+	return nil
+}
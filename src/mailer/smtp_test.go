@@ -0,0 +1,144 @@
+package mailer
+
+import (
+	"bufio"
+	"net"
+	"strings"
+	"testing"
+)
+
+// fakeSMTPServer принимает одно письмо и отдает его текст в канал
+func fakeSMTPServer(t *testing.T) (string, string, <-chan string) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	t.Cleanup(func() { _ = listener.Close() })
+
+	received := make(chan string, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		reader := bufio.NewReader(conn)
+		write := func(line string) { _, _ = conn.Write([]byte(line + "\r\n")) }
+
+		write("220 localhost fake SMTP")
+		var data strings.Builder
+		inData := false
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+			if inData {
+				if line == ".\r\n" {
+					inData = false
+					received <- data.String()
+					write("250 OK")
+					continue
+				}
+				data.WriteString(line)
+				continue
+			}
+
+			command := strings.ToUpper(strings.TrimSpace(line))
+			switch {
+			case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+				write("250 localhost")
+			case strings.HasPrefix(command, "DATA"):
+				inData = true
+				write("354 Start mail input")
+			case strings.HasPrefix(command, "QUIT"):
+				write("221 Bye")
+				return
+			default:
+				write("250 OK")
+			}
+		}
+	}()
+
+	host, port, _ := net.SplitHostPort(listener.Addr().String())
+	return host, port, received
+}
+
+func TestSMTPMailerSend(t *testing.T) {
+	host, port, received := fakeSMTPServer(t)
+	m := NewSMTPMailer(host, port, "", "", "tenders@example.com")
+
+	subject, body, err := Render(LocaleRu, TemplateBidPublished, map[string]string{
+		"TenderName": "Дорога",
+		"BidName":    "Асфальт",
+	})
+	if err != nil {
+		t.Fatalf("render failed: %v", err)
+	}
+
+	err = m.Send(Message{To: []string{"user@example.com"}, Subject: subject, Body: body})
+	if err != nil {
+		t.Fatalf("send failed: %v", err)
+	}
+
+	data := <-received
+	if !strings.Contains(data, "To: user@example.com") {
+		t.Errorf("recipient header missing:\n%s", data)
+	}
+	if !strings.Contains(data, "Subject: =?UTF-8?q?") {
+		t.Errorf("subject is not encoded:\n%s", data)
+	}
+	if !strings.Contains(data, "«Асфальт»") {
+		t.Errorf("body is not rendered:\n%s", data)
+	}
+}
+
+func TestBuildMessageRejectsHeaderInjection(t *testing.T) {
+	m := NewSMTPMailer("localhost", "", "", "", "tenders@example.com")
+
+	data, err := m.buildMessage(Message{
+		To:      []string{"user@example.com"},
+		Subject: "Тендер «x»\r\nBcc: victim@example.com",
+		Body:    "Текст",
+	})
+	if err != nil {
+		t.Fatalf("build failed: %v", err)
+	}
+	header, _, _ := strings.Cut(string(data), "\r\n\r\n")
+	for _, line := range strings.Split(header, "\r\n") {
+		if strings.HasPrefix(line, "Bcc:") {
+			t.Errorf("subject injected a header:\n%s", header)
+		}
+	}
+
+	ascii, err := m.buildMessage(Message{To: []string{"user@example.com"}, Subject: "x\nBcc: victim@example.com"})
+	if err != nil {
+		t.Fatalf("build failed: %v", err)
+	}
+	if strings.Contains(string(ascii), "\nBcc:") {
+		t.Errorf("ASCII subject injected a header:\n%s", ascii)
+	}
+
+	if _, err := m.buildMessage(Message{To: []string{"user@example.com\r\nBcc: victim@example.com"}}); err == nil {
+		t.Error("recipient with a line break was accepted")
+	}
+}
+
+func TestRenderFallsBackToDefaultLocale(t *testing.T) {
+	subject, _, err := Render("de", TemplateBidApproved, map[string]string{"TenderName": "Дорога"})
+	if err != nil {
+		t.Fatalf("render failed: %v", err)
+	}
+	if subject != "Тендер «Дорога» закрыт" {
+		t.Errorf("unexpected subject %q", subject)
+	}
+
+	subject, _, err = Render(LocaleEn, TemplateBidApproved, map[string]string{"TenderName": "Road"})
+	if err != nil {
+		t.Fatalf("render failed: %v", err)
+	}
+	if subject != `Tender "Road" is closed` {
+		t.Errorf("unexpected subject %q", subject)
+	}
+}
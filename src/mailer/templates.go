@@ -0,0 +1,109 @@
+package mailer
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+)
+
+const (
+	TemplateBidPublished  = "bid_published"
+	TemplateBidApproved   = "bid_approved"
+	TemplateTenderMatched = "tender_matched"
+
+	LocaleRu      = "ru"
+	LocaleEn      = "en"
+	DefaultLocale = LocaleRu
+)
+
+type messageTemplate struct {
+	subject *template.Template
+	body    *template.Template
+}
+
+var templates = map[string]map[string]messageTemplate{
+	LocaleRu: {
+		TemplateBidPublished: newTemplate(
+			`Новое предложение по тендеру «{{.TenderName}}»`,
+			`Здравствуйте{{if .FirstName}}, {{.FirstName}}{{end}}!
+
+По тендеру «{{.TenderName}}» опубликовано предложение «{{.BidName}}».
+
+Тендер: {{.TenderID}}
+Предложение: {{.BidID}}
+`),
+		TemplateBidApproved: newTemplate(
+			`Тендер «{{.TenderName}}» закрыт`,
+			`Здравствуйте{{if .FirstName}}, {{.FirstName}}{{end}}!
+
+Предложение «{{.BidName}}» согласовано, тендер «{{.TenderName}}» закрыт.
+
+Тендер: {{.TenderID}}
+Предложение: {{.BidID}}
+`),
+		TemplateTenderMatched: newTemplate(
+			`{{if .Updated}}Изменен{{else}}Опубликован{{end}} тендер «{{.TenderName}}»`,
+			`Здравствуйте{{if .FirstName}}, {{.FirstName}}{{end}}!
+
+{{if .Updated}}Изменен{{else}}Опубликован{{end}} тендер «{{.TenderName}}», подходящий под ваш поиск «{{.SearchName}}».
+
+Тендер: {{.TenderID}}
+`),
+	},
+	LocaleEn: {
+		TemplateBidPublished: newTemplate(
+			`New bid for tender "{{.TenderName}}"`,
+			`Hello{{if .FirstName}}, {{.FirstName}}{{end}}!
+
+Bid "{{.BidName}}" has been published for tender "{{.TenderName}}".
+
+Tender: {{.TenderID}}
+Bid: {{.BidID}}
+`),
+		TemplateBidApproved: newTemplate(
+			`Tender "{{.TenderName}}" is closed`,
+			`Hello{{if .FirstName}}, {{.FirstName}}{{end}}!
+
+Bid "{{.BidName}}" has been approved and tender "{{.TenderName}}" is closed.
+
+Tender: {{.TenderID}}
+Bid: {{.BidID}}
+`),
+		TemplateTenderMatched: newTemplate(
+			`Tender "{{.TenderName}}" {{if .Updated}}updated{{else}}published{{end}}`,
+			`Hello{{if .FirstName}}, {{.FirstName}}{{end}}!
+
+Tender "{{.TenderName}}" matching your saved search "{{.SearchName}}" has been {{if .Updated}}updated{{else}}published{{end}}.
+
+Tender: {{.TenderID}}
+`),
+	},
+}
+
+func newTemplate(subject, body string) messageTemplate {
+	return messageTemplate{
+		subject: template.Must(template.New("subject").Parse(subject)),
+		body:    template.Must(template.New("body").Parse(body)),
+	}
+}
+
+// Render возвращает тему и текст письма. Для неизвестной локали используется русская.
+func Render(locale string, name string, data interface{}) (string, string, error) {
+	localized, ok := templates[locale]
+	if !ok {
+		localized = templates[DefaultLocale]
+	}
+	tmpl, ok := localized[name]
+	if !ok {
+		return "", "", fmt.Errorf("unknown email template %q", name)
+	}
+
+	var subject, body bytes.Buffer
+	if err := tmpl.subject.Execute(&subject, data); err != nil {
+		return "", "", err
+	}
+	if err := tmpl.body.Execute(&body, data); err != nil {
+		return "", "", err
+	}
+	return subject.String(), body.String(), nil
+}
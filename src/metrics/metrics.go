@@ -0,0 +1,50 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	HTTPRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "http_requests_total",
+		Help: "Total number of HTTP requests by route template.",
+	}, []string{"method", "route", "status"})
+
+	HTTPRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "http_request_duration_seconds",
+		Help:    "HTTP request latency by route template.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method", "route"})
+
+	DBQueryDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "gorm_query_duration_seconds",
+		Help:    "Duration of SQL queries executed through GORM.",
+		Buckets: []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1},
+	}, []string{"operation", "table"})
+
+	TendersCreated = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "tenders_created_total",
+		Help: "Number of created tenders.",
+	})
+
+	TendersPublished = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "tenders_published_total",
+		Help: "Number of tender publications.",
+	})
+
+	TendersClosed = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "tenders_closed_total",
+		Help: "Number of closed tenders.",
+	})
+
+	BidsCreated = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "bids_created_total",
+		Help: "Number of created bids.",
+	})
+
+	BidDecisions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "bid_decisions_total",
+		Help: "Number of bid decisions by outcome.",
+	}, []string{"outcome"})
+)
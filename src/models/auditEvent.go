@@ -0,0 +1,23 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Действия журнала аудита
+const (
+	AuditBidsUnsealed = "BidsUnsealed"
+)
+
+// AuditEvent - запись журнала аудита тендера
+type AuditEvent struct {
+	ID       uuid.UUID `json:"id" gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	TenderId uuid.UUID `json:"tenderId" gorm:"type:uuid;not null;index"`
+	Action   string    `json:"action" gorm:"type:varchar(50);not null"`
+	// EmployeeId - сотрудник, совершивший действие; nil - действие выполнил сервис
+	EmployeeId *uuid.UUID             `json:"employeeId,omitempty" gorm:"type:uuid"`
+	Details    map[string]interface{} `json:"details,omitempty" gorm:"type:jsonb;serializer:json"`
+	CreatedAt  time.Time              `json:"createdAt" gorm:"not null"`
+}
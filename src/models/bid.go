@@ -7,17 +7,77 @@ import (
 )
 
 type Bid struct {
-	ID          uuid.UUID `json:"id" gorm:"type:uuid;default:uuid_generate_v4()"`
-	VersionID   uuid.UUID `json:"versionId" gorm:"type:uuid;default:uuid_generate_v4();primary_key"`
+	ID          uuid.UUID `json:"id" gorm:"type:uuid;default:uuid_generate_v4();primary_key"`
+	VersionID   uuid.UUID `json:"versionId" gorm:"type:uuid;default:uuid_generate_v4();uniqueIndex"`
 	Name        string    `json:"name" gorm:"type:varchar(100);not null"`
 	Description string    `json:"description" gorm:"type:text;not null"`
 	Status      string    `json:"status" gorm:"type:varchar(20);default:'Created';not null"`
-	TenderId    uuid.UUID `json:"tenderId" gorm:"not null"`
+	TenderId    uuid.UUID `json:"tenderId" gorm:"not null;index"`
 	AuthorType  string    `json:"authorType" gorm:"type:varchar(20);not null"`
-	AuthorId    uuid.UUID `json:"authorId" gorm:"not null"`
-	Author      Employee  `json:"author" gorm:"foreignkey:AuthorId;references:id"`
-	Version     int32     `json:"version" gorm:"default:1;not null"`
-	CreatedAt   time.Time `json:"createdAt" gorm:"autoCreateTime"`
+	AuthorId    uuid.UUID `json:"authorId" gorm:"not null;index"`
+	Author      Employee  `json:"-" gorm:"foreignkey:AuthorId;references:id;constraint:-"`
+	// Round - раунд тендера, в котором подана эта версия предложения
+	Round int32 `json:"round" gorm:"default:1;not null"`
+	// Sealed - название и описание, зашифрованные ключом запечатанного тендера; пока оно задано,
+	// Name и Description пусты. Commitment - SHA-256 содержимого, по нему после вскрытия
+	// можно убедиться, что содержимое не менялось.
+	Sealed     []byte    `json:"sealed,omitempty" gorm:"type:bytea"`
+	Commitment string    `json:"commitment,omitempty" gorm:"type:varchar(64);not null;default:''"`
+	Version    int32     `json:"version" gorm:"default:1;not null"`
+	CreatedAt  time.Time `json:"createdAt" gorm:"autoCreateTime"`
+}
+
+// BidVersion - прошлая версия предложения в таблице bid_versions
+type BidVersion struct {
+	VersionID   uuid.UUID `gorm:"type:uuid;default:uuid_generate_v4();primary_key"`
+	ID          uuid.UUID `gorm:"type:uuid;not null;index:idx_bid_versions_id_version"`
+	Name        string    `gorm:"type:varchar(100);not null"`
+	Description string    `gorm:"type:text;not null"`
+	Status      string    `gorm:"type:varchar(20);default:'Created';not null"`
+	TenderId    uuid.UUID `gorm:"not null;index:idx_bid_versions_tender_round"`
+	AuthorType  string    `gorm:"type:varchar(20);not null"`
+	AuthorId    uuid.UUID `gorm:"not null"`
+	Round       int32     `gorm:"default:1;not null;index:idx_bid_versions_tender_round"`
+	Sealed      []byte    `gorm:"type:bytea"`
+	Commitment  string    `gorm:"type:varchar(64);not null;default:''"`
+	Version     int32     `gorm:"not null;index:idx_bid_versions_id_version"`
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+}
+
+func NewBidVersion(bid Bid) BidVersion {
+	return BidVersion{
+		VersionID:   bid.VersionID,
+		ID:          bid.ID,
+		Name:        bid.Name,
+		Description: bid.Description,
+		Status:      bid.Status,
+		TenderId:    bid.TenderId,
+		AuthorType:  bid.AuthorType,
+		AuthorId:    bid.AuthorId,
+		Round:       bid.Round,
+		Sealed:      bid.Sealed,
+		Commitment:  bid.Commitment,
+		Version:     bid.Version,
+		CreatedAt:   bid.CreatedAt,
+	}
+}
+
+func (v BidVersion) Bid() Bid {
+	return Bid{
+		ID:          v.ID,
+		VersionID:   v.VersionID,
+		Name:        v.Name,
+		Description: v.Description,
+		Status:      v.Status,
+		TenderId:    v.TenderId,
+		AuthorType:  v.AuthorType,
+		AuthorId:    v.AuthorId,
+		Round:       v.Round,
+		Sealed:      v.Sealed,
+		Commitment:  v.Commitment,
+		Version:     v.Version,
+		CreatedAt:   v.CreatedAt,
+	}
 }
 
 type BidEdit struct {
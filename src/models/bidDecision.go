@@ -0,0 +1,16 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BidDecision - голос ответственного по предложению
+type BidDecision struct {
+	ID         uuid.UUID `json:"id" gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	BidId      uuid.UUID `json:"bidId" gorm:"type:uuid;not null;uniqueIndex:idx_bid_decision_author"`
+	EmployeeId uuid.UUID `json:"employeeId" gorm:"type:uuid;not null;uniqueIndex:idx_bid_decision_author"`
+	Decision   string    `json:"decision" gorm:"type:varchar(20);not null"`
+	CreatedAt  time.Time `json:"createdAt" gorm:"autoCreateTime"`
+}
@@ -0,0 +1,18 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BidShortlist - предложение, прошедшее в следующий раунд тендера
+type BidShortlist struct {
+	ID         uuid.UUID `json:"id" gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	TenderId   uuid.UUID `json:"tenderId" gorm:"type:uuid;not null;uniqueIndex:idx_bid_shortlist_round"`
+	Round      int32     `json:"round" gorm:"not null;uniqueIndex:idx_bid_shortlist_round"`
+	BidId      uuid.UUID `json:"bidId" gorm:"type:uuid;not null;uniqueIndex:idx_bid_shortlist_round"`
+	AuthorId   uuid.UUID `json:"authorId" gorm:"type:uuid;not null;index"`
+	EmployeeId uuid.UUID `json:"employeeId" gorm:"type:uuid;not null"`
+	CreatedAt  time.Time `json:"createdAt" gorm:"autoCreateTime"`
+}
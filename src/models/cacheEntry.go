@@ -0,0 +1,10 @@
+package models
+
+import "time"
+
+// CacheEntry - значение общего кеша ответов
+type CacheEntry struct {
+	Key       string    `gorm:"type:varchar(255);primaryKey"`
+	Value     []byte    `gorm:"type:bytea;not null"`
+	ExpiresAt time.Time `gorm:"not null;index"`
+}
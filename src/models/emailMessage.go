@@ -0,0 +1,27 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	EmailStatusPending = "Pending"
+	EmailStatusSent    = "Sent"
+	EmailStatusFailed  = "Failed"
+)
+
+// EmailMessage - письмо в очереди на отправку
+type EmailMessage struct {
+	ID            uuid.UUID  `json:"id" gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	Recipient     string     `json:"recipient" gorm:"type:varchar(100);not null"`
+	Subject       string     `json:"subject" gorm:"type:varchar(255);not null"`
+	Body          string     `json:"body" gorm:"type:text;not null"`
+	Status        string     `json:"status" gorm:"type:varchar(20);default:'Pending';not null;index"`
+	Attempts      int32      `json:"attempts" gorm:"default:0;not null"`
+	LastError     string     `json:"lastError" gorm:"type:text"`
+	NextAttemptAt time.Time  `json:"nextAttemptAt" gorm:"not null;index"`
+	SentAt        *time.Time `json:"sentAt"`
+	CreatedAt     time.Time  `json:"createdAt" gorm:"autoCreateTime"`
+}
@@ -11,13 +11,24 @@ type Employee struct {
 	Username  string    `json:"username" gorm:"type:varchar(50);unique;not null"`
 	FirstName string    `json:"firstName" gorm:"type:varchar(50)"`
 	LastName  string    `json:"lastName" gorm:"type:varchar(50)"`
+	Email     string    `json:"email" gorm:"type:varchar(100)"`
+	Locale    string    `json:"locale" gorm:"type:varchar(2);default:'ru'"`
+	IsAdmin   bool      `json:"isAdmin" gorm:"default:false;not null"`
 	CreatedAt time.Time `json:"createdAt" gorm:"autoCreateTime"`
 	UpdatedAt time.Time `json:"updatedAt" gorm:"autoUpdateTime"`
 
 	// Связь с ответственностью в организации
-	OrganizationsResponsible []OrganizationResponsible `gorm:"foreignKey:UserID"`
+	OrganizationsResponsible []OrganizationResponsible `json:"-" gorm:"foreignKey:UserID"`
 }
 
 func (Employee) TableName() string {
 	return "employee"
 }
+
+type EmployeeEdit struct {
+	Username  string `json:"username"`
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+	Email     string `json:"email"`
+	Locale    string `json:"locale"`
+}
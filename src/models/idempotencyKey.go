@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+// IdempotencyKey - сохраненный ответ на запрос с заголовком Idempotency-Key
+type IdempotencyKey struct {
+	Key          string    `gorm:"type:varchar(255);primaryKey"`
+	RequestHash  string    `gorm:"type:varchar(64);not null"`
+	Completed    bool      `gorm:"default:false;not null"`
+	StatusCode   int       `gorm:"not null;default:0"`
+	ContentType  string    `gorm:"type:varchar(100)"`
+	ResponseBody []byte    `gorm:"type:bytea"`
+	CreatedAt    time.Time `gorm:"autoCreateTime"`
+	ExpiresAt    time.Time `gorm:"not null;index"`
+}
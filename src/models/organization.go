@@ -15,9 +15,15 @@ type Organization struct {
 	UpdatedAt   time.Time `json:"updatedAt" gorm:"autoUpdateTime"`
 
 	// Связь с ответственными сотрудниками
-	ResponsibleEmployees []OrganizationResponsible `gorm:"foreignKey:OrganizationID"`
+	ResponsibleEmployees []OrganizationResponsible `json:"-" gorm:"foreignKey:OrganizationID"`
 }
 
 func (Organization) TableName() string {
 	return "organization"
 }
+
+type OrganizationEdit struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Type        string `json:"type"`
+}
@@ -4,12 +4,21 @@ import (
 	"github.com/google/uuid"
 )
 
+// Роли ответственных внутри организации
+const (
+	RoleOrgAdmin     = "OrgAdmin"
+	RoleTenderAuthor = "TenderAuthor"
+	RoleApprover     = "Approver"
+	RoleViewer       = "Viewer"
+)
+
 type OrganizationResponsible struct {
 	ID             uuid.UUID    `json:"id" gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
 	OrganizationID uuid.UUID    `json:"organizationId" gorm:"not null"`
-	Organization   Organization `gorm:"foreignKey:OrganizationID"`
+	Organization   Organization `json:"-" gorm:"foreignKey:OrganizationID"`
 	UserID         uuid.UUID    `json:"userId" gorm:"not null"`
-	Employee       Employee     `gorm:"foreignKey:UserID"`
+	Employee       Employee     `json:"-" gorm:"foreignKey:UserID"`
+	Role           string       `json:"role" gorm:"type:varchar(20);default:'OrgAdmin';not null"`
 }
 
 func (OrganizationResponsible) TableName() string {
@@ -0,0 +1,12 @@
+package models
+
+import "time"
+
+// RateLimitBucket - общее для всех реплик состояние token bucket
+type RateLimitBucket struct {
+	Key       string    `gorm:"type:varchar(255);primaryKey"`
+	Tokens    float64   `gorm:"not null"`
+	UpdatedAt time.Time `gorm:"not null"`
+	// FullAt - когда корзина снова наполнится до Burst, после этого ее можно удалить
+	FullAt time.Time `gorm:"not null;default:CURRENT_TIMESTAMP;index"`
+}
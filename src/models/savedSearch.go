@@ -0,0 +1,42 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SavedSearch - именованный фильтр тендеров сотрудника. С Notify сотрудник получает письмо,
+// когда опубликованный или измененный опубликованный тендер подходит под фильтр.
+type SavedSearch struct {
+	ID         uuid.UUID `json:"id" gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	EmployeeID uuid.UUID `json:"employeeId" gorm:"type:uuid;not null;index"`
+	Employee   Employee  `json:"-" gorm:"foreignKey:EmployeeID;constraint:OnDelete:CASCADE"`
+	Name       string    `json:"name" gorm:"type:varchar(100);not null"`
+	// Filter хранит виды услуг как их указал сотрудник: подкатегории добавляются при каждом поиске
+	Filter               TenderFilter `json:"filter" gorm:"type:jsonb;serializer:json;not null"`
+	IncludeSubcategories bool         `json:"includeSubcategories" gorm:"default:false;not null"`
+	Notify               bool         `json:"notify" gorm:"default:false;not null;index"`
+	CreatedAt            time.Time    `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt            time.Time    `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+// TenderMatch - опубликованная версия тендера, которую обработчик очереди писем сверит
+// с сохраненными поисками. Сверка вынесена из запроса, чтобы публикация не зависела
+// от числа подписок.
+type TenderMatch struct {
+	ID       uuid.UUID `gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	TenderId uuid.UUID `gorm:"type:uuid;not null"`
+	Version  int32     `gorm:"not null"`
+	// Updated - версия появилась при изменении уже опубликованного тендера
+	Updated       bool      `gorm:"default:false;not null"`
+	NextAttemptAt time.Time `gorm:"not null;index"`
+	CreatedAt     time.Time `gorm:"autoCreateTime"`
+}
+
+type SavedSearchEdit struct {
+	Name                 *string       `json:"name"`
+	Filter               *TenderFilter `json:"filter"`
+	IncludeSubcategories *bool         `json:"includeSubcategories"`
+	Notify               *bool         `json:"notify"`
+}
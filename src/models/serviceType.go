@@ -0,0 +1,39 @@
+package models
+
+import "time"
+
+// ServiceType - вид услуг из справочника. Справочник иерархический: ParentCode
+// указывает на вышестоящую категорию, у корневых категорий он пустой.
+type ServiceType struct {
+	Code       string  `json:"code" gorm:"type:varchar(50);primaryKey"`
+	ParentCode *string `json:"parentCode,omitempty" gorm:"type:varchar(50);index"`
+	// Names - отображаемые названия по языкам, например {"ru": "Строительство"}
+	Names     map[string]string `json:"names" gorm:"type:jsonb;serializer:json;not null"`
+	CreatedAt time.Time         `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt time.Time         `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+// Name возвращает название на языке locale, затем на fallback, затем код
+func (t ServiceType) Name(locale, fallback string) string {
+	if name := t.Names[locale]; name != "" {
+		return name
+	}
+	if name := t.Names[fallback]; name != "" {
+		return name
+	}
+	return t.Code
+}
+
+// ServiceTypeEdit - изменение вида услуг. Пустая строка в ParentCode делает вид корневым,
+// непустые названия в Names заменяют прежние, пустые - удаляют.
+type ServiceTypeEdit struct {
+	ParentCode *string           `json:"parentCode"`
+	Names      map[string]string `json:"names"`
+}
+
+// DefaultServiceTypes - виды услуг, которые были в сервисе до появления справочника
+var DefaultServiceTypes = []ServiceType{
+	{Code: "Construction", Names: map[string]string{"ru": "Строительство", "en": "Construction"}},
+	{Code: "Delivery", Names: map[string]string{"ru": "Доставка", "en": "Delivery"}},
+	{Code: "Manufacture", Names: map[string]string{"ru": "Производство", "en": "Manufacture"}},
+}
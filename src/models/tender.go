@@ -7,21 +7,94 @@ import (
 )
 
 type Tender struct {
-	ID              uuid.UUID    `json:"id" gorm:"type:uuid;default:uuid_generate_v4()"`
-	VersionID       uuid.UUID    `json:"versionId" gorm:"type:uuid;default:uuid_generate_v4();primary_key"`
+	ID              uuid.UUID    `json:"id" gorm:"type:uuid;default:uuid_generate_v4();primary_key"`
+	VersionID       uuid.UUID    `json:"versionId" gorm:"type:uuid;default:uuid_generate_v4();uniqueIndex"`
 	Name            string       `json:"name" gorm:"type:varchar(100);not null"`
 	Description     string       `json:"description" gorm:"type:text;not null"`
-	ServiceType     string       `json:"serviceType" gorm:"type:varchar(20);not null"`
+	ServiceType     string       `json:"serviceType" gorm:"type:varchar(50);not null;index"`
 	Status          string       `json:"status" gorm:"type:varchar(20);default:'Created';not null"`
-	OrganizationId  string       `json:"organizationId" gorm:"type:varchar(20);not null"`
-	Organization    Organization `json:"organization" gorm:"foreignkey:OrganizationId;references:id"`
+	OrganizationId  string       `json:"organizationId" gorm:"type:varchar(100);not null;index"`
+	Organization    Organization `json:"-" gorm:"foreignkey:OrganizationId;references:id;constraint:-"`
 	CreatorUsername string       `json:"creatorUsername" gorm:"-"`
-	Version         int32        `json:"version" gorm:"default:1;not null"`
-	CreatedAt       time.Time    `json:"createdAt" gorm:"autoCreateTime"`
+	Deadline        *time.Time   `json:"deadline,omitempty" gorm:"index"`
+	Budget          *float64     `json:"budget,omitempty" gorm:"type:numeric(15,2);index"`
+	Region          string       `json:"region,omitempty" gorm:"type:varchar(100);not null;default:'';index"`
+	// Rounds - число раундов закупки, Round - текущий раунд. Во втором и следующих раундах
+	// предложения подают только авторы, прошедшие в шорт-лист предыдущего раунда.
+	Rounds int32 `json:"rounds" gorm:"default:1;not null"`
+	Round  int32 `json:"round" gorm:"default:1;not null"`
+	// Sealed - содержимое предложений зашифровано ключом тендера до срока приема
+	Sealed    bool      `json:"sealed" gorm:"default:false;not null"`
+	Version   int32     `json:"version" gorm:"default:1;not null"`
+	CreatedAt time.Time `json:"createdAt" gorm:"autoCreateTime;index"`
+}
+
+// TenderVersion - прошлая версия тендера. В tenders хранится только текущая версия,
+// предыдущие переносятся в tender_versions.
+type TenderVersion struct {
+	VersionID      uuid.UUID `gorm:"type:uuid;default:uuid_generate_v4();primary_key"`
+	ID             uuid.UUID `gorm:"type:uuid;not null;index:idx_tender_versions_id_version"`
+	Name           string    `gorm:"type:varchar(100);not null"`
+	Description    string    `gorm:"type:text;not null"`
+	ServiceType    string    `gorm:"type:varchar(50);not null"`
+	Status         string    `gorm:"type:varchar(20);default:'Created';not null"`
+	OrganizationId string    `gorm:"type:varchar(100);not null"`
+	Deadline       *time.Time
+	Budget         *float64  `gorm:"type:numeric(15,2)"`
+	Region         string    `gorm:"type:varchar(100);not null;default:''"`
+	Rounds         int32     `gorm:"default:1;not null"`
+	Round          int32     `gorm:"default:1;not null"`
+	Sealed         bool      `gorm:"default:false;not null"`
+	Version        int32     `gorm:"not null;index:idx_tender_versions_id_version"`
+	CreatedAt      time.Time `gorm:"autoCreateTime"`
+}
+
+func NewTenderVersion(tender Tender) TenderVersion {
+	return TenderVersion{
+		VersionID:      tender.VersionID,
+		ID:             tender.ID,
+		Name:           tender.Name,
+		Description:    tender.Description,
+		ServiceType:    tender.ServiceType,
+		Status:         tender.Status,
+		OrganizationId: tender.OrganizationId,
+		Deadline:       tender.Deadline,
+		Budget:         tender.Budget,
+		Region:         tender.Region,
+		Rounds:         tender.Rounds,
+		Round:          tender.Round,
+		Sealed:         tender.Sealed,
+		Version:        tender.Version,
+		CreatedAt:      tender.CreatedAt,
+	}
+}
+
+func (v TenderVersion) Tender() Tender {
+	return Tender{
+		ID:             v.ID,
+		VersionID:      v.VersionID,
+		Name:           v.Name,
+		Description:    v.Description,
+		ServiceType:    v.ServiceType,
+		Status:         v.Status,
+		OrganizationId: v.OrganizationId,
+		Deadline:       v.Deadline,
+		Budget:         v.Budget,
+		Region:         v.Region,
+		Rounds:         v.Rounds,
+		Round:          v.Round,
+		Sealed:         v.Sealed,
+		Version:        v.Version,
+		CreatedAt:      v.CreatedAt,
+	}
 }
 
 type TenderEdit struct {
-	Name        string `json:"name"`
-	Description string `json:"description"`
-	ServiceType string `json:"serviceType"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	ServiceType string     `json:"serviceType"`
+	Deadline    *time.Time `json:"deadline"`
+	Budget      *float64   `json:"budget"`
+	Region      *string    `json:"region"`
+	Rounds      *int32     `json:"rounds"`
 }
@@ -0,0 +1,31 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TenderAuction - реверсивный аукцион тендера. В окне [StartsAt, EndsAt) авторы предложений
+// снижают цену каждый раз не меньше чем на MinDecrement. Ставка, сделанная меньше чем за
+// ExtensionSeconds до окончания, переносит окончание на ExtensionSeconds от времени ставки.
+type TenderAuction struct {
+	TenderId         uuid.UUID `json:"tenderId" gorm:"type:uuid;primaryKey"`
+	StartPrice       *float64  `json:"startPrice,omitempty" gorm:"type:numeric(15,2)"`
+	MinDecrement     float64   `json:"minDecrement" gorm:"type:numeric(15,2);not null"`
+	StartsAt         time.Time `json:"startsAt" gorm:"not null"`
+	EndsAt           time.Time `json:"endsAt" gorm:"not null"`
+	ExtensionSeconds int32     `json:"extensionSeconds" gorm:"not null;default:0"`
+	CreatedAt        time.Time `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt        time.Time `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+// AuctionOffer - ставка автора предложения в аукционе
+type AuctionOffer struct {
+	ID        uuid.UUID `json:"id" gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	TenderId  uuid.UUID `json:"tenderId" gorm:"type:uuid;not null;index"`
+	BidId     uuid.UUID `json:"bidId" gorm:"type:uuid;not null;index"`
+	AuthorId  uuid.UUID `json:"authorId" gorm:"type:uuid;not null"`
+	Price     float64   `json:"price" gorm:"type:numeric(15,2);not null"`
+	CreatedAt time.Time `json:"createdAt" gorm:"not null"`
+}
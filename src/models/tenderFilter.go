@@ -0,0 +1,81 @@
+package models
+
+import (
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Поля сортировки публичного списка тендеров
+const (
+	TenderSortName      = "name"
+	TenderSortCreatedAt = "createdAt"
+	TenderSortDeadline  = "deadline"
+	TenderSortBudget    = "budget"
+)
+
+// TenderFilter - условия публичного списка тендеров. Пустые поля не ограничивают выборку,
+// значения одного списка объединяются по ИЛИ, границы диапазонов включаются.
+type TenderFilter struct {
+	ServiceTypes      []string   `json:"serviceTypes,omitempty"`
+	OrganizationIDs   []string   `json:"organizationIds,omitempty"`
+	OrganizationTypes []string   `json:"organizationTypes,omitempty"`
+	Regions           []string   `json:"regions,omitempty"`
+	CreatedFrom       *time.Time `json:"createdFrom,omitempty"`
+	CreatedTo         *time.Time `json:"createdTo,omitempty"`
+	DeadlineFrom      *time.Time `json:"deadlineFrom,omitempty"`
+	DeadlineTo        *time.Time `json:"deadlineTo,omitempty"`
+	BudgetMin         *float64   `json:"budgetMin,omitempty"`
+	BudgetMax         *float64   `json:"budgetMax,omitempty"`
+	// Search - слова, которые должны встретиться в названии или описании
+	Search string `json:"search,omitempty"`
+	// Sort - одно из TenderSort*, пустое - по названию; Descending меняет порядок на обратный
+	Sort       string `json:"sort,omitempty"`
+	Descending bool   `json:"descending,omitempty"`
+}
+
+// searchWords разбивает текст на слова в нижнем регистре, как парсер simple в PostgreSQL
+func searchWords(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+func timeInRange(t *time.Time, from, to *time.Time) bool {
+	if from == nil && to == nil {
+		return true
+	}
+	return t != nil && (from == nil || !t.Before(*from)) && (to == nil || !t.After(*to))
+}
+
+// Match проверяет тендер по условиям фильтра без учета статуса и порядка;
+// organizationType - форма организации тендера. Условия совпадают с SQL репозитория.
+func (f *TenderFilter) Match(tender *Tender, organizationType string) bool {
+	if len(f.ServiceTypes) > 0 && !slices.Contains(f.ServiceTypes, tender.ServiceType) ||
+		len(f.OrganizationIDs) > 0 && !slices.Contains(f.OrganizationIDs, tender.OrganizationId) ||
+		len(f.OrganizationTypes) > 0 && !slices.Contains(f.OrganizationTypes, organizationType) ||
+		len(f.Regions) > 0 && !slices.Contains(f.Regions, tender.Region) {
+		return false
+	}
+	if !timeInRange(&tender.CreatedAt, f.CreatedFrom, f.CreatedTo) ||
+		!timeInRange(tender.Deadline, f.DeadlineFrom, f.DeadlineTo) {
+		return false
+	}
+	if f.BudgetMin != nil || f.BudgetMax != nil {
+		if tender.Budget == nil ||
+			f.BudgetMin != nil && *tender.Budget < *f.BudgetMin ||
+			f.BudgetMax != nil && *tender.Budget > *f.BudgetMax {
+			return false
+		}
+	}
+	if f.Search != "" {
+		words := searchWords(tender.Name + " " + tender.Description)
+		for _, word := range searchWords(f.Search) {
+			if !slices.Contains(words, word) {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,15 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TenderProtocol - PDF-протокол закупки, формируется при закрытии тендера
+type TenderProtocol struct {
+	TenderID  uuid.UUID `gorm:"type:uuid;primaryKey"`
+	BidID     uuid.UUID `gorm:"type:uuid;not null"`
+	Content   []byte    `gorm:"type:bytea;not null"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
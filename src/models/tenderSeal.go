@@ -0,0 +1,16 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TenderSeal - ключ AES-256-GCM, которым шифруются предложения запечатанного тендера.
+// После вскрытия по сроку приема ключ удаляется, а UnsealedAt фиксирует время вскрытия.
+type TenderSeal struct {
+	TenderId   uuid.UUID  `json:"tenderId" gorm:"type:uuid;primaryKey"`
+	Key        []byte     `json:"key,omitempty" gorm:"type:bytea"`
+	UnsealedAt *time.Time `json:"unsealedAt,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt" gorm:"autoCreateTime"`
+}
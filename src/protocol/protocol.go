@@ -0,0 +1,313 @@
+// Package protocol формирует PDF-протокол закупки: реквизиты организации, история тендера,
+// предложения, журнал голосов, победитель и подписи ответственных.
+package protocol
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/go-pdf/fpdf"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+	"strings"
+	"text/template"
+	"time"
+	"zadanie_6105/src/models"
+)
+
+// Bid - текущая версия предложения с именем автора
+type Bid struct {
+	models.Bid
+	Author string
+}
+
+// Decision - голос ответственного по предложению
+type Decision struct {
+	Bid       string
+	Employee  string
+	Role      string
+	Decision  string
+	CreatedAt time.Time
+}
+
+// Signatory - ответственный, подписывающий протокол
+type Signatory struct {
+	Name string
+	Role string
+}
+
+type Data struct {
+	Organization models.Organization
+	// Tender - закрытая версия тендера, History - все его версии по возрастанию номера
+	Tender      models.Tender
+	History     []models.Tender
+	Bids        []Bid
+	Winner      Bid
+	Decisions   []Decision
+	Signatories []Signatory
+	ClosedAt    time.Time
+	// ServiceTypes - названия видов услуг из справочника по коду
+	ServiceTypes map[string]string
+}
+
+// Тексты протокола; данные подставляются из Data
+var (
+	titleTemplate   = newTemplate(`Протокол закупки по тендеру «{{.Tender.Name}}»`)
+	summaryTemplate = newTemplate(`Тендер {{.Tender.ID}} организации «{{.Organization.Name}}» закрыт {{date .ClosedAt}}. ` +
+		`Предложений рассмотрено: {{len .Bids}}, голосов подано: {{len .Decisions}}.`)
+	winnerTemplate = newTemplate(`Победителем признано предложение «{{.Winner.Name}}» (версия {{.Winner.Version}}, ` +
+		`автор: {{.Winner.Author}}, идентификатор {{.Winner.ID}}).`)
+)
+
+var labels = map[string]string{
+	"Created":   "Создан",
+	"Published": "Опубликован",
+	"Closed":    "Закрыт",
+	"Canceled":  "Отменен",
+	"Approved":  "Согласовано",
+	"Rejected":  "Отклонено",
+
+	models.RoleOrgAdmin:     "Администратор организации",
+	models.RoleTenderAuthor: "Автор тендеров",
+	models.RoleApprover:     "Согласующий",
+	models.RoleViewer:       "Наблюдатель",
+}
+
+func newTemplate(text string) *template.Template {
+	return template.Must(template.New("").Funcs(template.FuncMap{"date": formatTime}).Parse(text))
+}
+
+func execute(t *template.Template, data *Data) (string, error) {
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// label переводит служебные значения для протокола, неизвестные возвращает как есть
+func label(value string) string {
+	if translated, ok := labels[value]; ok {
+		return translated
+	}
+	return value
+}
+
+// serviceType возвращает название вида услуг из справочника или код
+func (d *Data) serviceType(code string) string {
+	if name, ok := d.ServiceTypes[code]; ok {
+		return name
+	}
+	return code
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format("02.01.2006 15:04 UTC")
+}
+
+const (
+	fontFamily = "Go"
+	lineHeight = 5.0
+)
+
+// document оборачивает fpdf и хранит ширину области печати
+type document struct {
+	*fpdf.Fpdf
+	width float64
+}
+
+func (d *document) heading(text string) {
+	d.Ln(4)
+	d.SetFont(fontFamily, "B", 12)
+	d.MultiCell(0, 7, text, "", "L", false)
+	d.SetFont(fontFamily, "", 10)
+}
+
+// field печатает строку «название: значение»
+func (d *document) field(name, value string) {
+	d.SetFont(fontFamily, "B", 10)
+	d.CellFormat(45, lineHeight, name, "", 0, "L", false, 0, "")
+	d.SetFont(fontFamily, "", 10)
+	d.MultiCell(0, lineHeight, value, "", "L", false)
+}
+
+// table печатает таблицу с переносом текста в ячейках; ширины колонок заданы в долях
+func (d *document) table(fractions []float64, header []string, rows [][]string) {
+	widths := make([]float64, len(fractions))
+	for i, fraction := range fractions {
+		widths[i] = d.width * fraction
+	}
+
+	d.SetFont(fontFamily, "B", 9)
+	d.SetFillColor(230, 230, 230)
+	d.row(widths, header, true)
+	d.SetFont(fontFamily, "", 9)
+	for _, row := range rows {
+		if d.row(widths, row, false) {
+			// Строка не поместилась: на новой странице повторяется заголовок
+			d.SetFont(fontFamily, "B", 9)
+			d.row(widths, header, true)
+			d.SetFont(fontFamily, "", 9)
+			d.row(widths, row, false)
+		}
+	}
+}
+
+// row печатает строку таблицы. Если строка данных не помещается на странице, row добавляет
+// страницу и возвращает true, ничего не напечатав; заголовок просто переносится на новую страницу.
+func (d *document) row(widths []float64, cells []string, fill bool) bool {
+	lines := 1
+	for i, cell := range cells {
+		lines = max(lines, len(d.SplitText(cell, widths[i]-2)))
+	}
+	height := float64(lines)*lineHeight + 1
+
+	_, pageHeight := d.GetPageSize()
+	_, _, _, bottom := d.GetMargins()
+	if d.GetY()+height > pageHeight-bottom {
+		d.AddPage()
+		if !fill {
+			return true
+		}
+	}
+
+	left, _, _, _ := d.GetMargins()
+	x, y := left, d.GetY()
+	for i, cell := range cells {
+		style := "D"
+		if fill {
+			style = "FD"
+		}
+		d.Rect(x, y, widths[i], height, style)
+		d.SetXY(x+1, y+0.5)
+		d.MultiCell(widths[i]-2, lineHeight, cell, "", "L", false)
+		x += widths[i]
+	}
+	d.SetXY(left, y+height)
+	return false
+}
+
+// Render формирует PDF-протокол
+func Render(data *Data) ([]byte, error) {
+	title, err := execute(titleTemplate, data)
+	if err != nil {
+		return nil, err
+	}
+	summary, err := execute(summaryTemplate, data)
+	if err != nil {
+		return nil, err
+	}
+	winner, err := execute(winnerTemplate, data)
+	if err != nil {
+		return nil, err
+	}
+
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.AddUTF8FontFromBytes(fontFamily, "", goregular.TTF)
+	pdf.AddUTF8FontFromBytes(fontFamily, "B", gobold.TTF)
+	// Даты и порядок ресурсов фиксированы, чтобы протокол одного тендера совпадал побайтно
+	pdf.SetCreationDate(data.ClosedAt)
+	pdf.SetModificationDate(data.ClosedAt)
+	pdf.SetCatalogSort(true)
+	pdf.SetTitle(title, true)
+	pdf.SetAuthor(data.Organization.Name, true)
+	pdf.SetCreator("tender-service", true)
+	pdf.AliasNbPages("")
+	pdf.SetFooterFunc(func() {
+		pdf.SetY(-15)
+		pdf.SetFont(fontFamily, "", 8)
+		pdf.CellFormat(0, 10, fmt.Sprintf("Тендер %s · страница %d из {nb}", data.Tender.ID, pdf.PageNo()), "", 0, "C", false, 0, "")
+	})
+
+	pageWidth, _ := pdf.GetPageSize()
+	left, _, right, _ := pdf.GetMargins()
+	d := &document{Fpdf: pdf, width: pageWidth - left - right}
+	d.AddPage()
+
+	d.SetFont(fontFamily, "B", 14)
+	d.MultiCell(0, 8, title, "", "C", false)
+	d.SetFont(fontFamily, "", 10)
+	d.Ln(2)
+	d.MultiCell(0, lineHeight, summary, "", "L", false)
+
+	d.heading("1. Организатор")
+	d.field("Наименование", data.Organization.Name)
+	d.field("Форма", data.Organization.Type)
+	d.field("Идентификатор", data.Organization.ID.String())
+	if data.Organization.Description != "" {
+		d.field("Описание", data.Organization.Description)
+	}
+
+	d.heading("2. Предмет закупки")
+	d.field("Название", data.Tender.Name)
+	d.field("Описание", data.Tender.Description)
+	d.field("Вид услуг", data.serviceType(data.Tender.ServiceType))
+	if data.Tender.Region != "" {
+		d.field("Регион", data.Tender.Region)
+	}
+	if data.Tender.Budget != nil {
+		d.field("Бюджет", fmt.Sprintf("%.2f руб.", *data.Tender.Budget))
+	}
+	if data.Tender.Deadline != nil {
+		d.field("Срок приема", formatTime(*data.Tender.Deadline))
+	}
+	if data.Tender.Rounds > 1 {
+		d.field("Число раундов", fmt.Sprint(data.Tender.Rounds))
+	}
+	d.field("Создан", formatTime(data.Tender.CreatedAt))
+
+	d.heading("3. История тендера")
+	history := make([][]string, len(data.History))
+	for i, version := range data.History {
+		history[i] = []string{
+			fmt.Sprint(version.Version), version.Name, version.Description,
+			data.serviceType(version.ServiceType), formatTime(version.CreatedAt),
+		}
+	}
+	d.table([]float64{0.08, 0.22, 0.38, 0.14, 0.18},
+		[]string{"Версия", "Название", "Описание", "Вид услуг", "Дата"}, history)
+
+	d.heading("4. Предложения")
+	bids := make([][]string, len(data.Bids))
+	for i, bid := range data.Bids {
+		name := bid.Name
+		if bid.ID == data.Winner.ID {
+			name += " (победитель)"
+		}
+		bids[i] = []string{
+			name, bid.Author, fmt.Sprintf("%d / %d", bid.Round, bid.Version), label(bid.Status), formatTime(bid.CreatedAt),
+		}
+	}
+	d.table([]float64{0.3, 0.26, 0.1, 0.16, 0.18},
+		[]string{"Предложение", "Автор", "Раунд / версия", "Статус", "Подано"}, bids)
+
+	d.heading("5. Журнал голосования")
+	decisions := make([][]string, len(data.Decisions))
+	for i, decision := range data.Decisions {
+		decisions[i] = []string{
+			formatTime(decision.CreatedAt), decision.Employee, label(decision.Role),
+			decision.Bid, label(decision.Decision),
+		}
+	}
+	d.table([]float64{0.18, 0.22, 0.2, 0.24, 0.16},
+		[]string{"Дата", "Ответственный", "Роль", "Предложение", "Решение"}, decisions)
+
+	d.heading("6. Итог")
+	d.MultiCell(0, lineHeight, winner, "", "L", false)
+
+	d.heading("7. Подписи")
+	for _, signatory := range data.Signatories {
+		d.Ln(4)
+		caption := signatory.Name
+		if signatory.Role != "" {
+			caption += ", " + strings.ToLower(label(signatory.Role))
+		}
+		d.CellFormat(d.width*0.55, lineHeight, caption, "", 0, "L", false, 0, "")
+		d.CellFormat(0, lineHeight, "____________________", "", 1, "R", false, 0, "")
+	}
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, fmt.Errorf("render protocol: %w", err)
+	}
+	return buf.Bytes(), nil
+}
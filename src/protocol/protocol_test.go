@@ -0,0 +1,78 @@
+package protocol_test
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/google/uuid"
+	"testing"
+	"time"
+	"zadanie_6105/src/models"
+	"zadanie_6105/src/protocol"
+)
+
+func testData(bids int) *protocol.Data {
+	closedAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	budget := 150000.0
+	tender := models.Tender{
+		ID: uuid.New(), Name: "Ремонт офиса", Description: "Косметический ремонт", ServiceType: "Construction",
+		Status: "Closed", Version: 2, Budget: &budget, CreatedAt: closedAt.Add(-48 * time.Hour),
+	}
+	data := &protocol.Data{
+		Organization: models.Organization{ID: uuid.New(), Name: "Альфа", Type: "LLC"},
+		Tender:       tender,
+		History:      []models.Tender{tender, tender},
+		Signatories:  []protocol.Signatory{{Name: "Анна Смирнова", Role: models.RoleOrgAdmin}},
+		ClosedAt:     closedAt,
+		ServiceTypes: map[string]string{"Construction": "Строительство"},
+	}
+	for i := 0; i < bids; i++ {
+		bid := protocol.Bid{
+			Bid:    models.Bid{ID: uuid.New(), Name: fmt.Sprintf("Предложение %d", i+1), Status: "Published", Version: 1},
+			Author: "Елена Новикова (bidder)",
+		}
+		data.Bids = append(data.Bids, bid)
+		data.Decisions = append(data.Decisions, protocol.Decision{
+			Bid: bid.Name, Employee: "Олег Козлов", Role: models.RoleApprover, Decision: "Approved", CreatedAt: closedAt,
+		})
+	}
+	data.Winner = data.Bids[0]
+	return data
+}
+
+func TestRender(t *testing.T) {
+	data := testData(3)
+	first, err := protocol.Render(data)
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	if !bytes.HasPrefix(first, []byte("%PDF-")) || !bytes.Contains(first[len(first)-8:], []byte("%%EOF")) {
+		t.Fatalf("Render did not produce a PDF document")
+	}
+
+	second, err := protocol.Render(data)
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	if !bytes.Equal(first, second) {
+		t.Errorf("Render is not deterministic for the same data")
+	}
+}
+
+// Длинный журнал голосования переносится на следующие страницы
+func TestRenderPaginates(t *testing.T) {
+	short, err := protocol.Render(testData(1))
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	long, err := protocol.Render(testData(80))
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	pages := func(pdf []byte) int {
+		return bytes.Count(pdf, []byte("/Type /Page\n"))
+	}
+	if pages(short) != 1 || pages(long) < 3 {
+		t.Errorf("pages = %d and %d, want 1 and at least 3", pages(short), pages(long))
+	}
+}
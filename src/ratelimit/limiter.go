@@ -0,0 +1,99 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"zadanie_6105/src/logging"
+)
+
+// Limit - параметры token bucket: Rate токенов в секунду, не больше Burst в запасе
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+type Store interface {
+	// Take забирает токен из корзины key. Если токенов нет, возвращает время до появления следующего.
+	Take(ctx context.Context, key string, limit Limit) (bool, time.Duration, error)
+	// Cleanup удаляет корзины, которые уже снова наполнились: новая корзина от них не отличается
+	Cleanup(ctx context.Context) error
+}
+
+func RunCleanup(ctx context.Context, store Store, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := store.Cleanup(ctx); err != nil {
+				logging.FromContext(ctx).Error("Failed to clean up rate limit buckets", "error", err)
+			}
+		}
+	}
+}
+
+// refill пересчитывает количество токенов с момента последнего обращения и пытается забрать один
+func refill(tokens float64, updatedAt time.Time, now time.Time, limit Limit) (float64, bool, time.Duration) {
+	elapsed := now.Sub(updatedAt).Seconds()
+	if elapsed > 0 {
+		tokens = math.Min(float64(limit.Burst), tokens+elapsed*limit.Rate)
+	}
+	if tokens >= 1 {
+		return tokens - 1, true, 0
+	}
+	wait := time.Duration((1 - tokens) / limit.Rate * float64(time.Second))
+	return tokens, false, wait
+}
+
+// fullAt возвращает момент, когда корзина с tokens токенами наполнится до Burst
+func fullAt(tokens float64, now time.Time, limit Limit) time.Time {
+	missing := math.Max(float64(limit.Burst)-tokens, 0)
+	return now.Add(time.Duration(missing / limit.Rate * float64(time.Second)))
+}
+
+// ParseLimit разбирает лимит в формате "rate:burst", например "0.5:3"
+func ParseLimit(s string) (Limit, error) {
+	rateStr, burstStr, found := strings.Cut(strings.TrimSpace(s), ":")
+	if !found {
+		return Limit{}, fmt.Errorf("invalid rate limit %q, expected rate:burst", s)
+	}
+	rate, err := strconv.ParseFloat(rateStr, 64)
+	if err != nil || rate <= 0 {
+		return Limit{}, fmt.Errorf("invalid rate in %q", s)
+	}
+	burst, err := strconv.Atoi(burstStr)
+	if err != nil || burst < 1 {
+		return Limit{}, fmt.Errorf("invalid burst in %q", s)
+	}
+	return Limit{Rate: rate, Burst: burst}, nil
+}
+
+// ParseRouteLimits разбирает переопределения вида
+// "POST /api/bids/new=0.2:3;PUT /api/bids/{bidId}/feedback=0.5:5"
+func ParseRouteLimits(s string) (map[string]Limit, error) {
+	limits := make(map[string]Limit)
+	for _, item := range strings.Split(s, ";") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		route, limitStr, found := strings.Cut(item, "=")
+		if !found {
+			return nil, errors.New("invalid route rate limit " + item)
+		}
+		limit, err := ParseLimit(limitStr)
+		if err != nil {
+			return nil, err
+		}
+		limits[strings.TrimSpace(route)] = limit
+	}
+	return limits, nil
+}
@@ -0,0 +1,139 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRefill(t *testing.T) {
+	limit := Limit{Rate: 2, Burst: 3}
+	start := time.Now()
+
+	tokens, allowed, wait := refill(1, start, start, limit)
+	if !allowed || tokens != 0 || wait != 0 {
+		t.Errorf("refill(1 token) = %v, %v, %v, want 0, true, 0", tokens, allowed, wait)
+	}
+	tokens, allowed, wait = refill(0.5, start, start, limit)
+	if allowed || tokens != 0.5 || wait != 250*time.Millisecond {
+		t.Errorf("refill(0.5 token) = %v, %v, %v, want 0.5, false, 250ms", tokens, allowed, wait)
+	}
+	// За секунду при 2 токенах в секунду набирается 2 токена, но не больше Burst
+	tokens, allowed, _ = refill(0, start, start.Add(time.Second), limit)
+	if !allowed || tokens != 1 {
+		t.Errorf("refill after 1s = %v, %v, want 1, true", tokens, allowed)
+	}
+	tokens, allowed, _ = refill(0, start, start.Add(time.Hour), limit)
+	if !allowed || tokens != 2 {
+		t.Errorf("refill after 1h = %v, %v, want burst minus one", tokens, allowed)
+	}
+}
+
+func TestMemoryStoreTake(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+	limit := Limit{Rate: 0.1, Burst: 2}
+
+	for i := 0; i < 2; i++ {
+		if allowed, _, err := store.Take(ctx, "user:a", limit); err != nil || !allowed {
+			t.Fatalf("Take %d = %v, %v, want allowed", i+1, allowed, err)
+		}
+	}
+	allowed, wait, err := store.Take(ctx, "user:a", limit)
+	if err != nil || allowed || wait <= 9*time.Second || wait > 10*time.Second {
+		t.Errorf("Take over burst = %v, %v, %v, want denied with ~10s wait", allowed, wait, err)
+	}
+	if allowed, _, _ := store.Take(ctx, "user:b", limit); !allowed {
+		t.Error("Take from another bucket was denied")
+	}
+
+	store.buckets["user:a"].fullAt = time.Now().Add(-time.Second)
+	if err := store.Cleanup(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := store.buckets["user:a"]; ok {
+		t.Error("Cleanup kept a full bucket")
+	}
+	if _, ok := store.buckets["user:b"]; !ok {
+		t.Error("Cleanup removed a bucket that is not full yet")
+	}
+}
+
+func TestMemoryStoreCleanupSlowLimit(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+	// 100 запросов в час: опустевшая корзина наполняется час
+	limit := Limit{Rate: 100.0 / 3600, Burst: 100}
+	for i := 0; i < limit.Burst; i++ {
+		if allowed, _, _ := store.Take(ctx, "user:a", limit); !allowed {
+			t.Fatalf("Take %d was denied", i+1)
+		}
+	}
+
+	// Простой в 15 минут не возвращает клиенту весь запас
+	shift := func(d time.Duration) {
+		b := store.buckets["user:a"]
+		b.updatedAt, b.fullAt = b.updatedAt.Add(-d), b.fullAt.Add(-d)
+	}
+	shift(15 * time.Minute)
+	if err := store.Cleanup(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := store.buckets["user:a"]; !ok {
+		t.Fatal("Cleanup removed a bucket that is not full yet")
+	}
+	allowed := 0
+	for i := 0; i < limit.Burst; i++ {
+		if ok, _, _ := store.Take(ctx, "user:a", limit); ok {
+			allowed++
+		}
+	}
+	if allowed < 24 || allowed > 26 {
+		t.Errorf("Take after 15 minutes allowed %d requests, want about 25", allowed)
+	}
+
+	shift(2 * time.Hour)
+	if err := store.Cleanup(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := store.buckets["user:a"]; ok {
+		t.Error("Cleanup kept a full bucket")
+	}
+}
+
+func TestFullAt(t *testing.T) {
+	now := time.Now()
+	limit := Limit{Rate: 2, Burst: 3}
+	if got := fullAt(0.5, now, limit); !got.Equal(now.Add(1250 * time.Millisecond)) {
+		t.Errorf("fullAt(0.5) = %v, want now+1.25s", got.Sub(now))
+	}
+	if got := fullAt(3, now, limit); !got.Equal(now) {
+		t.Errorf("fullAt(burst) = %v, want now", got.Sub(now))
+	}
+}
+
+func TestParseLimit(t *testing.T) {
+	limit, err := ParseLimit(" 0.5:3 ")
+	if err != nil || limit != (Limit{Rate: 0.5, Burst: 3}) {
+		t.Errorf("ParseLimit(0.5:3) = %+v, %v", limit, err)
+	}
+	for _, s := range []string{"", "5", "0:3", "-1:3", "x:3", "1:0", "1:x", "1:2.5"} {
+		if _, err := ParseLimit(s); err == nil {
+			t.Errorf("ParseLimit(%q) succeeded, want error", s)
+		}
+	}
+}
+
+func TestParseRouteLimits(t *testing.T) {
+	limits, err := ParseRouteLimits("POST /api/bids/new=0.2:3; PUT /api/bids/{bidId}/feedback=0.5:5;")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(limits) != 2 || limits["POST /api/bids/new"] != (Limit{Rate: 0.2, Burst: 3}) ||
+		limits["PUT /api/bids/{bidId}/feedback"] != (Limit{Rate: 0.5, Burst: 5}) {
+		t.Errorf("ParseRouteLimits = %+v", limits)
+	}
+	if _, err := ParseRouteLimits("POST /api/bids/new"); err == nil {
+		t.Error("ParseRouteLimits without a limit succeeded")
+	}
+}
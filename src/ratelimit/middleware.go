@@ -0,0 +1,148 @@
+package ratelimit
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/gorilla/mux"
+	"io"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"zadanie_6105/src/logging"
+)
+
+type Config struct {
+	// Default действует на все маршруты без переопределения, корзина у них общая.
+	// Нулевой Default оставляет такие маршруты без ограничений.
+	Default Limit
+	// Routes - переопределения по ключу "METHOD /шаблон/маршрута", у каждого своя корзина
+	Routes map[string]Limit
+	// TrustedProxies - число обратных прокси перед сервисом. Адрес клиента берется из
+	// X-Forwarded-For на столько записей от конца: левее стоят записи, которые прислал сам клиент.
+	TrustedProxies int
+}
+
+// Middleware ограничивает частоту запросов отдельно для пользователя и для IP адреса.
+// При превышении возвращается 429 с заголовком Retry-After.
+func Middleware(store Store, config Config) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			scope, limit := config.limitFor(r)
+			if limit.Rate <= 0 {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			keys := []string{"ip:" + clientIP(r, config.TrustedProxies) + ":" + scope}
+			if username := resolveUsername(r); username != "" {
+				keys = append(keys, "user:"+username+":"+scope)
+			}
+
+			for _, key := range keys {
+				allowed, wait, err := store.Take(r.Context(), key, limit)
+				if err != nil {
+					// Недоступность хранилища не должна останавливать сервис
+					logging.FromContext(r.Context()).Error("Rate limit store failed", "error", err)
+					break
+				}
+				if !allowed {
+					retryAfter := int(math.Ceil(wait.Seconds()))
+					if retryAfter < 1 {
+						retryAfter = 1
+					}
+					w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+					writeError(w, http.StatusTooManyRequests, "Too many requests")
+					return
+				}
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, reason string) {
+	body, _ := json.Marshal(map[string]string{"reason": reason})
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(body)
+}
+
+func (c Config) limitFor(r *http.Request) (string, Limit) {
+	if current := mux.CurrentRoute(r); current != nil {
+		if template, err := current.GetPathTemplate(); err == nil {
+			routeKey := r.Method + " " + template
+			if limit, ok := c.Routes[routeKey]; ok {
+				return routeKey, limit
+			}
+		}
+	}
+	return "default", c.Default
+}
+
+func clientIP(r *http.Request, trustedProxies int) string {
+	if trustedProxies > 0 {
+		var hops []string
+		for _, header := range r.Header.Values("X-Forwarded-For") {
+			for _, hop := range strings.Split(header, ",") {
+				if hop = strings.TrimSpace(hop); hop != "" {
+					hops = append(hops, hop)
+				}
+			}
+		}
+		if len(hops) > 0 {
+			// Эту запись добавил внешний прокси, записи левее нее клиент может подделать
+			return hops[max(len(hops)-trustedProxies, 0)]
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// maxIdentityBody - сколько байт тела читается в поисках пользователя
+const maxIdentityBody = 64 << 10
+
+// resolveUsername определяет пользователя по параметрам запроса, а для POST /api/tenders/new
+// и POST /api/bids/new, где он передается в теле, - по creatorUsername и authorId.
+// Прочитанное тело возвращается в запрос без изменений.
+func resolveUsername(r *http.Request) string {
+	query := r.URL.Query()
+	if username := query.Get("username"); username != "" {
+		return username
+	}
+	if username := query.Get("requesterUsername"); username != "" {
+		return username
+	}
+	if r.Method != http.MethodPost || r.Body == nil || r.Body == http.NoBody {
+		return ""
+	}
+
+	prefix, err := io.ReadAll(io.LimitReader(r.Body, maxIdentityBody))
+	r.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(prefix), r.Body), r.Body}
+	if err != nil {
+		return ""
+	}
+	var body struct {
+		CreatorUsername string `json:"creatorUsername"`
+		AuthorId        string `json:"authorId"`
+	}
+	if json.Unmarshal(prefix, &body) != nil {
+		return ""
+	}
+	if body.CreatorUsername != "" {
+		return body.CreatorUsername
+	}
+	if body.AuthorId != "" {
+		// Автор предложения передается идентификатором; корзина у него своя
+		return "id:" + body.AuthorId
+	}
+	return ""
+}
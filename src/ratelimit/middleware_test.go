@@ -0,0 +1,102 @@
+package ratelimit
+
+import (
+	"github.com/gorilla/mux"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// newRouter возвращает роутер с лимитом 1 запрос в 10 секунд на POST /api/bids/new и без
+// ограничений на остальные маршруты; обработчик отвечает телом запроса
+func newRouter(config Config) *mux.Router {
+	router := mux.NewRouter()
+	echo := func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		_, _ = w.Write(body)
+	}
+	router.HandleFunc("/api/bids/new", echo).Methods("POST")
+	router.HandleFunc("/api/ping", echo).Methods("GET")
+	config.Routes = map[string]Limit{"POST /api/bids/new": {Rate: 0.1, Burst: 1}}
+	router.Use(Middleware(NewMemoryStore(), config))
+	return router
+}
+
+func do(router http.Handler, method, target, remoteAddr, body string, header http.Header) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	req.RemoteAddr = remoteAddr
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestMiddlewareTooManyRequests(t *testing.T) {
+	router := newRouter(Config{})
+	body := `{"authorId":"550e8400-e29b-41d4-a716-446655440000","name":"Предложение"}`
+
+	rec := do(router, "POST", "/api/bids/new", "10.0.0.1:1000", body, nil)
+	if rec.Code != http.StatusOK || rec.Body.String() != body {
+		t.Fatalf("first request: %d %q, want 200 with the body passed through", rec.Code, rec.Body.String())
+	}
+	rec = do(router, "POST", "/api/bids/new", "10.0.0.1:1000", body, nil)
+	if rec.Code != http.StatusTooManyRequests || rec.Header().Get("Retry-After") != "10" {
+		t.Errorf("second request: %d, Retry-After %q, want 429 with Retry-After 10", rec.Code, rec.Header().Get("Retry-After"))
+	}
+	// Автор из тела ограничивается и с другого адреса
+	if rec := do(router, "POST", "/api/bids/new", "10.0.0.2:1000", body, nil); rec.Code != http.StatusTooManyRequests {
+		t.Errorf("same author from another IP: %d, want 429", rec.Code)
+	}
+	other := `{"authorId":"6ba7b810-9dad-11d1-80b4-00c04fd430c8"}`
+	if rec := do(router, "POST", "/api/bids/new", "10.0.0.3:1000", other, nil); rec.Code != http.StatusOK {
+		t.Errorf("another author from another IP: %d, want 200", rec.Code)
+	}
+	for i := 0; i < 3; i++ {
+		if rec := do(router, "GET", "/api/ping", "10.0.0.1:1000", "", nil); rec.Code != http.StatusOK {
+			t.Errorf("unlimited route: %d, want 200", rec.Code)
+		}
+	}
+}
+
+func TestClientIP(t *testing.T) {
+	forwarded := http.Header{"X-Forwarded-For": {"1.1.1.1, 2.2.2.2", "3.3.3.3"}}
+	cases := []struct {
+		name           string
+		trustedProxies int
+		header         http.Header
+		want           string
+	}{
+		{"no proxy ignores header", 0, forwarded, "10.0.0.1"},
+		{"one proxy", 1, forwarded, "3.3.3.3"},
+		{"two proxies", 2, forwarded, "2.2.2.2"},
+		{"more proxies than hops", 5, forwarded, "1.1.1.1"},
+		{"no header", 1, nil, "10.0.0.1"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/api/ping", nil)
+			req.RemoteAddr = "10.0.0.1:1000"
+			req.Header = tc.header
+			if req.Header == nil {
+				req.Header = http.Header{}
+			}
+			if got := clientIP(req, tc.trustedProxies); got != tc.want {
+				t.Errorf("clientIP = %q, want %q", got, tc.want)
+			}
+		})
+	}
+
+	// Подмена левой записи не дает клиенту новой корзины
+	router := newRouter(Config{TrustedProxies: 1})
+	for i, spoofed := range []string{"1.1.1.1", "4.4.4.4"} {
+		header := http.Header{"X-Forwarded-For": {spoofed + ", 5.5.5.5"}}
+		rec := do(router, "POST", "/api/bids/new", "10.0.0.1:1000", "{}", header)
+		if want := []int{http.StatusOK, http.StatusTooManyRequests}[i]; rec.Code != want {
+			t.Errorf("request with X-Forwarded-For %s: %d, want %d", spoofed, rec.Code, want)
+		}
+	}
+}
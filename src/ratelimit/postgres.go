@@ -0,0 +1,53 @@
+package ratelimit
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+	"zadanie_6105/src/models"
+)
+
+// PostgresStore хранит корзины в таблице rate_limit_buckets, поэтому лимиты общие для всех реплик
+type PostgresStore struct {
+	db *gorm.DB
+}
+
+func NewPostgresStore(db *gorm.DB) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+func (s *PostgresStore) Take(ctx context.Context, key string, limit Limit) (bool, time.Duration, error) {
+	var allowed bool
+	var wait time.Duration
+
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+
+		initial := models.RateLimitBucket{Key: key, Tokens: float64(limit.Burst), UpdatedAt: now, FullAt: now}
+		err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&initial).Error
+		if err != nil {
+			return err
+		}
+
+		var b models.RateLimitBucket
+		err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("key = ?", key).
+			First(&b).Error
+		if err != nil {
+			return err
+		}
+
+		b.Tokens, allowed, wait = refill(b.Tokens, b.UpdatedAt, now, limit)
+		b.UpdatedAt, b.FullAt = now, fullAt(b.Tokens, now, limit)
+		return tx.Save(&b).Error
+	})
+
+	return allowed, wait, err
+}
+
+func (s *PostgresStore) Cleanup(ctx context.Context) error {
+	return s.db.WithContext(ctx).
+		Where("full_at <= ?", time.Now()).
+		Delete(&models.RateLimitBucket{}).Error
+}
@@ -0,0 +1,1531 @@
+package repository
+
+import (
+	"cmp"
+	"context"
+	"github.com/google/uuid"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"zadanie_6105/src/models"
+)
+
+// memoryStore хранит записи всех репозиториев в памяти и повторяет значения
+// по умолчанию и порядок сортировки PostgreSQL-реализации
+type memoryStore struct {
+	mu            sync.RWMutex
+	tenders       map[uuid.UUID][]models.Tender
+	bids          map[uuid.UUID][]models.Bid
+	feedbacks     []models.BidFeedback
+	decisions     []models.BidDecision
+	shortlists    []models.BidShortlist
+	employees     map[uuid.UUID]models.Employee
+	organizations map[uuid.UUID]models.Organization
+	responsibles  []models.OrganizationResponsible
+	emails        []models.EmailMessage
+	protocols     map[uuid.UUID]models.TenderProtocol
+	serviceTypes  map[string]models.ServiceType
+	searches      map[uuid.UUID]models.SavedSearch
+	matches       []models.TenderMatch
+	auctions      map[uuid.UUID]models.TenderAuction
+	offers        []models.AuctionOffer
+	seals         map[uuid.UUID]models.TenderSeal
+	audit         []models.AuditEvent
+}
+
+func newMemoryStore() *memoryStore {
+	store := &memoryStore{
+		tenders:       map[uuid.UUID][]models.Tender{},
+		bids:          map[uuid.UUID][]models.Bid{},
+		employees:     map[uuid.UUID]models.Employee{},
+		organizations: map[uuid.UUID]models.Organization{},
+		protocols:     map[uuid.UUID]models.TenderProtocol{},
+		serviceTypes:  map[string]models.ServiceType{},
+		searches:      map[uuid.UUID]models.SavedSearch{},
+		auctions:      map[uuid.UUID]models.TenderAuction{},
+		seals:         map[uuid.UUID]models.TenderSeal{},
+	}
+	for _, serviceType := range models.DefaultServiceTypes {
+		serviceType.Names = maps.Clone(serviceType.Names)
+		store.serviceTypes[serviceType.Code] = serviceType
+	}
+	return store
+}
+
+// page применяет limit и offset к уже отсортированному срезу
+func page[T any](items []T, limit, offset int) []T {
+	if offset > 0 {
+		if offset >= len(items) {
+			return []T{}
+		}
+		items = items[offset:]
+	}
+	if limit > 0 && limit < len(items) {
+		items = items[:limit]
+	}
+	return items
+}
+
+func newID(id uuid.UUID) uuid.UUID {
+	if id == uuid.Nil {
+		return uuid.New()
+	}
+	return id
+}
+
+type memoryTenders struct {
+	store *memoryStore
+}
+
+func (r *memoryTenders) Create(_ context.Context, tender *models.Tender) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	return r.store.insertTender(tender)
+}
+
+func (r *memoryTenders) CreateMany(_ context.Context, tenders []models.Tender) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for i := range tenders {
+		if _, ok := r.store.tenders[tenders[i].ID]; ok && tenders[i].ID != uuid.Nil {
+			return ErrDuplicate
+		}
+	}
+	for i := range tenders {
+		if err := r.store.insertTender(&tenders[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// insertTender добавляет версию тендера; вызывается под блокировкой
+func (m *memoryStore) insertTender(tender *models.Tender) error {
+	tender.ID = newID(tender.ID)
+	tender.VersionID = newID(tender.VersionID)
+	if tender.Status == "" {
+		tender.Status = "Created"
+	}
+	if tender.Rounds == 0 {
+		tender.Rounds = 1
+	}
+	if tender.Round == 0 {
+		tender.Round = 1
+	}
+	if tender.Version == 0 {
+		tender.Version = 1
+	}
+	if tender.CreatedAt.IsZero() {
+		tender.CreatedAt = time.Now()
+	}
+	for _, version := range m.tenders[tender.ID] {
+		if version.Version == tender.Version || version.VersionID == tender.VersionID {
+			return ErrDuplicate
+		}
+	}
+
+	versions := append(m.tenders[tender.ID], *tender)
+	sort.SliceStable(versions, func(i, j int) bool { return versions[i].Version < versions[j].Version })
+	m.tenders[tender.ID] = versions
+	return nil
+}
+
+func (r *memoryTenders) Save(ctx context.Context, tender *models.Tender) error {
+	r.store.mu.Lock()
+	versions := r.store.tenders[tender.ID]
+	for i := range versions {
+		if versions[i].VersionID == tender.VersionID {
+			versions[i] = *tender
+			r.store.mu.Unlock()
+			return nil
+		}
+	}
+	r.store.mu.Unlock()
+	return r.Create(ctx, tender)
+}
+
+func (r *memoryTenders) GetLast(_ context.Context, id uuid.UUID) (*models.Tender, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	versions := r.store.tenders[id]
+	if len(versions) == 0 {
+		return nil, ErrNotFound
+	}
+	tender := versions[len(versions)-1]
+	return &tender, nil
+}
+
+func (r *memoryTenders) GetVersion(_ context.Context, id uuid.UUID, version int32) (*models.Tender, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, tender := range r.store.tenders[id] {
+		if tender.Version == version {
+			return &tender, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+func (r *memoryTenders) ListVersions(_ context.Context, id uuid.UUID) ([]models.Tender, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return slices.Clone(r.store.tenders[id]), nil
+}
+
+// current выбирает текущую, то есть последнюю, версию каждого тендера
+func (r *memoryTenders) current() []models.Tender {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	tenders := []models.Tender{}
+	for _, versions := range r.store.tenders {
+		tenders = append(tenders, versions[len(versions)-1])
+	}
+	return tenders
+}
+
+func sortTendersByName(tenders []models.Tender) {
+	sort.SliceStable(tenders, func(i, j int) bool {
+		if tenders[i].Name != tenders[j].Name {
+			return tenders[i].Name < tenders[j].Name
+		}
+		return tenders[i].ID.String() < tenders[j].ID.String()
+	})
+}
+
+// compareTenders сравнивает тендеры по полю сортировки фильтра; пустые срок и бюджет всегда последние
+func compareTenders(a, b *models.Tender, filter *models.TenderFilter) int {
+	direction := 1
+	if filter.Descending {
+		direction = -1
+	}
+	switch filter.Sort {
+	case models.TenderSortCreatedAt:
+		return direction * a.CreatedAt.Compare(b.CreatedAt)
+	case models.TenderSortDeadline:
+		if a.Deadline == nil || b.Deadline == nil {
+			return compareNil(a.Deadline == nil, b.Deadline == nil)
+		}
+		return direction * a.Deadline.Compare(*b.Deadline)
+	case models.TenderSortBudget:
+		if a.Budget == nil || b.Budget == nil {
+			return compareNil(a.Budget == nil, b.Budget == nil)
+		}
+		return direction * cmp.Compare(*a.Budget, *b.Budget)
+	}
+	return direction * strings.Compare(a.Name, b.Name)
+}
+
+func compareNil(aNil, bNil bool) int {
+	switch {
+	case aNil == bNil:
+		return 0
+	case aNil:
+		return 1
+	}
+	return -1
+}
+
+func (r *memoryTenders) ListPublished(_ context.Context, filter *models.TenderFilter, limit, offset int) ([]models.Tender, error) {
+	if filter == nil {
+		filter = &models.TenderFilter{}
+	}
+	r.store.mu.RLock()
+	organizationTypes := map[string]string{}
+	for id, organization := range r.store.organizations {
+		organizationTypes[id.String()] = organization.Type
+	}
+	r.store.mu.RUnlock()
+
+	tenders := slices.DeleteFunc(r.current(), func(tender models.Tender) bool {
+		return tender.Status != "Published" || !filter.Match(&tender, organizationTypes[tender.OrganizationId])
+	})
+	sort.SliceStable(tenders, func(i, j int) bool {
+		if c := compareTenders(&tenders[i], &tenders[j], filter); c != 0 {
+			return c < 0
+		}
+		return tenders[i].ID.String() < tenders[j].ID.String()
+	})
+	return page(tenders, limit, offset), nil
+}
+
+func (r *memoryTenders) ListByOrganizations(_ context.Context, organizationIDs []string, limit, offset int) ([]models.Tender, error) {
+	tenders := r.current()
+	tenders = slices.DeleteFunc(tenders, func(tender models.Tender) bool {
+		return !slices.Contains(organizationIDs, tender.OrganizationId)
+	})
+	sortTendersByName(tenders)
+	return page(tenders, limit, offset), nil
+}
+
+func (r *memoryTenders) List(_ context.Context, status string, limit, offset int) ([]models.Tender, error) {
+	tenders := r.current()
+	if status != "" {
+		tenders = slices.DeleteFunc(tenders, func(tender models.Tender) bool {
+			return tender.Status != status
+		})
+	}
+	sort.SliceStable(tenders, func(i, j int) bool {
+		return tenders[i].CreatedAt.Before(tenders[j].CreatedAt)
+	})
+	return page(tenders, limit, offset), nil
+}
+
+func (r *memoryTenders) CountOpen(_ context.Context, organizationID string) (int64, error) {
+	tenders := r.current()
+
+	var count int64
+	for _, tender := range tenders {
+		if tender.OrganizationId == organizationID && tender.Status != "Closed" {
+			count++
+		}
+	}
+	return count, nil
+}
+
+type memoryBids struct {
+	store *memoryStore
+}
+
+func (r *memoryBids) Create(_ context.Context, bid *models.Bid) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	return r.store.insertBid(bid)
+}
+
+// insertBid добавляет версию предложения; вызывается под блокировкой
+func (m *memoryStore) insertBid(bid *models.Bid) error {
+	bid.ID = newID(bid.ID)
+	bid.VersionID = newID(bid.VersionID)
+	if bid.Status == "" {
+		bid.Status = "Created"
+	}
+	if bid.Round == 0 {
+		bid.Round = 1
+	}
+	if bid.Version == 0 {
+		bid.Version = 1
+	}
+	if bid.CreatedAt.IsZero() {
+		bid.CreatedAt = time.Now()
+	}
+	for _, version := range m.bids[bid.ID] {
+		if version.Version == bid.Version || version.VersionID == bid.VersionID {
+			return ErrDuplicate
+		}
+	}
+
+	versions := append(m.bids[bid.ID], *bid)
+	sort.SliceStable(versions, func(i, j int) bool { return versions[i].Version < versions[j].Version })
+	m.bids[bid.ID] = versions
+	return nil
+}
+
+func (r *memoryBids) Save(ctx context.Context, bid *models.Bid) error {
+	r.store.mu.Lock()
+	versions := r.store.bids[bid.ID]
+	for i := range versions {
+		if versions[i].VersionID == bid.VersionID {
+			saved := *bid
+			saved.Name, saved.Description = versions[i].Name, versions[i].Description
+			saved.Sealed, saved.Commitment = versions[i].Sealed, versions[i].Commitment
+			versions[i] = saved
+			r.store.mu.Unlock()
+			return nil
+		}
+	}
+	r.store.mu.Unlock()
+	return r.Create(ctx, bid)
+}
+
+func (r *memoryBids) GetLast(_ context.Context, id uuid.UUID) (*models.Bid, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	versions := r.store.bids[id]
+	if len(versions) == 0 {
+		return nil, ErrNotFound
+	}
+	bid := versions[len(versions)-1]
+	return &bid, nil
+}
+
+func (r *memoryBids) GetVersion(_ context.Context, id uuid.UUID, version int32) (*models.Bid, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, bid := range r.store.bids[id] {
+		if bid.Version == version {
+			return &bid, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+func (r *memoryBids) ListVersions(_ context.Context, id uuid.UUID) ([]models.Bid, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return slices.Clone(r.store.bids[id]), nil
+}
+
+func (r *memoryBids) current() []models.Bid {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	bids := []models.Bid{}
+	for _, versions := range r.store.bids {
+		bids = append(bids, versions[len(versions)-1])
+	}
+	return bids
+}
+
+func sortBidsByName(bids []models.Bid) {
+	sort.SliceStable(bids, func(i, j int) bool {
+		if bids[i].Name != bids[j].Name {
+			return bids[i].Name < bids[j].Name
+		}
+		return bids[i].ID.String() < bids[j].ID.String()
+	})
+}
+
+// latestBidVersions оставляет по каждому предложению версию с наибольшим номером и сортирует по имени
+func latestBidVersions(versions []models.Bid) []models.Bid {
+	latest := map[uuid.UUID]models.Bid{}
+	for _, bid := range versions {
+		if existing, ok := latest[bid.ID]; !ok || bid.Version > existing.Version {
+			latest[bid.ID] = bid
+		}
+	}
+	bids := slices.Collect(maps.Values(latest))
+	sortBidsByName(bids)
+	return bids
+}
+
+func (r *memoryBids) ListByAuthor(_ context.Context, authorID uuid.UUID, limit, offset int) ([]models.Bid, error) {
+	bids := r.current()
+	bids = slices.DeleteFunc(bids, func(bid models.Bid) bool { return bid.AuthorId != authorID })
+	sortBidsByName(bids)
+	return page(bids, limit, offset), nil
+}
+
+func (r *memoryBids) ListPublishedByTender(_ context.Context, tenderID uuid.UUID, limit, offset int) ([]models.Bid, error) {
+	bids := slices.DeleteFunc(r.current(), func(bid models.Bid) bool {
+		return bid.Status != "Published" || bid.TenderId != tenderID
+	})
+	sortBidsByName(bids)
+	return page(bids, limit, offset), nil
+}
+
+func (r *memoryBids) ListByTender(_ context.Context, tenderID uuid.UUID, limit, offset int) ([]models.Bid, error) {
+	bids := r.current()
+	bids = slices.DeleteFunc(bids, func(bid models.Bid) bool { return bid.TenderId != tenderID })
+	sort.SliceStable(bids, func(i, j int) bool {
+		return bids[i].CreatedAt.Before(bids[j].CreatedAt)
+	})
+	return page(bids, limit, offset), nil
+}
+
+func (r *memoryBids) ExistsByAuthor(_ context.Context, authorID uuid.UUID, tenderID uuid.UUID) (bool, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, versions := range r.store.bids {
+		for _, bid := range versions {
+			if bid.AuthorId == authorID && bid.TenderId == tenderID {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+func (r *memoryBids) ListIDsByAuthor(_ context.Context, authorID uuid.UUID) ([]uuid.UUID, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var ids []uuid.UUID
+	for id, versions := range r.store.bids {
+		for _, bid := range versions {
+			if bid.AuthorId == authorID {
+				ids = append(ids, id)
+				break
+			}
+		}
+	}
+	return ids, nil
+}
+
+func (r *memoryBids) SaveDecision(_ context.Context, decision *models.BidDecision) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	decision.CreatedAt = time.Now()
+	for i, existing := range r.store.decisions {
+		if existing.BidId == decision.BidId && existing.EmployeeId == decision.EmployeeId {
+			decision.ID = existing.ID
+			r.store.decisions[i] = *decision
+			return nil
+		}
+	}
+	return r.store.insertDecision(decision)
+}
+
+// insertDecision добавляет голос; вызывается под блокировкой
+func (m *memoryStore) insertDecision(decision *models.BidDecision) error {
+	decision.ID = newID(decision.ID)
+	for _, existing := range m.decisions {
+		if existing.ID == decision.ID ||
+			existing.BidId == decision.BidId && existing.EmployeeId == decision.EmployeeId {
+			return ErrDuplicate
+		}
+	}
+	if decision.CreatedAt.IsZero() {
+		decision.CreatedAt = time.Now()
+	}
+	m.decisions = append(m.decisions, *decision)
+	return nil
+}
+
+func (r *memoryBids) ListDecisions(_ context.Context, bidID uuid.UUID, employeeIDs []uuid.UUID) ([]models.BidDecision, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	decisions := []models.BidDecision{}
+	for _, decision := range r.store.decisions {
+		if decision.BidId == bidID && slices.Contains(employeeIDs, decision.EmployeeId) {
+			decisions = append(decisions, decision)
+		}
+	}
+	return decisions, nil
+}
+
+func (r *memoryBids) ListDecisionsByBids(_ context.Context, bidIDs []uuid.UUID) ([]models.BidDecision, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	decisions := []models.BidDecision{}
+	for _, decision := range r.store.decisions {
+		if slices.Contains(bidIDs, decision.BidId) {
+			decisions = append(decisions, decision)
+		}
+	}
+	sort.SliceStable(decisions, func(i, j int) bool {
+		return decisions[i].CreatedAt.Before(decisions[j].CreatedAt)
+	})
+	return decisions, nil
+}
+
+func (r *memoryBids) ListRoundVersions(_ context.Context, tenderID uuid.UUID, round int32) ([]models.Bid, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var versions []models.Bid
+	for _, bids := range r.store.bids {
+		for _, bid := range bids {
+			if bid.TenderId == tenderID && bid.Round == round {
+				versions = append(versions, bid)
+			}
+		}
+	}
+	return latestBidVersions(versions), nil
+}
+
+func (r *memoryBids) SaveShortlist(_ context.Context, entry *models.BidShortlist) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	entry.CreatedAt = time.Now()
+	for i, existing := range r.store.shortlists {
+		if existing.TenderId == entry.TenderId && existing.Round == entry.Round && existing.BidId == entry.BidId {
+			entry.ID = existing.ID
+			r.store.shortlists[i] = *entry
+			return nil
+		}
+	}
+	return r.store.insertShortlist(entry)
+}
+
+// insertShortlist добавляет запись шорт-листа; вызывается под блокировкой
+func (m *memoryStore) insertShortlist(entry *models.BidShortlist) error {
+	entry.ID = newID(entry.ID)
+	for _, existing := range m.shortlists {
+		if existing.ID == entry.ID ||
+			existing.TenderId == entry.TenderId && existing.Round == entry.Round && existing.BidId == entry.BidId {
+			return ErrDuplicate
+		}
+	}
+	if entry.CreatedAt.IsZero() {
+		entry.CreatedAt = time.Now()
+	}
+	m.shortlists = append(m.shortlists, *entry)
+	return nil
+}
+
+func (r *memoryBids) DeleteShortlist(_ context.Context, tenderID uuid.UUID, round int32, bidID uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	count := len(r.store.shortlists)
+	r.store.shortlists = slices.DeleteFunc(r.store.shortlists, func(entry models.BidShortlist) bool {
+		return entry.TenderId == tenderID && entry.Round == round && entry.BidId == bidID
+	})
+	if len(r.store.shortlists) == count {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (r *memoryBids) ListShortlist(_ context.Context, tenderID uuid.UUID, round int32) ([]models.BidShortlist, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	shortlist := []models.BidShortlist{}
+	for _, entry := range r.store.shortlists {
+		if entry.TenderId == tenderID && entry.Round == round {
+			shortlist = append(shortlist, entry)
+		}
+	}
+	sort.SliceStable(shortlist, func(i, j int) bool {
+		return shortlist[i].CreatedAt.Before(shortlist[j].CreatedAt)
+	})
+	return shortlist, nil
+}
+
+type memoryFeedbacks struct {
+	store *memoryStore
+}
+
+func (r *memoryFeedbacks) Create(_ context.Context, feedback *models.BidFeedback) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	return r.store.insertFeedback(feedback)
+}
+
+// insertFeedback добавляет отзыв; вызывается под блокировкой
+func (m *memoryStore) insertFeedback(feedback *models.BidFeedback) error {
+	feedback.ID = newID(feedback.ID)
+	for _, existing := range m.feedbacks {
+		if existing.ID == feedback.ID {
+			return ErrDuplicate
+		}
+	}
+	if feedback.CreatedAt.IsZero() {
+		feedback.CreatedAt = time.Now()
+	}
+	m.feedbacks = append(m.feedbacks, *feedback)
+	return nil
+}
+
+func (r *memoryFeedbacks) ListByBids(_ context.Context, bidIDs []uuid.UUID, limit, offset int) ([]models.BidFeedback, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	feedbacks := []models.BidFeedback{}
+	for _, feedback := range r.store.feedbacks {
+		if slices.Contains(bidIDs, feedback.BidId) {
+			feedbacks = append(feedbacks, feedback)
+		}
+	}
+	sort.SliceStable(feedbacks, func(i, j int) bool {
+		return feedbacks[i].CreatedAt.After(feedbacks[j].CreatedAt)
+	})
+	return page(feedbacks, limit, offset), nil
+}
+
+type memoryEmployees struct {
+	store *memoryStore
+}
+
+func (r *memoryEmployees) Get(_ context.Context, id uuid.UUID) (*models.Employee, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	employee, ok := r.store.employees[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return &employee, nil
+}
+
+func (r *memoryEmployees) ListByIDs(_ context.Context, ids []uuid.UUID) ([]models.Employee, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	employees := []models.Employee{}
+	for _, id := range ids {
+		if employee, ok := r.store.employees[id]; ok {
+			employees = append(employees, employee)
+		}
+	}
+	return employees, nil
+}
+
+func (r *memoryEmployees) GetByUsername(_ context.Context, username string) (*models.Employee, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, employee := range r.store.employees {
+		if employee.Username == username {
+			return &employee, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+func (r *memoryEmployees) List(_ context.Context, limit, offset int) ([]models.Employee, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	employees := make([]models.Employee, 0, len(r.store.employees))
+	for _, employee := range r.store.employees {
+		employees = append(employees, employee)
+	}
+	sort.Slice(employees, func(i, j int) bool {
+		return employees[i].Username < employees[j].Username
+	})
+	return page(employees, limit, offset), nil
+}
+
+// usernameTaken проверяет уникальность имени пользователя; вызывается под блокировкой
+func (m *memoryStore) usernameTaken(employee *models.Employee) bool {
+	for _, existing := range m.employees {
+		if existing.ID != employee.ID && existing.Username == employee.Username {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *memoryEmployees) Create(_ context.Context, employee *models.Employee) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	return r.store.insertEmployee(employee)
+}
+
+// insertEmployee добавляет сотрудника; вызывается под блокировкой
+func (m *memoryStore) insertEmployee(employee *models.Employee) error {
+	employee.ID = newID(employee.ID)
+	if _, ok := m.employees[employee.ID]; ok || m.usernameTaken(employee) {
+		return ErrDuplicate
+	}
+	if employee.Locale == "" {
+		employee.Locale = "ru"
+	}
+	now := time.Now()
+	if employee.CreatedAt.IsZero() {
+		employee.CreatedAt = now
+	}
+	if employee.UpdatedAt.IsZero() {
+		employee.UpdatedAt = now
+	}
+	m.employees[employee.ID] = *employee
+	return nil
+}
+
+func (r *memoryEmployees) Save(_ context.Context, employee *models.Employee) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if r.store.usernameTaken(employee) {
+		return ErrDuplicate
+	}
+	employee.UpdatedAt = time.Now()
+	r.store.employees[employee.ID] = *employee
+	return nil
+}
+
+func (r *memoryEmployees) Delete(_ context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	delete(r.store.employees, id)
+	maps.DeleteFunc(r.store.searches, func(_ uuid.UUID, search models.SavedSearch) bool {
+		return search.EmployeeID == id
+	})
+	r.store.responsibles = slices.DeleteFunc(r.store.responsibles, func(responsible models.OrganizationResponsible) bool {
+		return responsible.UserID == id
+	})
+	return nil
+}
+
+// sameOrganization сравнивает идентификаторы как UUID, как это делает PostgreSQL
+func sameOrganization(id uuid.UUID, organizationID string) bool {
+	parsed, err := uuid.Parse(organizationID)
+	return err == nil && parsed == id
+}
+
+func (r *memoryEmployees) ListResponsibilities(_ context.Context, employeeID uuid.UUID) ([]models.OrganizationResponsible, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	responsibles := []models.OrganizationResponsible{}
+	for _, responsible := range r.store.responsibles {
+		if responsible.UserID == employeeID {
+			responsibles = append(responsibles, responsible)
+		}
+	}
+	return responsibles, nil
+}
+
+func (r *memoryEmployees) ListResponsibles(_ context.Context, organizationID string) ([]models.Employee, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	employees := []models.Employee{}
+	for _, responsible := range r.store.responsibles {
+		if !sameOrganization(responsible.OrganizationID, organizationID) {
+			continue
+		}
+		if employee, ok := r.store.employees[responsible.UserID]; ok {
+			employees = append(employees, employee)
+		}
+	}
+	return employees, nil
+}
+
+func (r *memoryEmployees) ListResponsibleIDs(_ context.Context, organizationID string, roles []string) ([]uuid.UUID, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var ids []uuid.UUID
+	for _, responsible := range r.store.responsibles {
+		if sameOrganization(responsible.OrganizationID, organizationID) &&
+			slices.Contains(roles, responsible.Role) &&
+			!slices.Contains(ids, responsible.UserID) {
+			ids = append(ids, responsible.UserID)
+		}
+	}
+	return ids, nil
+}
+
+type memoryOrganizations struct {
+	store *memoryStore
+}
+
+func (r *memoryOrganizations) Get(_ context.Context, id uuid.UUID) (*models.Organization, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	organization, ok := r.store.organizations[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return &organization, nil
+}
+
+func (r *memoryOrganizations) List(_ context.Context, limit, offset int) ([]models.Organization, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	organizations := make([]models.Organization, 0, len(r.store.organizations))
+	for _, organization := range r.store.organizations {
+		organizations = append(organizations, organization)
+	}
+	sort.Slice(organizations, func(i, j int) bool {
+		if organizations[i].Name != organizations[j].Name {
+			return organizations[i].Name < organizations[j].Name
+		}
+		return organizations[i].ID.String() < organizations[j].ID.String()
+	})
+	return page(organizations, limit, offset), nil
+}
+
+func (r *memoryOrganizations) Create(_ context.Context, organization *models.Organization) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	return r.store.insertOrganization(organization)
+}
+
+// insertOrganization добавляет организацию; вызывается под блокировкой
+func (m *memoryStore) insertOrganization(organization *models.Organization) error {
+	organization.ID = newID(organization.ID)
+	if _, ok := m.organizations[organization.ID]; ok {
+		return ErrDuplicate
+	}
+	now := time.Now()
+	if organization.CreatedAt.IsZero() {
+		organization.CreatedAt = now
+	}
+	if organization.UpdatedAt.IsZero() {
+		organization.UpdatedAt = now
+	}
+	m.organizations[organization.ID] = *organization
+	return nil
+}
+
+func (r *memoryOrganizations) Save(_ context.Context, organization *models.Organization) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	organization.UpdatedAt = time.Now()
+	r.store.organizations[organization.ID] = *organization
+	return nil
+}
+
+func (r *memoryOrganizations) Delete(_ context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	delete(r.store.organizations, id)
+	r.store.responsibles = slices.DeleteFunc(r.store.responsibles, func(responsible models.OrganizationResponsible) bool {
+		return responsible.OrganizationID == id
+	})
+	return nil
+}
+
+func (r *memoryOrganizations) ListResponsibles(_ context.Context, organizationID uuid.UUID) ([]models.OrganizationResponsible, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	responsibles := []models.OrganizationResponsible{}
+	for _, responsible := range r.store.responsibles {
+		if responsible.OrganizationID == organizationID {
+			responsible.Employee = r.store.employees[responsible.UserID]
+			responsibles = append(responsibles, responsible)
+		}
+	}
+	return responsibles, nil
+}
+
+func (r *memoryOrganizations) GetResponsible(_ context.Context, organizationID uuid.UUID, employeeID uuid.UUID) (*models.OrganizationResponsible, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, responsible := range r.store.responsibles {
+		if responsible.OrganizationID == organizationID && responsible.UserID == employeeID {
+			return &responsible, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+func (r *memoryOrganizations) SaveResponsible(_ context.Context, responsible *models.OrganizationResponsible) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for i, existing := range r.store.responsibles {
+		if existing.ID == responsible.ID {
+			r.store.responsibles[i].Role = responsible.Role
+			return nil
+		}
+	}
+	return r.store.insertResponsible(responsible)
+}
+
+// insertResponsible назначает ответственного; вызывается под блокировкой
+func (m *memoryStore) insertResponsible(responsible *models.OrganizationResponsible) error {
+	responsible.ID = newID(responsible.ID)
+	for _, existing := range m.responsibles {
+		if existing.ID == responsible.ID {
+			return ErrDuplicate
+		}
+	}
+	if responsible.Role == "" {
+		responsible.Role = models.RoleOrgAdmin
+	}
+	stored := *responsible
+	stored.Organization = models.Organization{}
+	stored.Employee = models.Employee{}
+	m.responsibles = append(m.responsibles, stored)
+	return nil
+}
+
+func (r *memoryOrganizations) DeleteResponsible(_ context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	r.store.responsibles = slices.DeleteFunc(r.store.responsibles, func(responsible models.OrganizationResponsible) bool {
+		return responsible.ID == id
+	})
+	return nil
+}
+
+func (r *memoryOrganizations) CountResponsibles(_ context.Context, organizationID uuid.UUID) (int64, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var count int64
+	for _, responsible := range r.store.responsibles {
+		if responsible.OrganizationID == organizationID {
+			count++
+		}
+	}
+	return count, nil
+}
+
+type memoryEmails struct {
+	store *memoryStore
+}
+
+func (r *memoryEmails) Create(_ context.Context, message *models.EmailMessage) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	r.store.insertEmail(message)
+	return nil
+}
+
+// insertEmail добавляет письмо в очередь; вызывается под блокировкой
+func (m *memoryStore) insertEmail(message *models.EmailMessage) {
+	message.ID = newID(message.ID)
+	if message.Status == "" {
+		message.Status = models.EmailStatusPending
+	}
+	if message.CreatedAt.IsZero() {
+		message.CreatedAt = time.Now()
+	}
+	m.emails = append(m.emails, *message)
+}
+
+func (r *memoryEmails) Save(_ context.Context, message *models.EmailMessage) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for i := range r.store.emails {
+		if r.store.emails[i].ID == message.ID {
+			r.store.emails[i] = *message
+			return nil
+		}
+	}
+	return ErrNotFound
+}
+
+func (r *memoryEmails) ClaimDue(_ context.Context, now, until time.Time, limit int) ([]models.EmailMessage, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	due := []int{}
+	for i, message := range r.store.emails {
+		if message.Status == models.EmailStatusPending && !message.NextAttemptAt.After(now) {
+			due = append(due, i)
+		}
+	}
+	sort.SliceStable(due, func(i, j int) bool {
+		return r.store.emails[due[i]].NextAttemptAt.Before(r.store.emails[due[j]].NextAttemptAt)
+	})
+	messages := []models.EmailMessage{}
+	for _, i := range page(due, limit, 0) {
+		r.store.emails[i].NextAttemptAt = until
+		messages = append(messages, r.store.emails[i])
+	}
+	return messages, nil
+}
+
+type memoryProtocols struct {
+	store *memoryStore
+}
+
+func (r *memoryProtocols) Save(_ context.Context, protocol *models.TenderProtocol) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if protocol.CreatedAt.IsZero() {
+		protocol.CreatedAt = time.Now()
+	}
+	r.store.protocols[protocol.TenderID] = *protocol
+	return nil
+}
+
+func (r *memoryProtocols) Get(_ context.Context, tenderID uuid.UUID) (*models.TenderProtocol, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	protocol, ok := r.store.protocols[tenderID]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return &protocol, nil
+}
+
+type memoryServiceTypes struct {
+	store *memoryStore
+}
+
+// cloneServiceType копирует запись вместе с названиями, чтобы вызывающий не менял хранилище
+func cloneServiceType(serviceType models.ServiceType) models.ServiceType {
+	serviceType.Names = maps.Clone(serviceType.Names)
+	if serviceType.ParentCode != nil {
+		parentCode := *serviceType.ParentCode
+		serviceType.ParentCode = &parentCode
+	}
+	return serviceType
+}
+
+func (r *memoryServiceTypes) List(_ context.Context) ([]models.ServiceType, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	serviceTypes := make([]models.ServiceType, 0, len(r.store.serviceTypes))
+	for _, serviceType := range r.store.serviceTypes {
+		serviceTypes = append(serviceTypes, cloneServiceType(serviceType))
+	}
+	sort.Slice(serviceTypes, func(i, j int) bool {
+		return serviceTypes[i].Code < serviceTypes[j].Code
+	})
+	return serviceTypes, nil
+}
+
+func (r *memoryServiceTypes) Get(_ context.Context, code string) (*models.ServiceType, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	serviceType, ok := r.store.serviceTypes[code]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	serviceType = cloneServiceType(serviceType)
+	return &serviceType, nil
+}
+
+func (r *memoryServiceTypes) Create(_ context.Context, serviceType *models.ServiceType) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.serviceTypes[serviceType.Code]; ok {
+		return ErrDuplicate
+	}
+	now := time.Now()
+	serviceType.CreatedAt, serviceType.UpdatedAt = now, now
+	r.store.serviceTypes[serviceType.Code] = cloneServiceType(*serviceType)
+	return nil
+}
+
+func (r *memoryServiceTypes) Save(_ context.Context, serviceType *models.ServiceType) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	serviceType.UpdatedAt = time.Now()
+	if serviceType.CreatedAt.IsZero() {
+		serviceType.CreatedAt = serviceType.UpdatedAt
+	}
+	r.store.serviceTypes[serviceType.Code] = cloneServiceType(*serviceType)
+	return nil
+}
+
+func (r *memoryServiceTypes) Delete(_ context.Context, code string) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	delete(r.store.serviceTypes, code)
+	return nil
+}
+
+func (r *memoryServiceTypes) CountTenders(_ context.Context, code string) (int64, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var count int64
+	for _, versions := range r.store.tenders {
+		if slices.ContainsFunc(versions, func(tender models.Tender) bool { return tender.ServiceType == code }) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+type memorySavedSearches struct {
+	store *memoryStore
+}
+
+func (r *memorySavedSearches) Create(_ context.Context, search *models.SavedSearch) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	search.ID = newID(search.ID)
+	if _, ok := r.store.searches[search.ID]; ok {
+		return ErrDuplicate
+	}
+	now := time.Now()
+	search.CreatedAt, search.UpdatedAt = now, now
+	r.store.searches[search.ID] = *search
+	return nil
+}
+
+func (r *memorySavedSearches) Save(_ context.Context, search *models.SavedSearch) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	search.UpdatedAt = time.Now()
+	r.store.searches[search.ID] = *search
+	return nil
+}
+
+func (r *memorySavedSearches) Get(_ context.Context, id uuid.UUID) (*models.SavedSearch, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	search, ok := r.store.searches[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return &search, nil
+}
+
+func (r *memorySavedSearches) list(keep func(search models.SavedSearch) bool) []models.SavedSearch {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	searches := []models.SavedSearch{}
+	for _, search := range r.store.searches {
+		if keep(search) {
+			searches = append(searches, search)
+		}
+	}
+	sort.Slice(searches, func(i, j int) bool {
+		a, b := searches[i], searches[j]
+		if a.EmployeeID != b.EmployeeID {
+			return a.EmployeeID.String() < b.EmployeeID.String()
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.ID.String() < b.ID.String()
+	})
+	return searches
+}
+
+func (r *memorySavedSearches) ListByEmployee(_ context.Context, employeeID uuid.UUID) ([]models.SavedSearch, error) {
+	return r.list(func(search models.SavedSearch) bool { return search.EmployeeID == employeeID }), nil
+}
+
+func (r *memorySavedSearches) ListNotified(_ context.Context) ([]models.SavedSearch, error) {
+	return r.list(func(search models.SavedSearch) bool { return search.Notify }), nil
+}
+
+func (r *memorySavedSearches) Delete(_ context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	delete(r.store.searches, id)
+	return nil
+}
+
+func (r *memorySavedSearches) EnqueueMatch(_ context.Context, match *models.TenderMatch) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	match.ID = newID(match.ID)
+	if match.CreatedAt.IsZero() {
+		match.CreatedAt = time.Now()
+	}
+	r.store.matches = append(r.store.matches, *match)
+	return nil
+}
+
+func (r *memorySavedSearches) ClaimMatches(_ context.Context, now, until time.Time, limit int) ([]models.TenderMatch, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	due := []int{}
+	for i, match := range r.store.matches {
+		if !match.NextAttemptAt.After(now) {
+			due = append(due, i)
+		}
+	}
+	sort.SliceStable(due, func(i, j int) bool {
+		return r.store.matches[due[i]].NextAttemptAt.Before(r.store.matches[due[j]].NextAttemptAt)
+	})
+	matches := []models.TenderMatch{}
+	for _, i := range page(due, limit, 0) {
+		r.store.matches[i].NextAttemptAt = until
+		matches = append(matches, r.store.matches[i])
+	}
+	return matches, nil
+}
+
+func (r *memorySavedSearches) CompleteMatch(_ context.Context, id uuid.UUID, messages []models.EmailMessage) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	i := slices.IndexFunc(r.store.matches, func(match models.TenderMatch) bool { return match.ID == id })
+	if i < 0 {
+		return ErrNotFound
+	}
+	r.store.matches = slices.Delete(r.store.matches, i, i+1)
+	for _, message := range messages {
+		r.store.insertEmail(&message)
+	}
+	return nil
+}
+
+type memoryAuctions struct {
+	store *memoryStore
+}
+
+func (r *memoryAuctions) Get(_ context.Context, tenderID uuid.UUID) (*models.TenderAuction, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	auction, ok := r.store.auctions[tenderID]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return &auction, nil
+}
+
+func (r *memoryAuctions) Save(_ context.Context, auction *models.TenderAuction) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	r.store.saveAuction(auction)
+	return nil
+}
+
+// saveAuction заменяет аукцион тендера; вызывается под блокировкой
+func (m *memoryStore) saveAuction(auction *models.TenderAuction) {
+	now := time.Now()
+	if existing, ok := m.auctions[auction.TenderId]; ok {
+		auction.CreatedAt = existing.CreatedAt
+	} else if auction.CreatedAt.IsZero() {
+		auction.CreatedAt = now
+	}
+	auction.UpdatedAt = now
+	m.auctions[auction.TenderId] = *auction
+}
+
+func (r *memoryAuctions) CountOffers(_ context.Context, tenderID uuid.UUID) (int64, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var count int64
+	for _, offer := range r.store.offers {
+		if offer.TenderId == tenderID {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *memoryAuctions) PlaceOffer(_ context.Context, offer *models.AuctionOffer,
+	accept func(auction *models.TenderAuction, previous *models.AuctionOffer) error) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	auction, ok := r.store.auctions[offer.TenderId]
+	if !ok {
+		return ErrNotFound
+	}
+	var previous *models.AuctionOffer
+	for i := range r.store.offers {
+		if r.store.offers[i].BidId == offer.BidId {
+			last := r.store.offers[i]
+			previous = &last
+		}
+	}
+
+	if err := accept(&auction, previous); err != nil {
+		return err
+	}
+	r.store.saveAuction(&auction)
+	return r.store.insertOffer(offer)
+}
+
+// insertOffer добавляет ставку; вызывается под блокировкой
+func (m *memoryStore) insertOffer(offer *models.AuctionOffer) error {
+	offer.ID = newID(offer.ID)
+	for _, existing := range m.offers {
+		if existing.ID == offer.ID {
+			return ErrDuplicate
+		}
+	}
+	if offer.CreatedAt.IsZero() {
+		offer.CreatedAt = time.Now()
+	}
+	m.offers = append(m.offers, *offer)
+	return nil
+}
+
+// sortAuctionOffers упорядочивает ставки по цене, при равной цене раньше сделанная выше
+func sortAuctionOffers(offers []models.AuctionOffer) {
+	sort.SliceStable(offers, func(i, j int) bool {
+		a, b := offers[i], offers[j]
+		if a.Price != b.Price {
+			return a.Price < b.Price
+		}
+		return a.CreatedAt.Before(b.CreatedAt)
+	})
+}
+
+func (r *memoryAuctions) ListBestOffers(_ context.Context, tenderID uuid.UUID) ([]models.AuctionOffer, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	best := map[uuid.UUID]models.AuctionOffer{}
+	for _, offer := range r.store.offers {
+		if offer.TenderId != tenderID {
+			continue
+		}
+		if existing, ok := best[offer.BidId]; !ok || offer.Price < existing.Price {
+			best[offer.BidId] = offer
+		}
+	}
+	offers := slices.Collect(maps.Values(best))
+	sortAuctionOffers(offers)
+	return offers, nil
+}
+
+type memorySeals struct {
+	store *memoryStore
+}
+
+func (r *memorySeals) Get(_ context.Context, tenderID uuid.UUID) (*models.TenderSeal, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	seal, ok := r.store.seals[tenderID]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return &seal, nil
+}
+
+func (r *memorySeals) Create(_ context.Context, seal *models.TenderSeal) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	return r.store.insertSeal(seal)
+}
+
+// insertSeal добавляет ключ тендера; вызывается под блокировкой
+func (m *memoryStore) insertSeal(seal *models.TenderSeal) error {
+	if _, ok := m.seals[seal.TenderId]; ok {
+		return ErrDuplicate
+	}
+	if seal.CreatedAt.IsZero() {
+		seal.CreatedAt = time.Now()
+	}
+	m.seals[seal.TenderId] = *seal
+	return nil
+}
+
+func (r *memorySeals) ListDue(_ context.Context, now time.Time) ([]models.TenderSeal, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var seals []models.TenderSeal
+	deadlines := map[uuid.UUID]time.Time{}
+	for _, seal := range r.store.seals {
+		versions := r.store.tenders[seal.TenderId]
+		if seal.UnsealedAt != nil || len(versions) == 0 {
+			continue
+		}
+		deadline := versions[len(versions)-1].Deadline
+		if deadline != nil && !deadline.After(now) {
+			seals = append(seals, seal)
+			deadlines[seal.TenderId] = *deadline
+		}
+	}
+	sort.Slice(seals, func(i, j int) bool {
+		return deadlines[seals[i].TenderId].Before(deadlines[seals[j].TenderId])
+	})
+	return seals, nil
+}
+
+func (r *memorySeals) CreateBid(_ context.Context, bid *models.Bid, seal func(seal *models.TenderSeal) error) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	current, ok := r.store.seals[bid.TenderId]
+	if !ok {
+		return ErrNotFound
+	}
+	if err := seal(&current); err != nil {
+		return err
+	}
+	return r.store.insertBid(bid)
+}
+
+func (r *memorySeals) Unseal(_ context.Context, tenderID uuid.UUID, open func(key, sealed []byte) (name, description string, err error),
+	event func(bids []models.Bid) *models.AuditEvent) (bool, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	seal, ok := r.store.seals[tenderID]
+	if !ok {
+		return false, ErrNotFound
+	}
+	if seal.UnsealedAt != nil {
+		return false, nil
+	}
+
+	// Версии расшифровываются в копии, чтобы ошибка не оставила тендер вскрытым наполовину
+	opened := map[uuid.UUID][]models.Bid{}
+	bids := []models.Bid{}
+	for id, versions := range r.store.bids {
+		if len(versions) == 0 || versions[0].TenderId != tenderID {
+			continue
+		}
+		bids = append(bids, versions[len(versions)-1])
+		versions = slices.Clone(versions)
+		for i := range versions {
+			if versions[i].Sealed == nil {
+				continue
+			}
+			name, description, err := open(seal.Key, versions[i].Sealed)
+			if err != nil {
+				return false, err
+			}
+			versions[i].Name, versions[i].Description, versions[i].Sealed = name, description, nil
+		}
+		opened[id] = versions
+	}
+	sort.SliceStable(bids, func(i, j int) bool { return bids[i].CreatedAt.Before(bids[j].CreatedAt) })
+	record := event(bids)
+	maps.Copy(r.store.bids, opened)
+
+	unsealedAt := record.CreatedAt
+	seal.Key, seal.UnsealedAt = nil, &unsealedAt
+	r.store.seals[tenderID] = seal
+	return true, r.store.insertAuditEvent(record)
+}
+
+// insertAuditEvent добавляет запись журнала; вызывается под блокировкой
+func (m *memoryStore) insertAuditEvent(event *models.AuditEvent) error {
+	event.ID = newID(event.ID)
+	for _, existing := range m.audit {
+		if existing.ID == event.ID {
+			return ErrDuplicate
+		}
+	}
+	if event.CreatedAt.IsZero() {
+		event.CreatedAt = time.Now()
+	}
+	m.audit = append(m.audit, *event)
+	return nil
+}
+
+type memoryAudit struct {
+	store *memoryStore
+}
+
+func (r *memoryAudit) ListByTender(_ context.Context, tenderID uuid.UUID, limit, offset int) ([]models.AuditEvent, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var events []models.AuditEvent
+	for _, event := range r.store.audit {
+		if event.TenderId == tenderID {
+			events = append(events, event)
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		if !events[i].CreatedAt.Equal(events[j].CreatedAt) {
+			return events[i].CreatedAt.Before(events[j].CreatedAt)
+		}
+		return events[i].ID.String() < events[j].ID.String()
+	})
+	return page(events, limit, offset), nil
+}
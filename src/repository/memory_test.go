@@ -0,0 +1,268 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"slices"
+	"testing"
+	"time"
+	"zadanie_6105/src/models"
+)
+
+func TestMemoryTenderDefaults(t *testing.T) {
+	ctx := context.Background()
+	tenders := NewMemory().Tenders
+
+	tender := models.Tender{Name: "Доставка"}
+	if err := tenders.Create(ctx, &tender); err != nil {
+		t.Fatal(err)
+	}
+	if tender.ID == uuid.Nil || tender.VersionID == uuid.Nil || tender.Version != 1 ||
+		tender.Status != "Created" || tender.CreatedAt.IsZero() {
+		t.Errorf("Create did not fill defaults: %+v", tender)
+	}
+
+	duplicate := models.Tender{ID: tender.ID, Version: 1}
+	if err := tenders.Create(ctx, &duplicate); !errors.Is(err, ErrDuplicate) {
+		t.Errorf("Create of an existing version error = %v, want ErrDuplicate", err)
+	}
+	if _, err := tenders.GetLast(ctx, uuid.New()); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetLast of an unknown tender error = %v, want ErrNotFound", err)
+	}
+}
+
+// Списки строятся по текущей версии: черновик поверх опубликованной версии скрывает тендер
+func TestMemoryListPublishedUsesCurrentVersion(t *testing.T) {
+	ctx := context.Background()
+	tenders := NewMemory().Tenders
+
+	published := models.Tender{Name: "Опубликован", Status: "Published"}
+	if err := tenders.Create(ctx, &published); err != nil {
+		t.Fatal(err)
+	}
+	draft := models.Tender{ID: published.ID, Name: "Черновик", Version: 2}
+	if err := tenders.Create(ctx, &draft); err != nil {
+		t.Fatal(err)
+	}
+
+	list, err := tenders.ListPublished(ctx, nil, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 0 {
+		t.Errorf("ListPublished = %+v, want no tenders", list)
+	}
+
+	all, err := tenders.List(ctx, "", 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 1 || all[0].Version != 2 {
+		t.Errorf("List = %+v, want version 2", all)
+	}
+}
+
+func TestMemoryListPublishedFilter(t *testing.T) {
+	ctx := context.Background()
+	repos := NewMemory()
+
+	llc := models.Organization{Name: "ООО", Type: "LLC"}
+	ie := models.Organization{Name: "ИП", Type: "IE"}
+	for _, organization := range []*models.Organization{&llc, &ie} {
+		if err := repos.Organizations.Create(ctx, organization); err != nil {
+			t.Fatal(err)
+		}
+	}
+	day := func(d int) *time.Time {
+		t := time.Date(2025, 3, d, 0, 0, 0, 0, time.UTC)
+		return &t
+	}
+	budget := func(b float64) *float64 { return &b }
+	for _, tender := range []models.Tender{
+		{Name: "Дорога", Description: "Ремонт дорожного полотна", ServiceType: "Construction", OrganizationId: llc.ID.String(),
+			Region: "Татарстан", Deadline: day(10), Budget: budget(500), CreatedAt: *day(1)},
+		{Name: "Бетон", Description: "Поставка бетона для ремонта", ServiceType: "Delivery", OrganizationId: ie.ID.String(),
+			Region: "Москва", Deadline: day(5), CreatedAt: *day(2)},
+		{Name: "Мост", Description: "Ремонт моста", ServiceType: "Construction", OrganizationId: ie.ID.String(),
+			Region: "Татарстан", Budget: budget(1000), CreatedAt: *day(3)},
+		{Name: "Архив", Description: "Ремонт склада", ServiceType: "Construction", OrganizationId: llc.ID.String(), Status: "Closed"},
+	} {
+		if tender.Status == "" {
+			tender.Status = "Published"
+		}
+		if err := repos.Tenders.Create(ctx, &tender); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name   string
+		filter models.TenderFilter
+		want   []string
+	}{
+		{"all by name", models.TenderFilter{}, []string{"Бетон", "Дорога", "Мост"}},
+		{"service type", models.TenderFilter{ServiceTypes: []string{"Construction"}}, []string{"Дорога", "Мост"}},
+		{"organization", models.TenderFilter{OrganizationIDs: []string{ie.ID.String()}}, []string{"Бетон", "Мост"}},
+		{"organization type", models.TenderFilter{OrganizationTypes: []string{"LLC"}}, []string{"Дорога"}},
+		{"region", models.TenderFilter{Regions: []string{"Татарстан"}}, []string{"Дорога", "Мост"}},
+		{"created range", models.TenderFilter{CreatedFrom: day(2), CreatedTo: day(3)}, []string{"Бетон", "Мост"}},
+		{"deadline range skips empty", models.TenderFilter{DeadlineFrom: day(6)}, []string{"Дорога"}},
+		{"budget range skips empty", models.TenderFilter{BudgetMin: budget(100), BudgetMax: budget(800)}, []string{"Дорога"}},
+		{"search all words", models.TenderFilter{Search: "ремонт МОСТА"}, []string{"Мост"}},
+		{"search with filters", models.TenderFilter{Search: "ремонт", Regions: []string{"Татарстан"}, OrganizationTypes: []string{"IE"}}, []string{"Мост"}},
+		{"sort by budget", models.TenderFilter{Sort: models.TenderSortBudget}, []string{"Дорога", "Мост", "Бетон"}},
+		{"sort by budget descending", models.TenderFilter{Sort: models.TenderSortBudget, Descending: true}, []string{"Мост", "Дорога", "Бетон"}},
+		{"sort by deadline", models.TenderFilter{Sort: models.TenderSortDeadline}, []string{"Бетон", "Дорога", "Мост"}},
+		{"sort by creation descending", models.TenderFilter{Sort: models.TenderSortCreatedAt, Descending: true}, []string{"Мост", "Бетон", "Дорога"}},
+	}
+	for _, tt := range tests {
+		list, err := repos.Tenders.ListPublished(ctx, &tt.filter, 0, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := []string{}
+		for _, tender := range list {
+			got = append(got, tender.Name)
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%s: ListPublished = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestMemoryPagination(t *testing.T) {
+	ctx := context.Background()
+	employees := NewMemory().Employees
+
+	for _, username := range []string{"c", "a", "b"} {
+		if err := employees.Create(ctx, &models.Employee{Username: username}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := employees.Create(ctx, &models.Employee{Username: "a"}); !errors.Is(err, ErrDuplicate) {
+		t.Errorf("Create with a taken username error = %v, want ErrDuplicate", err)
+	}
+
+	tests := []struct {
+		limit, offset int
+		want          []string
+	}{
+		{0, 0, []string{"a", "b", "c"}},
+		{2, 0, []string{"a", "b"}},
+		{2, 2, []string{"c"}},
+		{0, 5, []string{}},
+	}
+	for _, tt := range tests {
+		list, err := employees.List(ctx, tt.limit, tt.offset)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := []string{}
+		for _, employee := range list {
+			got = append(got, employee.Username)
+		}
+		if len(got) != len(tt.want) {
+			t.Errorf("List(%d, %d) = %v, want %v", tt.limit, tt.offset, got, tt.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("List(%d, %d) = %v, want %v", tt.limit, tt.offset, got, tt.want)
+				break
+			}
+		}
+	}
+}
+
+// Импорт снимка сохраняет историю версий и пропускает уже существующие записи
+func TestMemorySnapshotImport(t *testing.T) {
+	ctx := context.Background()
+	repos := NewMemory()
+
+	tenderID := uuid.New()
+	snapshot := Snapshot{
+		Employees: []models.Employee{{Username: "author"}},
+		Tenders: []models.Tender{
+			{ID: tenderID, Name: "Версия 2", Status: "Published", Version: 2},
+			{ID: tenderID, Name: "Версия 1", Version: 1},
+		},
+	}
+	for i := 0; i < 2; i++ {
+		if err := repos.Snapshots.Import(ctx, &snapshot); err != nil {
+			t.Fatalf("Import #%d: %v", i+1, err)
+		}
+	}
+
+	versions, err := repos.Tenders.ListVersions(ctx, tenderID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(versions) != 2 {
+		t.Fatalf("ListVersions returned %d versions, want 2", len(versions))
+	}
+	last, err := repos.Tenders.GetLast(ctx, tenderID)
+	if err != nil || last.Name != "Версия 2" {
+		t.Errorf("GetLast = %+v, %v, want version 2", last, err)
+	}
+
+	exported, err := repos.Snapshots.Export(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(exported.Employees) != 1 || len(exported.Tenders) != 2 || exported.Tenders[0].Version != 1 {
+		t.Errorf("Export = %+v, want one employee and two ordered tender versions", exported)
+	}
+}
+
+// Забранное письмо не достается второму обработчику, пока не истечет срок, на который его забрали
+func TestMemoryEmailClaim(t *testing.T) {
+	ctx := context.Background()
+	emails := NewMemory().Emails
+	now := time.Now()
+	for _, recipient := range []string{"a@example.com", "b@example.com"} {
+		message := models.EmailMessage{Recipient: recipient, NextAttemptAt: now.Add(-time.Minute)}
+		if err := emails.Create(ctx, &message); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	first, err := emails.ClaimDue(ctx, now, now.Add(time.Hour), 1)
+	if err != nil || len(first) != 1 || first[0].Recipient != "a@example.com" {
+		t.Fatalf("first ClaimDue = %v, %v, want a@example.com", first, err)
+	}
+	second, err := emails.ClaimDue(ctx, now, now.Add(time.Hour), 10)
+	if err != nil || len(second) != 1 || second[0].Recipient != "b@example.com" {
+		t.Fatalf("second ClaimDue = %v, %v, want b@example.com", second, err)
+	}
+	if rest, _ := emails.ClaimDue(ctx, now, now.Add(time.Hour), 10); len(rest) != 0 {
+		t.Errorf("ClaimDue returned claimed messages: %v", rest)
+	}
+	if expired, _ := emails.ClaimDue(ctx, now.Add(2*time.Hour), now.Add(3*time.Hour), 10); len(expired) != 2 {
+		t.Errorf("ClaimDue after the claim expired = %v, want both messages", expired)
+	}
+}
+
+func TestMemoryCompleteMatch(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemory()
+	match := models.TenderMatch{TenderId: uuid.New(), Version: 1, NextAttemptAt: time.Now()}
+	if err := store.SavedSearches.EnqueueMatch(ctx, &match); err != nil {
+		t.Fatal(err)
+	}
+	messages := []models.EmailMessage{{Recipient: "a@example.com"}, {Recipient: "b@example.com"}}
+	if err := store.SavedSearches.CompleteMatch(ctx, match.ID, messages); err != nil {
+		t.Fatal(err)
+	}
+	// Повторное завершение после истекшей блокировки не дублирует письма
+	if err := store.SavedSearches.CompleteMatch(ctx, match.ID, messages); !errors.Is(err, ErrNotFound) {
+		t.Errorf("second CompleteMatch error = %v, want ErrNotFound", err)
+	}
+	later := time.Now().Add(time.Hour)
+	if queued, _ := store.Emails.ClaimDue(ctx, later, later, 10); len(queued) != 2 {
+		t.Errorf("queued emails = %v, want 2", queued)
+	}
+	if left, _ := store.SavedSearches.ClaimMatches(ctx, later, later, 10); len(left) != 0 {
+		t.Errorf("matches after CompleteMatch = %v, want none", left)
+	}
+}
@@ -0,0 +1,1060 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"sort"
+	"time"
+	"zadanie_6105/src/models"
+)
+
+// recordError заменяет gorm.ErrRecordNotFound на ErrNotFound
+func recordError(err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrNotFound
+	}
+	return err
+}
+
+func paginate(query *gorm.DB, limit, offset int) *gorm.DB {
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+	return query
+}
+
+type postgresTenders struct {
+	db *gorm.DB
+}
+
+// Create добавляет версию тендера. Версия новее текущей занимает ее место в tenders,
+// а прежняя текущая переносится в tender_versions; более старая версия сразу попадает в историю.
+func (r *postgresTenders) Create(ctx context.Context, tender *models.Tender) error {
+	if tender.Version == 0 {
+		tender.Version = 1
+	}
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var current models.Tender
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", tender.ID).
+			First(&current).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return tx.Omit(clause.Associations).Create(tender).Error
+		}
+		if err != nil {
+			return err
+		}
+
+		if tender.Version > current.Version {
+			history := models.NewTenderVersion(current)
+			if err := tx.Create(&history).Error; err != nil {
+				return err
+			}
+			if err := tx.Delete(&current).Error; err != nil {
+				return err
+			}
+			return tx.Omit(clause.Associations).Create(tender).Error
+		}
+
+		exists := tender.Version == current.Version
+		if !exists {
+			exists, err = versionExists(tx, &models.TenderVersion{}, tender.ID, tender.Version)
+			if err != nil {
+				return err
+			}
+		}
+		if exists {
+			return ErrDuplicate
+		}
+		history := models.NewTenderVersion(*tender)
+		if err := tx.Create(&history).Error; err != nil {
+			return err
+		}
+		tender.VersionID, tender.Status, tender.CreatedAt = history.VersionID, history.Status, history.CreatedAt
+		return nil
+	})
+}
+
+func (r *postgresTenders) CreateMany(ctx context.Context, tenders []models.Tender) error {
+	if len(tenders) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return tx.Omit(clause.Associations).CreateInBatches(&tenders, 100).Error
+	})
+}
+
+// Save изменяет версию на месте: текущую в tenders или прошлую в tender_versions
+func (r *postgresTenders) Save(ctx context.Context, tender *models.Tender) error {
+	db := r.db.WithContext(ctx)
+
+	result := db.Model(tender).
+		Where("version_id = ?", tender.VersionID).
+		Select("*").
+		Omit(clause.Associations).
+		Updates(tender)
+	if result.Error != nil || result.RowsAffected > 0 {
+		return result.Error
+	}
+
+	history := models.NewTenderVersion(*tender)
+	result = db.Model(&history).Select("*").Updates(&history)
+	if result.Error == nil && result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return result.Error
+}
+
+func (r *postgresTenders) GetLast(ctx context.Context, id uuid.UUID) (*models.Tender, error) {
+	var tender models.Tender
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&tender).Error; err != nil {
+		return nil, recordError(err)
+	}
+	return &tender, nil
+}
+
+func (r *postgresTenders) GetVersion(ctx context.Context, id uuid.UUID, version int32) (*models.Tender, error) {
+	db := r.db.WithContext(ctx)
+
+	var tender models.Tender
+	err := db.Where("id = ? AND version = ?", id, version).First(&tender).Error
+	if err == nil {
+		return &tender, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	var history models.TenderVersion
+	if err := db.Where("id = ? AND version = ?", id, version).First(&history).Error; err != nil {
+		return nil, recordError(err)
+	}
+	tender = history.Tender()
+	return &tender, nil
+}
+
+func (r *postgresTenders) ListVersions(ctx context.Context, id uuid.UUID) ([]models.Tender, error) {
+	db := r.db.WithContext(ctx)
+
+	var history []models.TenderVersion
+	if err := db.Where("id = ?", id).Order("version").Find(&history).Error; err != nil {
+		return nil, err
+	}
+	tenders := make([]models.Tender, 0, len(history)+1)
+	for _, version := range history {
+		tenders = append(tenders, version.Tender())
+	}
+
+	var current []models.Tender
+	if err := db.Where("id = ?", id).Find(&current).Error; err != nil {
+		return nil, err
+	}
+	return append(tenders, current...), nil
+}
+
+// TenderSearchVector - выражение полнотекстового поиска по тендерам, по нему построен
+// индекс idx_tenders_search
+const TenderSearchVector = "to_tsvector('simple', name || ' ' || description)"
+
+var tenderSortColumns = map[string]string{
+	models.TenderSortName:      "name",
+	models.TenderSortCreatedAt: "created_at",
+	models.TenderSortDeadline:  "deadline",
+	models.TenderSortBudget:    "budget",
+}
+
+// filterTenders добавляет к запросу условия и порядок фильтра публичного списка
+func filterTenders(query *gorm.DB, filter *models.TenderFilter) *gorm.DB {
+	if len(filter.ServiceTypes) > 0 {
+		query = query.Where("service_type IN ?", filter.ServiceTypes)
+	}
+	if len(filter.OrganizationIDs) > 0 {
+		query = query.Where("organization_id IN ?", filter.OrganizationIDs)
+	}
+	if len(filter.OrganizationTypes) > 0 {
+		query = query.Where("organization_id IN (SELECT id::text FROM organization WHERE type IN ?)", filter.OrganizationTypes)
+	}
+	if len(filter.Regions) > 0 {
+		query = query.Where("region IN ?", filter.Regions)
+	}
+	if filter.CreatedFrom != nil {
+		query = query.Where("created_at >= ?", *filter.CreatedFrom)
+	}
+	if filter.CreatedTo != nil {
+		query = query.Where("created_at <= ?", *filter.CreatedTo)
+	}
+	if filter.DeadlineFrom != nil {
+		query = query.Where("deadline >= ?", *filter.DeadlineFrom)
+	}
+	if filter.DeadlineTo != nil {
+		query = query.Where("deadline <= ?", *filter.DeadlineTo)
+	}
+	if filter.BudgetMin != nil {
+		query = query.Where("budget >= ?", *filter.BudgetMin)
+	}
+	if filter.BudgetMax != nil {
+		query = query.Where("budget <= ?", *filter.BudgetMax)
+	}
+	if filter.Search != "" {
+		query = query.Where(TenderSearchVector+" @@ plainto_tsquery('simple', ?)", filter.Search)
+	}
+
+	column, ok := tenderSortColumns[filter.Sort]
+	if !ok {
+		column = "name"
+	}
+	direction := "ASC"
+	if filter.Descending {
+		direction = "DESC"
+	}
+	// Тендеры без срока и бюджета идут последними в обоих направлениях
+	return query.Order(column + " " + direction + " NULLS LAST").Order("id")
+}
+
+func (r *postgresTenders) ListPublished(ctx context.Context, filter *models.TenderFilter, limit, offset int) ([]models.Tender, error) {
+	if filter == nil {
+		filter = &models.TenderFilter{}
+	}
+	query := filterTenders(r.db.WithContext(ctx).Where("status = ?", "Published"), filter)
+
+	var tenders []models.Tender
+	err := paginate(query, limit, offset).Find(&tenders).Error
+	return tenders, err
+}
+
+func (r *postgresTenders) ListByOrganizations(ctx context.Context, organizationIDs []string, limit, offset int) ([]models.Tender, error) {
+	tenders := []models.Tender{}
+	if len(organizationIDs) == 0 {
+		return tenders, nil
+	}
+	query := r.db.WithContext(ctx).
+		Where("organization_id IN ?", organizationIDs).
+		Order("name").
+		Order("id")
+
+	err := paginate(query, limit, offset).Find(&tenders).Error
+	return tenders, err
+}
+
+func (r *postgresTenders) List(ctx context.Context, status string, limit, offset int) ([]models.Tender, error) {
+	query := r.db.WithContext(ctx)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	query = query.Order("created_at")
+
+	var tenders []models.Tender
+	err := paginate(query, limit, offset).Find(&tenders).Error
+	return tenders, err
+}
+
+func (r *postgresTenders) CountOpen(ctx context.Context, organizationID string) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.Tender{}).
+		Where("organization_id = ?", organizationID).
+		Where("status <> ?", "Closed").
+		Count(&count).Error
+	return count, err
+}
+
+// versionExists проверяет, есть ли в таблице истории версия с таким номером
+func versionExists(tx *gorm.DB, model interface{}, id uuid.UUID, version int32) (bool, error) {
+	var count int64
+	err := tx.Model(model).
+		Where("id = ? AND version = ?", id, version).
+		Count(&count).Error
+	return count > 0, err
+}
+
+type postgresBids struct {
+	db *gorm.DB
+}
+
+// Create добавляет версию предложения так же, как postgresTenders.Create
+func (r *postgresBids) Create(ctx context.Context, bid *models.Bid) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return createBid(tx, bid)
+	})
+}
+
+// createBid добавляет версию предложения в транзакции tx
+func createBid(tx *gorm.DB, bid *models.Bid) error {
+	if bid.Version == 0 {
+		bid.Version = 1
+	}
+	var current models.Bid
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", bid.ID).
+		First(&current).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return tx.Omit(clause.Associations).Create(bid).Error
+	}
+	if err != nil {
+		return err
+	}
+
+	if bid.Version > current.Version {
+		history := models.NewBidVersion(current)
+		if err := tx.Create(&history).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&current).Error; err != nil {
+			return err
+		}
+		return tx.Omit(clause.Associations).Create(bid).Error
+	}
+
+	exists := bid.Version == current.Version
+	if !exists {
+		exists, err = versionExists(tx, &models.BidVersion{}, bid.ID, bid.Version)
+		if err != nil {
+			return err
+		}
+	}
+	if exists {
+		return ErrDuplicate
+	}
+	history := models.NewBidVersion(*bid)
+	if err := tx.Create(&history).Error; err != nil {
+		return err
+	}
+	bid.VersionID, bid.Status, bid.CreatedAt = history.VersionID, history.Status, history.CreatedAt
+	return nil
+}
+
+func (r *postgresBids) Save(ctx context.Context, bid *models.Bid) error {
+	db := r.db.WithContext(ctx)
+
+	// Содержимое не перезаписывается: иначе копия, прочитанная до вскрытия тендера,
+	// снова сделала бы версию запечатанной
+	content := []string{"name", "description", "sealed", "commitment"}
+	result := db.Model(bid).
+		Where("version_id = ?", bid.VersionID).
+		Select("*").
+		Omit(append(content, clause.Associations)...).
+		Updates(bid)
+	if result.Error != nil || result.RowsAffected > 0 {
+		return result.Error
+	}
+
+	history := models.NewBidVersion(*bid)
+	result = db.Model(&history).Select("*").Omit(content...).Updates(&history)
+	if result.Error == nil && result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return result.Error
+}
+
+func (r *postgresBids) GetLast(ctx context.Context, id uuid.UUID) (*models.Bid, error) {
+	var bid models.Bid
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&bid).Error; err != nil {
+		return nil, recordError(err)
+	}
+	return &bid, nil
+}
+
+func (r *postgresBids) GetVersion(ctx context.Context, id uuid.UUID, version int32) (*models.Bid, error) {
+	db := r.db.WithContext(ctx)
+
+	var bid models.Bid
+	err := db.Where("id = ? AND version = ?", id, version).First(&bid).Error
+	if err == nil {
+		return &bid, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	var history models.BidVersion
+	if err := db.Where("id = ? AND version = ?", id, version).First(&history).Error; err != nil {
+		return nil, recordError(err)
+	}
+	bid = history.Bid()
+	return &bid, nil
+}
+
+func (r *postgresBids) ListVersions(ctx context.Context, id uuid.UUID) ([]models.Bid, error) {
+	db := r.db.WithContext(ctx)
+
+	var history []models.BidVersion
+	if err := db.Where("id = ?", id).Order("version").Find(&history).Error; err != nil {
+		return nil, err
+	}
+	bids := make([]models.Bid, 0, len(history)+1)
+	for _, version := range history {
+		bids = append(bids, version.Bid())
+	}
+
+	var current []models.Bid
+	if err := db.Where("id = ?", id).Find(&current).Error; err != nil {
+		return nil, err
+	}
+	return append(bids, current...), nil
+}
+
+func (r *postgresBids) ListByAuthor(ctx context.Context, authorID uuid.UUID, limit, offset int) ([]models.Bid, error) {
+	query := r.db.WithContext(ctx).
+		Where("author_id = ?", authorID).
+		Order("name").
+		Order("id")
+
+	var bids []models.Bid
+	err := paginate(query, limit, offset).Find(&bids).Error
+	return bids, err
+}
+
+func (r *postgresBids) ListPublishedByTender(ctx context.Context, tenderID uuid.UUID, limit, offset int) ([]models.Bid, error) {
+	query := r.db.WithContext(ctx).
+		Where("status = ?", "Published").
+		Where("tender_id = ?", tenderID).
+		Order("name").
+		Order("id")
+
+	var bids []models.Bid
+	err := paginate(query, limit, offset).Find(&bids).Error
+	return bids, err
+}
+
+func (r *postgresBids) ListByTender(ctx context.Context, tenderID uuid.UUID, limit, offset int) ([]models.Bid, error) {
+	query := r.db.WithContext(ctx).
+		Where("tender_id = ?", tenderID).
+		Order("created_at")
+
+	var bids []models.Bid
+	err := paginate(query, limit, offset).Find(&bids).Error
+	return bids, err
+}
+
+// Автор и тендер у всех версий предложения совпадают, поэтому достаточно текущих версий
+func (r *postgresBids) ExistsByAuthor(ctx context.Context, authorID uuid.UUID, tenderID uuid.UUID) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.Bid{}).
+		Where("author_id = ? AND tender_id = ?", authorID, tenderID).
+		Limit(1).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *postgresBids) ListIDsByAuthor(ctx context.Context, authorID uuid.UUID) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := r.db.WithContext(ctx).Model(&models.Bid{}).
+		Where("author_id = ?", authorID).
+		Pluck("id", &ids).Error
+	return ids, err
+}
+
+func (r *postgresBids) SaveDecision(ctx context.Context, decision *models.BidDecision) error {
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "bid_id"}, {Name: "employee_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"decision", "created_at"}),
+	}).Create(decision).Error
+}
+
+func (r *postgresBids) ListDecisions(ctx context.Context, bidID uuid.UUID, employeeIDs []uuid.UUID) ([]models.BidDecision, error) {
+	decisions := []models.BidDecision{}
+	if len(employeeIDs) == 0 {
+		return decisions, nil
+	}
+	err := r.db.WithContext(ctx).
+		Where("bid_id = ?", bidID).
+		Where("employee_id IN ?", employeeIDs).
+		Find(&decisions).Error
+	return decisions, err
+}
+
+func (r *postgresBids) ListDecisionsByBids(ctx context.Context, bidIDs []uuid.UUID) ([]models.BidDecision, error) {
+	decisions := []models.BidDecision{}
+	if len(bidIDs) == 0 {
+		return decisions, nil
+	}
+	err := r.db.WithContext(ctx).
+		Where("bid_id IN ?", bidIDs).
+		Order("created_at").
+		Order("id").
+		Find(&decisions).Error
+	return decisions, err
+}
+
+func (r *postgresBids) ListRoundVersions(ctx context.Context, tenderID uuid.UUID, round int32) ([]models.Bid, error) {
+	db := r.db.WithContext(ctx)
+
+	var history []models.BidVersion
+	if err := db.Where("tender_id = ? AND round = ?", tenderID, round).Find(&history).Error; err != nil {
+		return nil, err
+	}
+	var bids []models.Bid
+	if err := db.Where("tender_id = ? AND round = ?", tenderID, round).Find(&bids).Error; err != nil {
+		return nil, err
+	}
+	for _, version := range history {
+		bids = append(bids, version.Bid())
+	}
+	return latestBidVersions(bids), nil
+}
+
+func (r *postgresBids) SaveShortlist(ctx context.Context, entry *models.BidShortlist) error {
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "tender_id"}, {Name: "round"}, {Name: "bid_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"employee_id", "created_at"}),
+	}).Create(entry).Error
+}
+
+func (r *postgresBids) DeleteShortlist(ctx context.Context, tenderID uuid.UUID, round int32, bidID uuid.UUID) error {
+	result := r.db.WithContext(ctx).
+		Where("tender_id = ? AND round = ? AND bid_id = ?", tenderID, round, bidID).
+		Delete(&models.BidShortlist{})
+	if result.Error == nil && result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return result.Error
+}
+
+func (r *postgresBids) ListShortlist(ctx context.Context, tenderID uuid.UUID, round int32) ([]models.BidShortlist, error) {
+	shortlist := []models.BidShortlist{}
+	err := r.db.WithContext(ctx).
+		Where("tender_id = ? AND round = ?", tenderID, round).
+		Order("created_at").
+		Order("id").
+		Find(&shortlist).Error
+	return shortlist, err
+}
+
+type postgresFeedbacks struct {
+	db *gorm.DB
+}
+
+func (r *postgresFeedbacks) Create(ctx context.Context, feedback *models.BidFeedback) error {
+	return r.db.WithContext(ctx).Create(feedback).Error
+}
+
+func (r *postgresFeedbacks) ListByBids(ctx context.Context, bidIDs []uuid.UUID, limit, offset int) ([]models.BidFeedback, error) {
+	feedbacks := []models.BidFeedback{}
+	if len(bidIDs) == 0 {
+		return feedbacks, nil
+	}
+	query := r.db.WithContext(ctx).
+		Where("bid_id IN ?", bidIDs).
+		Order("created_at DESC")
+
+	err := paginate(query, limit, offset).Find(&feedbacks).Error
+	return feedbacks, err
+}
+
+type postgresEmployees struct {
+	db *gorm.DB
+}
+
+func (r *postgresEmployees) Get(ctx context.Context, id uuid.UUID) (*models.Employee, error) {
+	var employee models.Employee
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&employee).Error; err != nil {
+		return nil, recordError(err)
+	}
+	return &employee, nil
+}
+
+func (r *postgresEmployees) ListByIDs(ctx context.Context, ids []uuid.UUID) ([]models.Employee, error) {
+	employees := []models.Employee{}
+	if len(ids) == 0 {
+		return employees, nil
+	}
+	err := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&employees).Error
+	return employees, err
+}
+
+func (r *postgresEmployees) GetByUsername(ctx context.Context, username string) (*models.Employee, error) {
+	var employee models.Employee
+	if err := r.db.WithContext(ctx).Where("username = ?", username).First(&employee).Error; err != nil {
+		return nil, recordError(err)
+	}
+	return &employee, nil
+}
+
+func (r *postgresEmployees) List(ctx context.Context, limit, offset int) ([]models.Employee, error) {
+	var employees []models.Employee
+	query := r.db.WithContext(ctx).Order("username")
+	err := paginate(query, limit, offset).Find(&employees).Error
+	return employees, err
+}
+
+func (r *postgresEmployees) Create(ctx context.Context, employee *models.Employee) error {
+	return r.db.WithContext(ctx).Create(employee).Error
+}
+
+func (r *postgresEmployees) Save(ctx context.Context, employee *models.Employee) error {
+	return r.db.WithContext(ctx).Save(employee).Error
+}
+
+func (r *postgresEmployees) Delete(ctx context.Context, id uuid.UUID) error {
+	// Ответственность удаляется внешним ключом ON DELETE CASCADE
+	return r.db.WithContext(ctx).Delete(&models.Employee{ID: id}).Error
+}
+
+func (r *postgresEmployees) ListResponsibilities(ctx context.Context, employeeID uuid.UUID) ([]models.OrganizationResponsible, error) {
+	var responsibles []models.OrganizationResponsible
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", employeeID).
+		Find(&responsibles).Error
+	return responsibles, err
+}
+
+func (r *postgresEmployees) ListResponsibles(ctx context.Context, organizationID string) ([]models.Employee, error) {
+	var employees []models.Employee
+	err := r.db.WithContext(ctx).
+		Joins("JOIN organization_responsible ON organization_responsible.user_id = employee.id").
+		Where("organization_responsible.organization_id = ?", organizationID).
+		Find(&employees).Error
+	return employees, err
+}
+
+func (r *postgresEmployees) ListResponsibleIDs(ctx context.Context, organizationID string, roles []string) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := r.db.WithContext(ctx).Model(&models.OrganizationResponsible{}).
+		Where("organization_id = ?", organizationID).
+		Where("role IN ?", roles).
+		Distinct().
+		Pluck("user_id", &ids).Error
+	return ids, err
+}
+
+type postgresOrganizations struct {
+	db *gorm.DB
+}
+
+func (r *postgresOrganizations) Get(ctx context.Context, id uuid.UUID) (*models.Organization, error) {
+	var organization models.Organization
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&organization).Error; err != nil {
+		return nil, recordError(err)
+	}
+	return &organization, nil
+}
+
+func (r *postgresOrganizations) List(ctx context.Context, limit, offset int) ([]models.Organization, error) {
+	var organizations []models.Organization
+	query := r.db.WithContext(ctx).Order("name")
+	err := paginate(query, limit, offset).Find(&organizations).Error
+	return organizations, err
+}
+
+func (r *postgresOrganizations) Create(ctx context.Context, organization *models.Organization) error {
+	return r.db.WithContext(ctx).Create(organization).Error
+}
+
+func (r *postgresOrganizations) Save(ctx context.Context, organization *models.Organization) error {
+	return r.db.WithContext(ctx).Save(organization).Error
+}
+
+func (r *postgresOrganizations) Delete(ctx context.Context, id uuid.UUID) error {
+	// Ответственные удаляются внешним ключом ON DELETE CASCADE
+	return r.db.WithContext(ctx).Delete(&models.Organization{ID: id}).Error
+}
+
+func (r *postgresOrganizations) ListResponsibles(ctx context.Context, organizationID uuid.UUID) ([]models.OrganizationResponsible, error) {
+	var responsibles []models.OrganizationResponsible
+	err := r.db.WithContext(ctx).
+		Preload("Employee").
+		Where("organization_id = ?", organizationID).
+		Find(&responsibles).Error
+	return responsibles, err
+}
+
+func (r *postgresOrganizations) GetResponsible(ctx context.Context, organizationID uuid.UUID, employeeID uuid.UUID) (*models.OrganizationResponsible, error) {
+	var responsible models.OrganizationResponsible
+	err := r.db.WithContext(ctx).
+		Where("organization_id = ? AND user_id = ?", organizationID, employeeID).
+		First(&responsible).Error
+	if err != nil {
+		return nil, recordError(err)
+	}
+	return &responsible, nil
+}
+
+func (r *postgresOrganizations) SaveResponsible(ctx context.Context, responsible *models.OrganizationResponsible) error {
+	db := r.db.WithContext(ctx).Omit("Organization", "Employee")
+	if responsible.ID == uuid.Nil {
+		return db.Create(responsible).Error
+	}
+	return db.Save(responsible).Error
+}
+
+func (r *postgresOrganizations) DeleteResponsible(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Delete(&models.OrganizationResponsible{ID: id}).Error
+}
+
+func (r *postgresOrganizations) CountResponsibles(ctx context.Context, organizationID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.OrganizationResponsible{}).
+		Where("organization_id = ?", organizationID).
+		Count(&count).Error
+	return count, err
+}
+
+type postgresEmails struct {
+	db *gorm.DB
+}
+
+func (r *postgresEmails) Create(ctx context.Context, message *models.EmailMessage) error {
+	return r.db.WithContext(ctx).Create(message).Error
+}
+
+func (r *postgresEmails) Save(ctx context.Context, message *models.EmailMessage) error {
+	return r.db.WithContext(ctx).Save(message).Error
+}
+
+func (r *postgresEmails) ClaimDue(ctx context.Context, now, until time.Time, limit int) ([]models.EmailMessage, error) {
+	db := r.db.WithContext(ctx)
+	// Строки, которые уже забрал другой обработчик, пропускаются, а не ожидаются
+	due := db.Model(&models.EmailMessage{}).
+		Select("id").
+		Where("status = ? AND next_attempt_at <= ?", models.EmailStatusPending, now).
+		Order("next_attempt_at").
+		Limit(limit).
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"})
+	var messages []models.EmailMessage
+	err := db.Model(&messages).
+		Clauses(clause.Returning{}).
+		Where("id IN (?)", due).
+		Update("next_attempt_at", until).Error
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(messages, func(i, j int) bool {
+		return messages[i].CreatedAt.Before(messages[j].CreatedAt)
+	})
+	return messages, nil
+}
+
+type postgresProtocols struct {
+	db *gorm.DB
+}
+
+func (r *postgresProtocols) Save(ctx context.Context, protocol *models.TenderProtocol) error {
+	return r.db.WithContext(ctx).Save(protocol).Error
+}
+
+func (r *postgresProtocols) Get(ctx context.Context, tenderID uuid.UUID) (*models.TenderProtocol, error) {
+	var protocol models.TenderProtocol
+	if err := r.db.WithContext(ctx).Where("tender_id = ?", tenderID).First(&protocol).Error; err != nil {
+		return nil, recordError(err)
+	}
+	return &protocol, nil
+}
+
+type postgresServiceTypes struct {
+	db *gorm.DB
+}
+
+func (r *postgresServiceTypes) List(ctx context.Context) ([]models.ServiceType, error) {
+	var serviceTypes []models.ServiceType
+	err := r.db.WithContext(ctx).Order("code").Find(&serviceTypes).Error
+	return serviceTypes, err
+}
+
+func (r *postgresServiceTypes) Get(ctx context.Context, code string) (*models.ServiceType, error) {
+	var serviceType models.ServiceType
+	if err := r.db.WithContext(ctx).Where("code = ?", code).First(&serviceType).Error; err != nil {
+		return nil, recordError(err)
+	}
+	return &serviceType, nil
+}
+
+func (r *postgresServiceTypes) Create(ctx context.Context, serviceType *models.ServiceType) error {
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(serviceType)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrDuplicate
+	}
+	return nil
+}
+
+func (r *postgresServiceTypes) Save(ctx context.Context, serviceType *models.ServiceType) error {
+	return r.db.WithContext(ctx).Save(serviceType).Error
+}
+
+func (r *postgresServiceTypes) Delete(ctx context.Context, code string) error {
+	return r.db.WithContext(ctx).Delete(&models.ServiceType{Code: code}).Error
+}
+
+func (r *postgresServiceTypes) CountTenders(ctx context.Context, code string) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Raw(`SELECT COUNT(DISTINCT id) FROM (
+		SELECT id FROM tenders WHERE service_type = ?
+		UNION ALL
+		SELECT id FROM tender_versions WHERE service_type = ?
+	) t`, code, code).Scan(&count).Error
+	return count, err
+}
+
+type postgresSavedSearches struct {
+	db *gorm.DB
+}
+
+func (r *postgresSavedSearches) Create(ctx context.Context, search *models.SavedSearch) error {
+	return r.db.WithContext(ctx).Omit(clause.Associations).Create(search).Error
+}
+
+func (r *postgresSavedSearches) Save(ctx context.Context, search *models.SavedSearch) error {
+	return r.db.WithContext(ctx).Omit(clause.Associations).Save(search).Error
+}
+
+func (r *postgresSavedSearches) Get(ctx context.Context, id uuid.UUID) (*models.SavedSearch, error) {
+	var search models.SavedSearch
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&search).Error; err != nil {
+		return nil, recordError(err)
+	}
+	return &search, nil
+}
+
+func (r *postgresSavedSearches) ListByEmployee(ctx context.Context, employeeID uuid.UUID) ([]models.SavedSearch, error) {
+	searches := []models.SavedSearch{}
+	err := r.db.WithContext(ctx).Where("employee_id = ?", employeeID).Order("name").Order("id").Find(&searches).Error
+	return searches, err
+}
+
+func (r *postgresSavedSearches) ListNotified(ctx context.Context) ([]models.SavedSearch, error) {
+	var searches []models.SavedSearch
+	err := r.db.WithContext(ctx).Where("notify").Order("employee_id").Order("name").Find(&searches).Error
+	return searches, err
+}
+
+func (r *postgresSavedSearches) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Delete(&models.SavedSearch{ID: id}).Error
+}
+
+func (r *postgresSavedSearches) EnqueueMatch(ctx context.Context, match *models.TenderMatch) error {
+	return r.db.WithContext(ctx).Create(match).Error
+}
+
+func (r *postgresSavedSearches) ClaimMatches(ctx context.Context, now, until time.Time, limit int) ([]models.TenderMatch, error) {
+	db := r.db.WithContext(ctx)
+	due := db.Model(&models.TenderMatch{}).
+		Select("id").
+		Where("next_attempt_at <= ?", now).
+		Order("next_attempt_at").
+		Limit(limit).
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"})
+	var matches []models.TenderMatch
+	err := db.Model(&matches).
+		Clauses(clause.Returning{}).
+		Where("id IN (?)", due).
+		Update("next_attempt_at", until).Error
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].CreatedAt.Before(matches[j].CreatedAt)
+	})
+	return matches, nil
+}
+
+func (r *postgresSavedSearches) CompleteMatch(ctx context.Context, id uuid.UUID, messages []models.EmailMessage) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Delete(&models.TenderMatch{ID: id})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrNotFound
+		}
+		if len(messages) == 0 {
+			return nil
+		}
+		return tx.Create(&messages).Error
+	})
+}
+
+type postgresAuctions struct {
+	db *gorm.DB
+}
+
+func (r *postgresAuctions) Get(ctx context.Context, tenderID uuid.UUID) (*models.TenderAuction, error) {
+	var auction models.TenderAuction
+	if err := r.db.WithContext(ctx).Where("tender_id = ?", tenderID).First(&auction).Error; err != nil {
+		return nil, recordError(err)
+	}
+	return &auction, nil
+}
+
+func (r *postgresAuctions) Save(ctx context.Context, auction *models.TenderAuction) error {
+	return r.db.WithContext(ctx).Save(auction).Error
+}
+
+func (r *postgresAuctions) CountOffers(ctx context.Context, tenderID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.AuctionOffer{}).Where("tender_id = ?", tenderID).Count(&count).Error
+	return count, err
+}
+
+func (r *postgresAuctions) PlaceOffer(ctx context.Context, offer *models.AuctionOffer,
+	accept func(auction *models.TenderAuction, previous *models.AuctionOffer) error) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var auction models.TenderAuction
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("tender_id = ?", offer.TenderId).
+			First(&auction).Error
+		if err != nil {
+			return recordError(err)
+		}
+
+		var previous []models.AuctionOffer
+		err = tx.Where("bid_id = ?", offer.BidId).
+			Order("created_at DESC").
+			Order("id DESC").
+			Limit(1).
+			Find(&previous).Error
+		if err != nil {
+			return err
+		}
+		var last *models.AuctionOffer
+		if len(previous) > 0 {
+			last = &previous[0]
+		}
+
+		if err := accept(&auction, last); err != nil {
+			return err
+		}
+		if err := tx.Save(&auction).Error; err != nil {
+			return err
+		}
+		return tx.Create(offer).Error
+	})
+}
+
+func (r *postgresAuctions) ListBestOffers(ctx context.Context, tenderID uuid.UUID) ([]models.AuctionOffer, error) {
+	var offers []models.AuctionOffer
+	err := r.db.WithContext(ctx).Raw(`SELECT DISTINCT ON (bid_id) * FROM auction_offers
+		WHERE tender_id = ? ORDER BY bid_id, price, created_at`, tenderID).Scan(&offers).Error
+	if err != nil {
+		return nil, err
+	}
+	sortAuctionOffers(offers)
+	return offers, nil
+}
+
+type postgresSeals struct {
+	db *gorm.DB
+}
+
+func (r *postgresSeals) Get(ctx context.Context, tenderID uuid.UUID) (*models.TenderSeal, error) {
+	var seal models.TenderSeal
+	if err := r.db.WithContext(ctx).Where("tender_id = ?", tenderID).First(&seal).Error; err != nil {
+		return nil, recordError(err)
+	}
+	return &seal, nil
+}
+
+func (r *postgresSeals) Create(ctx context.Context, seal *models.TenderSeal) error {
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(seal)
+	if result.Error == nil && result.RowsAffected == 0 {
+		return ErrDuplicate
+	}
+	return result.Error
+}
+
+func (r *postgresSeals) ListDue(ctx context.Context, now time.Time) ([]models.TenderSeal, error) {
+	var seals []models.TenderSeal
+	err := r.db.WithContext(ctx).
+		Select("tender_seals.*").
+		Joins("JOIN tenders ON tenders.id = tender_seals.tender_id").
+		Where("tender_seals.unsealed_at IS NULL AND tenders.deadline <= ?", now).
+		Order("tenders.deadline").
+		Find(&seals).Error
+	return seals, err
+}
+
+func (r *postgresSeals) CreateBid(ctx context.Context, bid *models.Bid, seal func(seal *models.TenderSeal) error) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var current models.TenderSeal
+		err := tx.Clauses(clause.Locking{Strength: "SHARE"}).
+			Where("tender_id = ?", bid.TenderId).
+			First(&current).Error
+		if err != nil {
+			return recordError(err)
+		}
+		if err := seal(&current); err != nil {
+			return err
+		}
+		return createBid(tx, bid)
+	})
+}
+
+func (r *postgresSeals) Unseal(ctx context.Context, tenderID uuid.UUID, open func(key, sealed []byte) (name, description string, err error),
+	event func(bids []models.Bid) *models.AuditEvent) (bool, error) {
+	unsealed := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var seal models.TenderSeal
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("tender_id = ?", tenderID).
+			First(&seal).Error
+		if err != nil {
+			return recordError(err)
+		}
+		if seal.UnsealedAt != nil {
+			return nil
+		}
+		var bids []models.Bid
+		if err := tx.Where("tender_id = ?", tenderID).Order("created_at").Find(&bids).Error; err != nil {
+			return err
+		}
+		record := event(bids)
+
+		for _, table := range []interface{}{&models.Bid{}, &models.BidVersion{}} {
+			var rows []struct {
+				VersionID uuid.UUID
+				Sealed    []byte
+			}
+			err := tx.Model(table).
+				Select("version_id", "sealed").
+				Where("tender_id = ? AND sealed IS NOT NULL", tenderID).
+				Find(&rows).Error
+			if err != nil {
+				return err
+			}
+			for _, row := range rows {
+				name, description, err := open(seal.Key, row.Sealed)
+				if err != nil {
+					return err
+				}
+				err = tx.Model(table).
+					Where("version_id = ?", row.VersionID).
+					Updates(map[string]interface{}{"name": name, "description": description, "sealed": nil}).Error
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		err = tx.Model(&models.TenderSeal{}).
+			Where("tender_id = ?", tenderID).
+			Updates(map[string]interface{}{"key": nil, "unsealed_at": record.CreatedAt}).Error
+		if err != nil {
+			return err
+		}
+		if err := tx.Create(record).Error; err != nil {
+			return err
+		}
+		unsealed = true
+		return nil
+	})
+	return unsealed, err
+}
+
+type postgresAudit struct {
+	db *gorm.DB
+}
+
+func (r *postgresAudit) ListByTender(ctx context.Context, tenderID uuid.UUID, limit, offset int) ([]models.AuditEvent, error) {
+	var events []models.AuditEvent
+	err := paginate(r.db.WithContext(ctx).Where("tender_id = ?", tenderID), limit, offset).
+		Order("created_at").
+		Order("id").
+		Find(&events).Error
+	return events, err
+}
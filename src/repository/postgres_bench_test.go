@@ -0,0 +1,124 @@
+package repository_test
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"testing"
+	"zadanie_6105/src/models"
+	"zadanie_6105/src/repository"
+	"zadanie_6105/src/testharness"
+)
+
+const (
+	benchTenders = 200
+	benchBids    = 200
+	benchPage    = 50
+)
+
+func TestMain(m *testing.M) {
+	testharness.Main(m)
+}
+
+// seedHistory создает benchTenders опубликованных тендеров и benchBids предложений
+// к первому из них, у каждого history прошлых версий
+func seedHistory(b *testing.B, db *gorm.DB, f *testharness.Fixtures, history int) uuid.UUID {
+	b.Helper()
+
+	var tenders []models.Tender
+	var tenderHistory []models.TenderVersion
+	for i := 0; i < benchTenders; i++ {
+		tender := models.Tender{
+			ID:             uuid.New(),
+			VersionID:      uuid.New(),
+			Name:           fmt.Sprintf("Тендер %03d", i),
+			Description:    "Описание",
+			ServiceType:    "Delivery",
+			Status:         "Published",
+			OrganizationId: f.Alpha.ID.String(),
+			Version:        int32(history + 1),
+		}
+		for version := 1; version <= history; version++ {
+			past := models.NewTenderVersion(tender)
+			past.VersionID = uuid.New()
+			past.Version = int32(version)
+			tenderHistory = append(tenderHistory, past)
+		}
+		tenders = append(tenders, tender)
+	}
+
+	var bids []models.Bid
+	var bidHistory []models.BidVersion
+	for i := 0; i < benchBids; i++ {
+		bid := models.Bid{
+			ID:          uuid.New(),
+			VersionID:   uuid.New(),
+			Name:        fmt.Sprintf("Предложение %03d", i),
+			Description: "Описание",
+			Status:      "Published",
+			TenderId:    tenders[0].ID,
+			AuthorType:  "User",
+			AuthorId:    f.Bidder.ID,
+			Version:     int32(history + 1),
+		}
+		for version := 1; version <= history; version++ {
+			past := models.NewBidVersion(bid)
+			past.VersionID = uuid.New()
+			past.Version = int32(version)
+			bidHistory = append(bidHistory, past)
+		}
+		bids = append(bids, bid)
+	}
+
+	for _, records := range []interface{}{&tenders, &tenderHistory, &bids, &bidHistory} {
+		if err := db.Omit("Organization", "Author").CreateInBatches(records, 500).Error; err != nil {
+			b.Fatal(err)
+		}
+	}
+	return tenders[0].ID
+}
+
+// Списки читают только текущие версии, поэтому время запросов не должно расти
+// вместе с числом прошлых версий
+func BenchmarkPostgresLists(b *testing.B) {
+	ctx := context.Background()
+
+	for _, history := range []int{0, 10, 100} {
+		h := testharness.New(b)
+		repos := repository.NewPostgres(h.DB)
+		tenderID := seedHistory(b, h.DB, h.Fixtures, history)
+		organizations := []string{h.Fixtures.Alpha.ID.String()}
+
+		queries := []struct {
+			name string
+			run  func() error
+		}{
+			{"ListPublished", func() error {
+				_, err := repos.Tenders.ListPublished(ctx, nil, benchPage, 0)
+				return err
+			}},
+			{"ListByOrganizations", func() error {
+				_, err := repos.Tenders.ListByOrganizations(ctx, organizations, benchPage, 0)
+				return err
+			}},
+			{"ListByAuthor", func() error {
+				_, err := repos.Bids.ListByAuthor(ctx, h.Fixtures.Bidder.ID, benchPage, 0)
+				return err
+			}},
+			{"ListPublishedByTender", func() error {
+				_, err := repos.Bids.ListPublishedByTender(ctx, tenderID, benchPage, 0)
+				return err
+			}},
+		}
+		for _, query := range queries {
+			b.Run(fmt.Sprintf("%s/history=%d", query.name, history), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					if err := query.run(); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,270 @@
+// Package repository отделяет бизнес-логику сервиса от хранилища.
+//
+// Тендеры и предложения хранятся версиями: Create добавляет версию, Save изменяет
+// уже сохраненную версию на месте. Текущей считается версия с наибольшим номером,
+// списки строятся только по текущим версиям. Методы List* с limit <= 0 и offset <= 0
+// отдают все записи. Ненайденная запись возвращается как ErrNotFound.
+package repository
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"time"
+	"zadanie_6105/src/models"
+)
+
+var (
+	ErrNotFound  = errors.New("record not found")
+	ErrDuplicate = errors.New("duplicate record")
+)
+
+type TenderRepository interface {
+	Create(ctx context.Context, tender *models.Tender) error
+	// CreateMany создает новые тендеры целиком или не создает ни одного
+	CreateMany(ctx context.Context, tenders []models.Tender) error
+	Save(ctx context.Context, tender *models.Tender) error
+	// GetLast возвращает последнюю версию тендера
+	GetLast(ctx context.Context, id uuid.UUID) (*models.Tender, error)
+	GetVersion(ctx context.Context, id uuid.UUID, version int32) (*models.Tender, error)
+	// ListVersions возвращает все версии по возрастанию номера
+	ListVersions(ctx context.Context, id uuid.UUID) ([]models.Tender, error)
+	// ListPublished возвращает опубликованные тендеры, подходящие под filter, в заданном им порядке;
+	// nil - все опубликованные тендеры по имени
+	ListPublished(ctx context.Context, filter *models.TenderFilter, limit, offset int) ([]models.Tender, error)
+	// ListByOrganizations возвращает тендеры организаций по имени
+	ListByOrganizations(ctx context.Context, organizationIDs []string, limit, offset int) ([]models.Tender, error)
+	// List возвращает тендеры по дате создания; пустой status - без фильтра
+	List(ctx context.Context, status string, limit, offset int) ([]models.Tender, error)
+	// CountOpen считает незакрытые тендеры организации
+	CountOpen(ctx context.Context, organizationID string) (int64, error)
+}
+
+type BidRepository interface {
+	Create(ctx context.Context, bid *models.Bid) error
+	// Save сохраняет статус и раунд версии; содержимое версии не меняется
+	Save(ctx context.Context, bid *models.Bid) error
+	GetLast(ctx context.Context, id uuid.UUID) (*models.Bid, error)
+	GetVersion(ctx context.Context, id uuid.UUID, version int32) (*models.Bid, error)
+	ListVersions(ctx context.Context, id uuid.UUID) ([]models.Bid, error)
+	// ListByAuthor возвращает предложения автора по имени
+	ListByAuthor(ctx context.Context, authorID uuid.UUID, limit, offset int) ([]models.Bid, error)
+	// ListPublishedByTender возвращает опубликованные предложения тендера по имени
+	ListPublishedByTender(ctx context.Context, tenderID uuid.UUID, limit, offset int) ([]models.Bid, error)
+	// ListByTender возвращает предложения тендера в любом статусе по дате создания
+	ListByTender(ctx context.Context, tenderID uuid.UUID, limit, offset int) ([]models.Bid, error)
+	ExistsByAuthor(ctx context.Context, authorID uuid.UUID, tenderID uuid.UUID) (bool, error)
+	ListIDsByAuthor(ctx context.Context, authorID uuid.UUID) ([]uuid.UUID, error)
+
+	// SaveDecision сохраняет голос, повторный голос того же сотрудника заменяет прежний
+	SaveDecision(ctx context.Context, decision *models.BidDecision) error
+	ListDecisions(ctx context.Context, bidID uuid.UUID, employeeIDs []uuid.UUID) ([]models.BidDecision, error)
+	// ListDecisionsByBids возвращает все голоса по предложениям в порядке подачи
+	ListDecisionsByBids(ctx context.Context, bidIDs []uuid.UUID) ([]models.BidDecision, error)
+
+	// ListRoundVersions возвращает по каждому предложению тендера последнюю версию,
+	// поданную в раунде round, в любом статусе по имени
+	ListRoundVersions(ctx context.Context, tenderID uuid.UUID, round int32) ([]models.Bid, error)
+	// SaveShortlist добавляет предложение в шорт-лист раунда, повторное добавление заменяет прежнее
+	SaveShortlist(ctx context.Context, entry *models.BidShortlist) error
+	DeleteShortlist(ctx context.Context, tenderID uuid.UUID, round int32, bidID uuid.UUID) error
+	// ListShortlist возвращает шорт-лист раунда в порядке добавления
+	ListShortlist(ctx context.Context, tenderID uuid.UUID, round int32) ([]models.BidShortlist, error)
+}
+
+type FeedbackRepository interface {
+	Create(ctx context.Context, feedback *models.BidFeedback) error
+	// ListByBids возвращает отзывы на предложения, новые первыми
+	ListByBids(ctx context.Context, bidIDs []uuid.UUID, limit, offset int) ([]models.BidFeedback, error)
+}
+
+type EmployeeRepository interface {
+	Get(ctx context.Context, id uuid.UUID) (*models.Employee, error)
+	GetByUsername(ctx context.Context, username string) (*models.Employee, error)
+	// List возвращает сотрудников по имени пользователя
+	List(ctx context.Context, limit, offset int) ([]models.Employee, error)
+	// ListByIDs возвращает найденных сотрудников из ids в любом порядке
+	ListByIDs(ctx context.Context, ids []uuid.UUID) ([]models.Employee, error)
+	Create(ctx context.Context, employee *models.Employee) error
+	Save(ctx context.Context, employee *models.Employee) error
+	// Delete удаляет сотрудника вместе с его ответственностью в организациях
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// ListResponsibilities возвращает ответственность сотрудника во всех организациях
+	ListResponsibilities(ctx context.Context, employeeID uuid.UUID) ([]models.OrganizationResponsible, error)
+	// ListResponsibles возвращает ответственных организации
+	ListResponsibles(ctx context.Context, organizationID string) ([]models.Employee, error)
+	// ListResponsibleIDs возвращает ответственных организации с одной из ролей
+	ListResponsibleIDs(ctx context.Context, organizationID string, roles []string) ([]uuid.UUID, error)
+}
+
+type OrganizationRepository interface {
+	Get(ctx context.Context, id uuid.UUID) (*models.Organization, error)
+	// List возвращает организации по названию
+	List(ctx context.Context, limit, offset int) ([]models.Organization, error)
+	Create(ctx context.Context, organization *models.Organization) error
+	Save(ctx context.Context, organization *models.Organization) error
+	// Delete удаляет организацию вместе с ее ответственными
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// ListResponsibles возвращает ответственных организации с заполненным Employee
+	ListResponsibles(ctx context.Context, organizationID uuid.UUID) ([]models.OrganizationResponsible, error)
+	GetResponsible(ctx context.Context, organizationID uuid.UUID, employeeID uuid.UUID) (*models.OrganizationResponsible, error)
+	// SaveResponsible добавляет ответственного или меняет роль уже назначенного
+	SaveResponsible(ctx context.Context, responsible *models.OrganizationResponsible) error
+	DeleteResponsible(ctx context.Context, id uuid.UUID) error
+	CountResponsibles(ctx context.Context, organizationID uuid.UUID) (int64, error)
+}
+
+// EmailRepository - очередь писем
+type EmailRepository interface {
+	Create(ctx context.Context, message *models.EmailMessage) error
+	Save(ctx context.Context, message *models.EmailMessage) error
+	// ClaimDue забирает ожидающие письма, время отправки которых наступило к now, и переносит
+	// их следующую попытку на until. Другие обработчики очереди эти письма до until не получат;
+	// если обработчик упадет, не сохранив результат, письмо снова станет доступно после until.
+	ClaimDue(ctx context.Context, now, until time.Time, limit int) ([]models.EmailMessage, error)
+}
+
+type SnapshotRepository interface {
+	Export(ctx context.Context) (*Snapshot, error)
+	// Import добавляет записи снимка целиком или не добавляет ничего.
+	// Записи с уже существующим первичным ключом пропускаются.
+	Import(ctx context.Context, snapshot *Snapshot) error
+}
+
+// ProtocolRepository хранит PDF-протоколы закрытых тендеров
+type ProtocolRepository interface {
+	// Save сохраняет протокол, повторное сохранение заменяет прежний
+	Save(ctx context.Context, protocol *models.TenderProtocol) error
+	Get(ctx context.Context, tenderID uuid.UUID) (*models.TenderProtocol, error)
+}
+
+// ServiceTypeRepository - справочник видов услуг
+type ServiceTypeRepository interface {
+	// List возвращает весь справочник по коду
+	List(ctx context.Context) ([]models.ServiceType, error)
+	Get(ctx context.Context, code string) (*models.ServiceType, error)
+	// Create добавляет вид услуг, уже существующий код - ErrDuplicate
+	Create(ctx context.Context, serviceType *models.ServiceType) error
+	Save(ctx context.Context, serviceType *models.ServiceType) error
+	Delete(ctx context.Context, code string) error
+	// CountTenders считает тендеры, у которых вид услуг code есть хотя бы в одной версии
+	CountTenders(ctx context.Context, code string) (int64, error)
+}
+
+// SavedSearchRepository хранит сохраненные поиски сотрудников
+type SavedSearchRepository interface {
+	Create(ctx context.Context, search *models.SavedSearch) error
+	Save(ctx context.Context, search *models.SavedSearch) error
+	Get(ctx context.Context, id uuid.UUID) (*models.SavedSearch, error)
+	// ListByEmployee возвращает поиски сотрудника по названию
+	ListByEmployee(ctx context.Context, employeeID uuid.UUID) ([]models.SavedSearch, error)
+	// ListNotified возвращает все поиски с включенными уведомлениями
+	ListNotified(ctx context.Context) ([]models.SavedSearch, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// EnqueueMatch ставит версию тендера в очередь сверки с поисками
+	EnqueueMatch(ctx context.Context, match *models.TenderMatch) error
+	// ClaimMatches забирает задачи сверки так же, как EmailRepository.ClaimDue забирает письма
+	ClaimMatches(ctx context.Context, now, until time.Time, limit int) ([]models.TenderMatch, error)
+	// CompleteMatch в одной транзакции ставит письма messages в очередь и удаляет задачу сверки.
+	// Если задачу уже завершил другой обработчик, письма не добавляются и возвращается ErrNotFound.
+	CompleteMatch(ctx context.Context, id uuid.UUID, messages []models.EmailMessage) error
+}
+
+// AuctionRepository - реверсивные аукционы тендеров и ставки в них
+type AuctionRepository interface {
+	Get(ctx context.Context, tenderID uuid.UUID) (*models.TenderAuction, error)
+	// Save создает аукцион тендера или заменяет его настройки
+	Save(ctx context.Context, auction *models.TenderAuction) error
+	CountOffers(ctx context.Context, tenderID uuid.UUID) (int64, error)
+	// PlaceOffer под блокировкой аукциона передает в accept его настройки и последнюю ставку
+	// по тому же предложению (nil, если ставок не было). Если accept не вернул ошибку,
+	// ставка и измененный accept аукцион сохраняются; ErrNotFound - аукциона нет.
+	PlaceOffer(ctx context.Context, offer *models.AuctionOffer,
+		accept func(auction *models.TenderAuction, previous *models.AuctionOffer) error) error
+	// ListBestOffers возвращает лучшую ставку каждого предложения: по возрастанию цены,
+	// при равной цене выше та, что сделана раньше
+	ListBestOffers(ctx context.Context, tenderID uuid.UUID) ([]models.AuctionOffer, error)
+}
+
+// SealRepository хранит ключи запечатанных тендеров
+type SealRepository interface {
+	Get(ctx context.Context, tenderID uuid.UUID) (*models.TenderSeal, error)
+	// Create сохраняет ключ тендера; ErrDuplicate - ключ уже создан
+	Create(ctx context.Context, seal *models.TenderSeal) error
+	// ListDue возвращает невскрытые ключи тендеров, срок приема которых наступил к now
+	ListDue(ctx context.Context, now time.Time) ([]models.TenderSeal, error)
+	// CreateBid сохраняет версию предложения запечатанного тендера так же, как BidRepository.Create.
+	// Перед записью под разделяемой блокировкой ключа вызывается seal: он проверяет, что тендер
+	// еще принимает предложения, и шифрует содержимое. Вскрытие ждет окончания записи.
+	CreateBid(ctx context.Context, bid *models.Bid, seal func(seal *models.TenderSeal) error) error
+	// Unseal под блокировкой ключа расшифровывает open все версии предложений тендера,
+	// удаляет ключ, отмечает время вскрытия и записывает в журнал аудита запись, которую event
+	// строит по текущим версиям предложений. Уже вскрытый тендер не меняется, тогда возвращается false.
+	Unseal(ctx context.Context, tenderID uuid.UUID, open func(key, sealed []byte) (name, description string, err error),
+		event func(bids []models.Bid) *models.AuditEvent) (bool, error)
+}
+
+// AuditRepository - журнал аудита тендеров
+type AuditRepository interface {
+	// ListByTender возвращает записи журнала тендера по времени
+	ListByTender(ctx context.Context, tenderID uuid.UUID, limit, offset int) ([]models.AuditEvent, error)
+}
+
+type Repositories struct {
+	Tenders       TenderRepository
+	Bids          BidRepository
+	Feedbacks     FeedbackRepository
+	Employees     EmployeeRepository
+	Organizations OrganizationRepository
+	Emails        EmailRepository
+	Snapshots     SnapshotRepository
+	Protocols     ProtocolRepository
+	ServiceTypes  ServiceTypeRepository
+	SavedSearches SavedSearchRepository
+	Auctions      AuctionRepository
+	Seals         SealRepository
+	Audit         AuditRepository
+}
+
+// NewPostgres создает репозитории поверх GORM
+func NewPostgres(db *gorm.DB) Repositories {
+	return Repositories{
+		Tenders:       &postgresTenders{db: db},
+		Bids:          &postgresBids{db: db},
+		Feedbacks:     &postgresFeedbacks{db: db},
+		Employees:     &postgresEmployees{db: db},
+		Organizations: &postgresOrganizations{db: db},
+		Emails:        &postgresEmails{db: db},
+		Snapshots:     &postgresSnapshots{db: db},
+		Protocols:     &postgresProtocols{db: db},
+		ServiceTypes:  &postgresServiceTypes{db: db},
+		SavedSearches: &postgresSavedSearches{db: db},
+		Auctions:      &postgresAuctions{db: db},
+		Seals:         &postgresSeals{db: db},
+		Audit:         &postgresAudit{db: db},
+	}
+}
+
+// NewMemory создает пустые репозитории в памяти процесса со справочником видов услуг по умолчанию
+func NewMemory() Repositories {
+	store := newMemoryStore()
+	return Repositories{
+		Tenders:       &memoryTenders{store},
+		Bids:          &memoryBids{store},
+		Feedbacks:     &memoryFeedbacks{store},
+		Employees:     &memoryEmployees{store},
+		Organizations: &memoryOrganizations{store},
+		Emails:        &memoryEmails{store},
+		Snapshots:     &memorySnapshots{store},
+		Protocols:     &memoryProtocols{store},
+		ServiceTypes:  &memoryServiceTypes{store},
+		SavedSearches: &memorySavedSearches{store},
+		Auctions:      &memoryAuctions{store},
+		Seals:         &memorySeals{store},
+		Audit:         &memoryAudit{store},
+	}
+}
@@ -0,0 +1,350 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"sort"
+	"zadanie_6105/src/models"
+)
+
+const snapshotBatchSize = 100
+
+// Snapshot - выгрузка данных сервиса со всеми версиями тендеров и предложений
+type Snapshot struct {
+	Organizations []models.Organization            `json:"organizations"`
+	Employees     []models.Employee                `json:"employees"`
+	Responsibles  []models.OrganizationResponsible `json:"responsibles"`
+	Tenders       []models.Tender                  `json:"tenders"`
+	Bids          []models.Bid                     `json:"bids"`
+	Feedbacks     []models.BidFeedback             `json:"feedbacks"`
+	Decisions     []models.BidDecision             `json:"decisions"`
+	Shortlists    []models.BidShortlist            `json:"shortlists"`
+	Auctions      []models.TenderAuction           `json:"auctions"`
+	Offers        []models.AuctionOffer            `json:"offers"`
+	Seals         []models.TenderSeal              `json:"seals"`
+	AuditEvents   []models.AuditEvent              `json:"auditEvents"`
+	ServiceTypes  []models.ServiceType             `json:"serviceTypes"`
+}
+
+type postgresSnapshots struct {
+	db *gorm.DB
+}
+
+func (r *postgresSnapshots) Export(ctx context.Context) (*Snapshot, error) {
+	db := r.db.WithContext(ctx)
+
+	var snapshot Snapshot
+	queries := []struct {
+		dest  interface{}
+		order string
+	}{
+		{&snapshot.Organizations, "created_at"},
+		{&snapshot.Employees, "created_at"},
+		{&snapshot.Responsibles, "organization_id, user_id"},
+		{&snapshot.Tenders, "id"},
+		{&snapshot.Bids, "id"},
+		{&snapshot.Feedbacks, "created_at"},
+		{&snapshot.Decisions, "created_at"},
+		{&snapshot.Shortlists, "created_at"},
+		{&snapshot.Auctions, "tender_id"},
+		{&snapshot.Offers, "created_at"},
+		{&snapshot.Seals, "tender_id"},
+		{&snapshot.AuditEvents, "created_at"},
+		{&snapshot.ServiceTypes, "code"},
+	}
+	for _, query := range queries {
+		if err := db.Order(query.order).Find(query.dest).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	// Прошлые версии хранятся отдельно от текущих, в снимке они идут вместе
+	var tenderHistory []models.TenderVersion
+	if err := db.Find(&tenderHistory).Error; err != nil {
+		return nil, err
+	}
+	for _, version := range tenderHistory {
+		snapshot.Tenders = append(snapshot.Tenders, version.Tender())
+	}
+	var bidHistory []models.BidVersion
+	if err := db.Find(&bidHistory).Error; err != nil {
+		return nil, err
+	}
+	for _, version := range bidHistory {
+		snapshot.Bids = append(snapshot.Bids, version.Bid())
+	}
+	sortTenderVersions(snapshot.Tenders)
+	sortBidVersions(snapshot.Bids)
+	return &snapshot, nil
+}
+
+func (r *postgresSnapshots) Import(ctx context.Context, snapshot *Snapshot) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		tx = tx.Omit(clause.Associations).
+			Clauses(clause.OnConflict{DoNothing: true}).
+			Session(&gorm.Session{})
+
+		// Виды услуг идут первыми: тендеры снимка ссылаются на них
+		if len(snapshot.ServiceTypes) > 0 {
+			if err := tx.CreateInBatches(&snapshot.ServiceTypes, snapshotBatchSize).Error; err != nil {
+				return err
+			}
+		}
+		if len(snapshot.Organizations) > 0 {
+			if err := tx.CreateInBatches(&snapshot.Organizations, snapshotBatchSize).Error; err != nil {
+				return err
+			}
+		}
+		if len(snapshot.Employees) > 0 {
+			if err := tx.CreateInBatches(&snapshot.Employees, snapshotBatchSize).Error; err != nil {
+				return err
+			}
+		}
+		if len(snapshot.Responsibles) > 0 {
+			if err := tx.CreateInBatches(&snapshot.Responsibles, snapshotBatchSize).Error; err != nil {
+				return err
+			}
+		}
+		tenders, tenderHistory := splitTenders(snapshot.Tenders)
+		if len(tenders) > 0 {
+			if err := tx.CreateInBatches(&tenders, snapshotBatchSize).Error; err != nil {
+				return err
+			}
+		}
+		if len(tenderHistory) > 0 {
+			if err := tx.CreateInBatches(&tenderHistory, snapshotBatchSize).Error; err != nil {
+				return err
+			}
+		}
+		bids, bidHistory := splitBids(snapshot.Bids)
+		if len(bids) > 0 {
+			if err := tx.CreateInBatches(&bids, snapshotBatchSize).Error; err != nil {
+				return err
+			}
+		}
+		if len(bidHistory) > 0 {
+			if err := tx.CreateInBatches(&bidHistory, snapshotBatchSize).Error; err != nil {
+				return err
+			}
+		}
+		if len(snapshot.Feedbacks) > 0 {
+			if err := tx.CreateInBatches(&snapshot.Feedbacks, snapshotBatchSize).Error; err != nil {
+				return err
+			}
+		}
+		if len(snapshot.Decisions) > 0 {
+			if err := tx.CreateInBatches(&snapshot.Decisions, snapshotBatchSize).Error; err != nil {
+				return err
+			}
+		}
+		if len(snapshot.Shortlists) > 0 {
+			if err := tx.CreateInBatches(&snapshot.Shortlists, snapshotBatchSize).Error; err != nil {
+				return err
+			}
+		}
+		if len(snapshot.Auctions) > 0 {
+			if err := tx.CreateInBatches(&snapshot.Auctions, snapshotBatchSize).Error; err != nil {
+				return err
+			}
+		}
+		if len(snapshot.Offers) > 0 {
+			if err := tx.CreateInBatches(&snapshot.Offers, snapshotBatchSize).Error; err != nil {
+				return err
+			}
+		}
+		if len(snapshot.Seals) > 0 {
+			if err := tx.CreateInBatches(&snapshot.Seals, snapshotBatchSize).Error; err != nil {
+				return err
+			}
+		}
+		if len(snapshot.AuditEvents) > 0 {
+			if err := tx.CreateInBatches(&snapshot.AuditEvents, snapshotBatchSize).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+type memorySnapshots struct {
+	store *memoryStore
+}
+
+func (r *memorySnapshots) Export(_ context.Context) (*Snapshot, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	snapshot := Snapshot{
+		Responsibles: append([]models.OrganizationResponsible{}, r.store.responsibles...),
+		Feedbacks:    append([]models.BidFeedback{}, r.store.feedbacks...),
+		Decisions:    append([]models.BidDecision{}, r.store.decisions...),
+		Shortlists:   append([]models.BidShortlist{}, r.store.shortlists...),
+		Offers:       append([]models.AuctionOffer{}, r.store.offers...),
+		AuditEvents:  append([]models.AuditEvent{}, r.store.audit...),
+	}
+	for _, organization := range r.store.organizations {
+		snapshot.Organizations = append(snapshot.Organizations, organization)
+	}
+	for _, employee := range r.store.employees {
+		snapshot.Employees = append(snapshot.Employees, employee)
+	}
+	for _, versions := range r.store.tenders {
+		snapshot.Tenders = append(snapshot.Tenders, versions...)
+	}
+	for _, versions := range r.store.bids {
+		snapshot.Bids = append(snapshot.Bids, versions...)
+	}
+	for _, serviceType := range r.store.serviceTypes {
+		snapshot.ServiceTypes = append(snapshot.ServiceTypes, cloneServiceType(serviceType))
+	}
+	for _, auction := range r.store.auctions {
+		snapshot.Auctions = append(snapshot.Auctions, auction)
+	}
+	for _, seal := range r.store.seals {
+		snapshot.Seals = append(snapshot.Seals, seal)
+	}
+
+	sort.SliceStable(snapshot.Organizations, func(i, j int) bool {
+		return snapshot.Organizations[i].CreatedAt.Before(snapshot.Organizations[j].CreatedAt)
+	})
+	sort.SliceStable(snapshot.Employees, func(i, j int) bool {
+		return snapshot.Employees[i].CreatedAt.Before(snapshot.Employees[j].CreatedAt)
+	})
+	sort.Slice(snapshot.ServiceTypes, func(i, j int) bool {
+		return snapshot.ServiceTypes[i].Code < snapshot.ServiceTypes[j].Code
+	})
+	sort.Slice(snapshot.Auctions, func(i, j int) bool {
+		return snapshot.Auctions[i].TenderId.String() < snapshot.Auctions[j].TenderId.String()
+	})
+	sort.Slice(snapshot.Seals, func(i, j int) bool {
+		return snapshot.Seals[i].TenderId.String() < snapshot.Seals[j].TenderId.String()
+	})
+	sortTenderVersions(snapshot.Tenders)
+	sortBidVersions(snapshot.Bids)
+	return &snapshot, nil
+}
+
+func (r *memorySnapshots) Import(_ context.Context, snapshot *Snapshot) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	// Каждая запись вставляется по копии, чтобы не менять снимок вызывающего
+	var err error
+	for _, serviceType := range snapshot.ServiceTypes {
+		if _, ok := r.store.serviceTypes[serviceType.Code]; !ok {
+			r.store.serviceTypes[serviceType.Code] = cloneServiceType(serviceType)
+		}
+	}
+	for _, organization := range snapshot.Organizations {
+		err = skipDuplicate(err, r.store.insertOrganization(&organization))
+	}
+	for _, employee := range snapshot.Employees {
+		err = skipDuplicate(err, r.store.insertEmployee(&employee))
+	}
+	for _, responsible := range snapshot.Responsibles {
+		err = skipDuplicate(err, r.store.insertResponsible(&responsible))
+	}
+	for _, tender := range snapshot.Tenders {
+		err = skipDuplicate(err, r.store.insertTender(&tender))
+	}
+	for _, bid := range snapshot.Bids {
+		err = skipDuplicate(err, r.store.insertBid(&bid))
+	}
+	for _, feedback := range snapshot.Feedbacks {
+		err = skipDuplicate(err, r.store.insertFeedback(&feedback))
+	}
+	for _, decision := range snapshot.Decisions {
+		err = skipDuplicate(err, r.store.insertDecision(&decision))
+	}
+	for _, entry := range snapshot.Shortlists {
+		err = skipDuplicate(err, r.store.insertShortlist(&entry))
+	}
+	for _, auction := range snapshot.Auctions {
+		if _, ok := r.store.auctions[auction.TenderId]; !ok {
+			r.store.saveAuction(&auction)
+		}
+	}
+	for _, offer := range snapshot.Offers {
+		err = skipDuplicate(err, r.store.insertOffer(&offer))
+	}
+	for _, seal := range snapshot.Seals {
+		err = skipDuplicate(err, r.store.insertSeal(&seal))
+	}
+	for _, event := range snapshot.AuditEvents {
+		err = skipDuplicate(err, r.store.insertAuditEvent(&event))
+	}
+	return err
+}
+
+// skipDuplicate оставляет первую ошибку, кроме ErrDuplicate: как и ON CONFLICT DO NOTHING,
+// импорт пропускает уже существующие записи
+func skipDuplicate(first error, err error) error {
+	if first != nil || errors.Is(err, ErrDuplicate) {
+		return first
+	}
+	return err
+}
+
+func sortTenderVersions(tenders []models.Tender) {
+	sort.SliceStable(tenders, func(i, j int) bool {
+		a, b := tenders[i], tenders[j]
+		if a.ID != b.ID {
+			return a.ID.String() < b.ID.String()
+		}
+		return a.Version < b.Version
+	})
+}
+
+func sortBidVersions(bids []models.Bid) {
+	sort.SliceStable(bids, func(i, j int) bool {
+		a, b := bids[i], bids[j]
+		if a.ID != b.ID {
+			return a.ID.String() < b.ID.String()
+		}
+		return a.Version < b.Version
+	})
+}
+
+// splitTenders делит версии из снимка на текущие, с наибольшим номером, и прошлые
+func splitTenders(tenders []models.Tender) ([]models.Tender, []models.TenderVersion) {
+	last := map[uuid.UUID]int{}
+	for i, tender := range tenders {
+		if j, ok := last[tender.ID]; !ok || tender.Version > tenders[j].Version {
+			last[tender.ID] = i
+		}
+	}
+
+	var current []models.Tender
+	var history []models.TenderVersion
+	for i, tender := range tenders {
+		if last[tender.ID] == i {
+			current = append(current, tender)
+		} else {
+			history = append(history, models.NewTenderVersion(tender))
+		}
+	}
+	return current, history
+}
+
+func splitBids(bids []models.Bid) ([]models.Bid, []models.BidVersion) {
+	last := map[uuid.UUID]int{}
+	for i, bid := range bids {
+		if j, ok := last[bid.ID]; !ok || bid.Version > bids[j].Version {
+			last[bid.ID] = i
+		}
+	}
+
+	var current []models.Bid
+	var history []models.BidVersion
+	for i, bid := range bids {
+		if last[bid.ID] == i {
+			current = append(current, bid)
+		} else {
+			history = append(history, models.NewBidVersion(bid))
+		}
+	}
+	return current, history
+}
@@ -0,0 +1,370 @@
+package routes_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"zadanie_6105/src/api"
+	"zadanie_6105/src/idempotency"
+	"zadanie_6105/src/mailer"
+	"zadanie_6105/src/models"
+	"zadanie_6105/src/routes"
+	"zadanie_6105/src/services"
+	"zadanie_6105/src/tabular"
+	"zadanie_6105/src/testharness"
+)
+
+// Адрес из servers спецификации, чтобы маршрутизатор kin-openapi нашел операцию
+const specBaseURL = "http://localhost:8080/api"
+
+// Файлы выгрузки и протокол проверяются только по коду ответа и типу содержимого
+func init() {
+	openapi3filter.RegisterBodyDecoder(tabular.ContentType(tabular.FormatXLSX), openapi3filter.FileBodyDecoder)
+	openapi3filter.RegisterBodyDecoder(tabular.ContentType(tabular.FormatJSONL), openapi3filter.FileBodyDecoder)
+	openapi3filter.RegisterBodyDecoder("application/pdf", openapi3filter.FileBodyDecoder)
+}
+
+type conformanceClient struct {
+	t          *testing.T
+	router     http.Handler
+	specRouter routers.Router
+}
+
+func newConformanceClient(t *testing.T, db *gorm.DB) *conformanceClient {
+	t.Helper()
+
+	swagger, err := api.GetSwagger()
+	if err != nil {
+		t.Fatalf("Failed to load spec: %v", err)
+	}
+	specRouter, err := gorillamux.NewRouter(swagger)
+	if err != nil {
+		t.Fatalf("Failed to build spec router: %v", err)
+	}
+
+	service := services.NewService(db, mailer.LogMailer{})
+	return &conformanceClient{
+		t:          t,
+		router:     routes.RegisterRoutes(service, idempotency.NewMemoryStore()),
+		specRouter: specRouter,
+	}
+}
+
+// do выполняет запрос, проверяет код ответа и валидирует ответ по схеме спецификации
+func (c *conformanceClient) do(method, target string, body interface{}, wantStatus int) []byte {
+	c.t.Helper()
+
+	if body == nil {
+		return c.send(method, target, "", nil, wantStatus)
+	}
+	payload, err := json.Marshal(body)
+	if err != nil {
+		c.t.Fatalf("Failed to encode body: %v", err)
+	}
+	return c.send(method, target, "application/json", payload, wantStatus)
+}
+
+// send выполняет запрос с готовым телом, например с файлом импорта
+func (c *conformanceClient) send(method, target, contentType string, payload []byte, wantStatus int) []byte {
+	c.t.Helper()
+
+	req := httptest.NewRequest(method, specBaseURL+target, bytes.NewReader(payload))
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	rec := httptest.NewRecorder()
+	c.router.ServeHTTP(rec, req)
+
+	responseBody, _ := io.ReadAll(rec.Body)
+	if rec.Code != wantStatus {
+		c.t.Fatalf("%s %s: status %d, want %d: %s", method, target, rec.Code, wantStatus, responseBody)
+	}
+
+	route, pathParams, err := c.specRouter.FindRoute(req)
+	if err != nil {
+		c.t.Fatalf("%s %s: route is not in spec: %v", method, target, err)
+	}
+	req.Body = io.NopCloser(bytes.NewReader(payload))
+	input := &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: &openapi3filter.RequestValidationInput{
+			Request:    req,
+			PathParams: pathParams,
+			Route:      route,
+		},
+		Status: rec.Code,
+		Header: rec.Header(),
+		Options: &openapi3filter.Options{
+			IncludeResponseStatus: true,
+		},
+	}
+	input.SetBodyBytes(responseBody)
+	if err := openapi3filter.ValidateResponse(context.Background(), input); err != nil {
+		c.t.Fatalf("%s %s: response does not conform to spec: %v", method, target, err)
+	}
+	return responseBody
+}
+
+// TestConformanceWithoutDatabase покрывает ответы, которые формируются до обращения к БД
+func TestConformanceWithoutDatabase(t *testing.T) {
+	// Соединение открывается лениво, поэтому сервер PostgreSQL не нужен
+	db, err := gorm.Open(postgres.Open("host=127.0.0.1 port=1"), &gorm.Config{DisableAutomaticPing: true})
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	c := newConformanceClient(t, db)
+
+	c.do("GET", "/ping", nil, http.StatusOK)
+	c.do("GET", "/tenders?limit=51", nil, http.StatusBadRequest)
+	c.do("GET", "/tenders?offset=-1", nil, http.StatusBadRequest)
+	c.do("GET", "/tenders?service_type=Delivery&include_subcategories=maybe", nil, http.StatusBadRequest)
+	c.do("GET", "/tenders?sort=status", nil, http.StatusBadRequest)
+	c.do("GET", "/tenders?budget_min=10&budget_max=1", nil, http.StatusBadRequest)
+	c.do("GET", "/tenders/my", nil, http.StatusUnauthorized)
+	c.do("GET", "/bids/my", nil, http.StatusUnauthorized)
+	c.do("PUT", "/tenders/550e8400-e29b-41d4-a716-446655440000/status?username=user&status=Deleted", nil, http.StatusBadRequest)
+	c.do("PATCH", "/tenders/550e8400-e29b-41d4-a716-446655440000/edit?username=user", map[string]int{"rounds": 6}, http.StatusBadRequest)
+	c.do("PUT", "/bids/550e8400-e29b-41d4-a716-446655440000/submit_decision?username=user&decision=Maybe", nil, http.StatusBadRequest)
+	c.do("GET", "/bids/550e8400-e29b-41d4-a716-446655440000/reviews?authorUsername=user", nil, http.StatusUnauthorized)
+	c.send("POST", "/tenders/import", "text/csv", []byte("name\n"), http.StatusUnauthorized)
+	c.send("POST", "/tenders/import?username=user&dry_run=maybe", "text/csv", []byte("name\n"), http.StatusBadRequest)
+	c.do("GET", "/tenders/550e8400-e29b-41d4-a716-446655440000/export", nil, http.StatusUnauthorized)
+	c.do("GET", "/tenders/550e8400-e29b-41d4-a716-446655440000/protocol.pdf", nil, http.StatusUnauthorized)
+	c.do("POST", "/service_types/new", map[string]interface{}{"code": "Roadworks", "names": map[string]string{"ru": "Дороги"}}, http.StatusUnauthorized)
+	c.do("POST", "/service_types/new?username=user", map[string]interface{}{"code": "Road works", "names": map[string]string{"ru": "Дороги"}}, http.StatusBadRequest)
+	c.do("DELETE", "/service_types/Delivery", nil, http.StatusUnauthorized)
+	c.do("GET", "/searches", nil, http.StatusUnauthorized)
+	c.do("GET", "/tenders/550e8400-e29b-41d4-a716-446655440000/shortlist", nil, http.StatusUnauthorized)
+	c.do("GET", "/tenders/550e8400-e29b-41d4-a716-446655440000/shortlist?username=user&round=0", nil, http.StatusBadRequest)
+	c.do("GET", "/tenders/550e8400-e29b-41d4-a716-446655440000/rounds/last/bids?username=user", nil, http.StatusBadRequest)
+	c.do("PUT", "/tenders/550e8400-e29b-41d4-a716-446655440000/auction", map[string]interface{}{"minDecrement": 10}, http.StatusUnauthorized)
+	c.do("PUT", "/tenders/550e8400-e29b-41d4-a716-446655440000/auction?username=user", map[string]interface{}{"minDecrement": 10}, http.StatusBadRequest)
+	c.do("POST", "/bids/550e8400-e29b-41d4-a716-446655440000/auction?username=user", map[string]interface{}{}, http.StatusBadRequest)
+	c.do("GET", "/tenders/550e8400-e29b-41d4-a716-446655440000/audit", nil, http.StatusUnauthorized)
+	c.do("GET", "/tenders/550e8400-e29b-41d4-a716-446655440000/audit?username=user&limit=51", nil, http.StatusBadRequest)
+	c.do("POST", "/searches/new?username=user", map[string]interface{}{"name": "Сортировка", "filter": map[string]string{"sort": "status"}}, http.StatusBadRequest)
+	c.do("GET", "/tenders/550e8400-e29b-41d4-a716-446655440000/export?username=user&format=pdf", nil, http.StatusBadRequest)
+}
+
+func TestConformance(t *testing.T) {
+	h := testharness.New(t)
+	c := newConformanceClient(t, h.DB)
+
+	organization := h.Fixtures.Alpha
+	responsible := h.Fixtures.AlphaAdmin
+	approver := h.Fixtures.AlphaApprover
+	author := h.Fixtures.Bidder
+
+	var tender api.Tender
+	body := c.do("POST", "/tenders/new", api.CreateTenderJSONRequestBody{
+		Name:            "Доставка",
+		Description:     "Доставка оборудования",
+		ServiceType:     "Delivery",
+		OrganizationId:  organization.ID.String(),
+		CreatorUsername: responsible.Username,
+	}, http.StatusOK)
+	if err := json.Unmarshal(body, &tender); err != nil {
+		t.Fatalf("Failed to decode tender: %v", err)
+	}
+	tenderPath := "/tenders/" + tender.Id
+
+	c.do("POST", "/tenders/new", api.CreateTenderJSONRequestBody{
+		Name:            "Доставка",
+		Description:     "Доставка оборудования",
+		ServiceType:     "Delivery",
+		OrganizationId:  organization.ID.String(),
+		CreatorUsername: author.Username,
+	}, http.StatusForbidden)
+	c.do("GET", "/tenders/my?username="+responsible.Username, nil, http.StatusOK)
+	c.do("GET", "/tenders/my?username=nobody", nil, http.StatusUnauthorized)
+	c.do("GET", tenderPath+"/status?username="+responsible.Username, nil, http.StatusOK)
+	c.do("GET", tenderPath+"/status", nil, http.StatusUnauthorized)
+	c.do("GET", "/tenders/550e8400-e29b-41d4-a716-446655440000/status?username="+responsible.Username, nil, http.StatusNotFound)
+	c.do("PATCH", tenderPath+"/edit?username="+responsible.Username, map[string]string{"name": "Доставка 2"}, http.StatusOK)
+	c.do("PUT", tenderPath+"/rollback/1?username="+responsible.Username, nil, http.StatusOK)
+	c.do("PUT", tenderPath+"/rollback/10?username="+responsible.Username, nil, http.StatusNotFound)
+	c.do("PUT", tenderPath+"/status?username="+author.Username+"&status=Published", nil, http.StatusForbidden)
+	c.do("PUT", tenderPath+"/status?username="+responsible.Username+"&status=Published", nil, http.StatusOK)
+	c.do("GET", "/tenders?service_type=Delivery&service_type=Construction&limit=50", nil, http.StatusOK)
+	c.do("GET", "/tenders?service_type=Construction&include_subcategories=true", nil, http.StatusOK)
+	c.do("GET", "/tenders?service_type=Unknown", nil, http.StatusBadRequest)
+	c.do("GET", "/tenders?organization_type=LLC&region=Moscow&search=delivery&budget_min=0&sort=-deadline", nil, http.StatusOK)
+	c.do("GET", tenderPath+"/status", nil, http.StatusOK)
+
+	file := "name,description,serviceType,organizationId\n" +
+		"Ремонт,Ремонт офиса,Construction," + organization.ID.String() + "\n"
+	importPath := "/tenders/import?username=" + responsible.Username
+	c.send("POST", importPath+"&dry_run=true", "text/csv", []byte(file), http.StatusOK)
+	c.send("POST", importPath, "text/csv", []byte(file+"Ремонт,,Construction,"+organization.ID.String()+"\n"), http.StatusBadRequest)
+	c.send("POST", importPath, "text/csv", []byte("name\n"), http.StatusBadRequest)
+	c.send("POST", "/tenders/import?username=nobody", "text/csv", []byte(file), http.StatusUnauthorized)
+	c.send("POST", importPath, "text/csv", []byte(file), http.StatusOK)
+
+	var bid api.Bid
+	body = c.do("POST", "/bids/new", api.CreateBidJSONRequestBody{
+		Name:        "Предложение",
+		Description: "Доставим за неделю",
+		TenderId:    tender.Id,
+		AuthorType:  "User",
+		AuthorId:    author.ID.String(),
+	}, http.StatusOK)
+	if err := json.Unmarshal(body, &bid); err != nil {
+		t.Fatalf("Failed to decode bid: %v", err)
+	}
+	bidPath := "/bids/" + bid.Id
+
+	c.do("GET", "/bids/my?username="+author.Username, nil, http.StatusOK)
+	c.do("GET", bidPath+"/status?username="+author.Username, nil, http.StatusOK)
+	c.do("PATCH", bidPath+"/edit?username="+author.Username, map[string]string{"description": "Доставим за три дня"}, http.StatusOK)
+	c.do("PATCH", bidPath+"/edit?username="+responsible.Username, map[string]string{"description": "Чужая правка"}, http.StatusForbidden)
+	c.do("PUT", bidPath+"/rollback/1?username="+author.Username, nil, http.StatusOK)
+	c.do("PUT", bidPath+"/status?username="+author.Username+"&status=Published", nil, http.StatusOK)
+	c.do("GET", "/bids/"+tender.Id+"/list?username="+responsible.Username, nil, http.StatusOK)
+	c.do("GET", "/bids/"+tender.Id+"/list?username="+author.Username, nil, http.StatusForbidden)
+	c.do("PUT", bidPath+"/feedback?username="+responsible.Username+"&bidFeedback=Хорошее+предложение", nil, http.StatusOK)
+	c.do("GET", "/bids/"+tender.Id+"/reviews?authorUsername="+author.Username+"&requesterUsername="+responsible.Username, nil, http.StatusOK)
+	c.do("GET", tenderPath+"/protocol.pdf?username="+responsible.Username, nil, http.StatusNotFound)
+	c.do("PUT", bidPath+"/submit_decision?username="+responsible.Username+"&decision=Approved", nil, http.StatusOK)
+	// Второй голос набирает кворум и закрывает тендер
+	c.do("PUT", bidPath+"/submit_decision?username="+approver.Username+"&decision=Approved", nil, http.StatusOK)
+	c.do("GET", tenderPath+"/protocol.pdf?username="+responsible.Username, nil, http.StatusOK)
+	c.do("GET", tenderPath+"/protocol.pdf?username="+author.Username, nil, http.StatusForbidden)
+	c.do("GET", tenderPath+"/export?username="+responsible.Username, nil, http.StatusOK)
+	c.do("GET", tenderPath+"/export?username="+responsible.Username+"&format=xlsx", nil, http.StatusOK)
+	c.do("GET", tenderPath+"/export?username="+responsible.Username+"&format=jsonl", nil, http.StatusOK)
+	c.do("GET", tenderPath+"/export?username="+author.Username, nil, http.StatusForbidden)
+	c.do("GET", "/bids/550e8400-e29b-41d4-a716-446655440000/status?username="+author.Username, nil, http.StatusNotFound)
+
+	rounds := int32(2)
+	body = c.do("POST", "/tenders/new", api.CreateTenderJSONRequestBody{
+		Name:            "Поставка",
+		Description:     "Поставка в два раунда",
+		ServiceType:     "Delivery",
+		OrganizationId:  organization.ID.String(),
+		Rounds:          &rounds,
+		CreatorUsername: responsible.Username,
+	}, http.StatusOK)
+	if err := json.Unmarshal(body, &tender); err != nil {
+		t.Fatalf("Failed to decode tender: %v", err)
+	}
+	tenderPath = "/tenders/" + tender.Id
+	c.do("PUT", tenderPath+"/status?username="+responsible.Username+"&status=Published", nil, http.StatusOK)
+	body = c.do("POST", "/bids/new", api.CreateBidJSONRequestBody{
+		Name:        "Предложение",
+		Description: "Поставим за неделю",
+		TenderId:    tender.Id,
+		AuthorType:  "User",
+		AuthorId:    author.ID.String(),
+	}, http.StatusOK)
+	if err := json.Unmarshal(body, &bid); err != nil {
+		t.Fatalf("Failed to decode bid: %v", err)
+	}
+	c.do("PUT", "/bids/"+bid.Id+"/status?username="+author.Username+"&status=Published", nil, http.StatusOK)
+	shortlistPath := tenderPath + "/shortlist/" + bid.Id
+
+	c.do("PUT", tenderPath+"/next_round?username="+responsible.Username, nil, http.StatusConflict)
+	c.do("PUT", shortlistPath+"?username="+author.Username, nil, http.StatusForbidden)
+	c.do("PUT", shortlistPath+"?username="+approver.Username, nil, http.StatusOK)
+	c.do("GET", tenderPath+"/shortlist?username="+responsible.Username, nil, http.StatusOK)
+	c.do("GET", tenderPath+"/shortlist?username="+responsible.Username+"&round=3", nil, http.StatusNotFound)
+	c.do("DELETE", shortlistPath+"?username="+approver.Username, nil, http.StatusNoContent)
+	c.do("DELETE", shortlistPath+"?username="+approver.Username, nil, http.StatusNotFound)
+	c.do("PUT", shortlistPath+"?username="+approver.Username, nil, http.StatusOK)
+	c.do("PUT", tenderPath+"/next_round?username="+responsible.Username, nil, http.StatusOK)
+	c.do("PUT", tenderPath+"/next_round?username="+responsible.Username, nil, http.StatusConflict)
+	c.do("GET", tenderPath+"/rounds/1/bids?username="+responsible.Username, nil, http.StatusOK)
+	c.do("GET", tenderPath+"/rounds/3/bids?username="+responsible.Username, nil, http.StatusNotFound)
+	c.do("GET", tenderPath+"/rounds/1/bids?username="+author.Username, nil, http.StatusForbidden)
+
+	body = c.do("POST", "/tenders/new", api.CreateTenderJSONRequestBody{
+		Name:            "Перевозка",
+		Description:     "Перевозка на аукционе",
+		ServiceType:     "Delivery",
+		OrganizationId:  organization.ID.String(),
+		CreatorUsername: responsible.Username,
+	}, http.StatusOK)
+	if err := json.Unmarshal(body, &tender); err != nil {
+		t.Fatalf("Failed to decode tender: %v", err)
+	}
+	auctionPath := "/tenders/" + tender.Id + "/auction"
+	c.do("PUT", "/tenders/"+tender.Id+"/status?username="+responsible.Username+"&status=Published", nil, http.StatusOK)
+	body = c.do("POST", "/bids/new", api.CreateBidJSONRequestBody{
+		Name:        "Предложение",
+		Description: "Перевезем за день",
+		TenderId:    tender.Id,
+		AuthorType:  "User",
+		AuthorId:    author.ID.String(),
+	}, http.StatusOK)
+	if err := json.Unmarshal(body, &bid); err != nil {
+		t.Fatalf("Failed to decode bid: %v", err)
+	}
+	bidAuctionPath := "/bids/" + bid.Id + "/auction"
+	c.do("PUT", "/bids/"+bid.Id+"/status?username="+author.Username+"&status=Published", nil, http.StatusOK)
+
+	now := time.Now()
+	auction := map[string]interface{}{
+		"startPrice":   1000,
+		"minDecrement": 10,
+		"startsAt":     now.Add(-time.Minute).Format(time.RFC3339),
+		"endsAt":       now.Add(time.Hour).Format(time.RFC3339),
+	}
+	c.do("GET", auctionPath, nil, http.StatusNotFound)
+	c.do("PUT", auctionPath+"?username="+author.Username, auction, http.StatusForbidden)
+	c.do("PUT", auctionPath+"?username="+responsible.Username, auction, http.StatusOK)
+	c.do("GET", auctionPath, nil, http.StatusOK)
+	c.do("GET", bidAuctionPath+"?username="+author.Username, nil, http.StatusOK)
+	c.do("POST", bidAuctionPath+"?username="+responsible.Username, map[string]int{"price": 900}, http.StatusForbidden)
+	c.do("POST", bidAuctionPath+"?username="+author.Username, map[string]int{"price": 1200}, http.StatusConflict)
+	c.do("POST", bidAuctionPath+"?username="+author.Username, map[string]int{"price": 900}, http.StatusOK)
+	c.do("GET", bidAuctionPath+"?username="+author.Username, nil, http.StatusOK)
+	c.do("GET", auctionPath+"/ranking?username="+responsible.Username, nil, http.StatusOK)
+	c.do("GET", auctionPath+"/ranking?username="+author.Username, nil, http.StatusForbidden)
+	c.do("PUT", auctionPath+"?username="+responsible.Username, auction, http.StatusConflict)
+	c.do("GET", "/tenders/"+tender.Id+"/audit?username="+responsible.Username, nil, http.StatusOK)
+	c.do("GET", "/tenders/"+tender.Id+"/audit?username="+author.Username, nil, http.StatusForbidden)
+
+	var search api.SavedSearch
+	body = c.do("POST", "/searches/new?username="+author.Username, api.CreateSavedSearchJSONRequestBody{
+		Name:   "Доставка",
+		Filter: &api.TenderFilter{ServiceTypes: &[]string{"Delivery"}, OrganizationTypes: &[]api.TenderFilterOrganizationTypes{"LLC"}},
+	}, http.StatusOK)
+	if err := json.Unmarshal(body, &search); err != nil {
+		t.Fatalf("Failed to decode saved search: %v", err)
+	}
+	searchPath := "/searches/" + search.Id
+
+	c.do("GET", "/searches?username="+author.Username, nil, http.StatusOK)
+	c.do("GET", searchPath+"?username="+author.Username, nil, http.StatusOK)
+	c.do("GET", searchPath+"?username="+responsible.Username, nil, http.StatusNotFound)
+	c.do("PATCH", searchPath+"/edit?username="+author.Username, map[string]interface{}{"notify": true, "filter": map[string]string{"sort": "deadline"}}, http.StatusOK)
+	c.do("GET", searchPath+"/tenders?username="+author.Username, nil, http.StatusOK)
+	c.do("GET", searchPath+"/tenders?username="+author.Username+"&limit=51", nil, http.StatusBadRequest)
+	c.do("DELETE", searchPath+"?username="+author.Username, nil, http.StatusNoContent)
+	c.do("DELETE", searchPath+"?username="+author.Username, nil, http.StatusNotFound)
+
+	admin := models.Employee{Username: "service_admin", IsAdmin: true}
+	if err := h.Service.CreateEmployee(&admin); err != nil {
+		t.Fatalf("Failed to create admin: %v", err)
+	}
+	roadworks := map[string]interface{}{"code": "Roadworks", "parentCode": "Construction", "names": map[string]string{"ru": "Дорожные работы"}}
+	c.do("POST", "/service_types/new?username="+admin.Username, roadworks, http.StatusOK)
+	c.do("POST", "/service_types/new?username="+admin.Username, roadworks, http.StatusConflict)
+	c.do("POST", "/service_types/new?username="+responsible.Username, roadworks, http.StatusForbidden)
+	c.do("GET", "/service_types?locale=en", nil, http.StatusOK)
+	c.do("GET", "/service_types/Roadworks", nil, http.StatusOK)
+	c.do("GET", "/service_types/Missing", nil, http.StatusNotFound)
+	c.do("PATCH", "/service_types/Roadworks/edit?username="+admin.Username, map[string]interface{}{"names": map[string]string{"en": "Roadworks"}}, http.StatusOK)
+	c.do("PATCH", "/service_types/Construction/edit?username="+admin.Username, map[string]string{"parentCode": "Roadworks"}, http.StatusConflict)
+	c.do("DELETE", "/service_types/Delivery?username="+admin.Username, nil, http.StatusConflict)
+	c.do("DELETE", "/service_types/Roadworks?username="+admin.Username, nil, http.StatusNoContent)
+	c.do("DELETE", "/service_types/Roadworks?username="+admin.Username, nil, http.StatusNotFound)
+}
@@ -2,33 +2,54 @@ package routes
 
 import (
 	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gorilla/mux/otelmux"
+	"zadanie_6105/src/api"
+	"zadanie_6105/src/cache"
 	"zadanie_6105/src/handlers"
+	"zadanie_6105/src/idempotency"
+	"zadanie_6105/src/logging"
+	"zadanie_6105/src/metrics"
 	"zadanie_6105/src/services"
 )
 
-func RegisterRoutes(service *services.Service) *mux.Router {
+func RegisterRoutes(service *services.Service, idempotencyStore idempotency.Store) *mux.Router {
 	r := mux.NewRouter()
+	r.Use(otelmux.Middleware("tender-service"))
+	r.Use(logging.Middleware)
+	r.Use(metrics.Middleware)
+	r.Use(cache.Conditional)
 
-	r.HandleFunc("/api/ping", handlers.Ping).Methods("GET")
+	r.Handle("/metrics", promhttp.Handler()).Methods("GET")
 
-	r.HandleFunc("/api/tenders", handlers.GetTenders(service)).Methods("GET")
-	r.HandleFunc("/api/tenders/new", handlers.CreateTender(service)).Methods("POST")
-	r.HandleFunc("/api/tenders/my", handlers.GetTendersByUser(service)).Methods("GET")
-	r.HandleFunc("/api/tenders/{tenderId}/status", handlers.GetTenderStatus(service)).Methods("GET")
-	r.HandleFunc("/api/tenders/{tenderId}/status", handlers.UpdateTenderStatus(service)).Methods("PUT")
-	r.HandleFunc("/api/tenders/{tenderId}/edit", handlers.UpdateTender(service)).Methods("PATCH")
-	r.HandleFunc("/api/tenders/{tenderId}/rollback/{version}", handlers.RollbackTender(service)).Methods("PUT")
+	// Маршруты из задание/openapi.yml регистрируются сгенерированным кодом
+	api.HandlerWithOptions(handlers.NewServer(service), api.GorillaServerOptions{
+		BaseURL:    "/api",
+		BaseRouter: r,
+		Middlewares: []api.MiddlewareFunc{
+			idempotency.Middleware(idempotencyStore, idempotency.DefaultTTL,
+				"POST /api/tenders/new",
+				"POST /api/bids/new",
+				"PUT /api/bids/{bidId}/submit_decision",
+			),
+		},
+		ErrorHandlerFunc: handlers.HandleRequestError,
+	})
 
-	r.HandleFunc("/api/bids/new", handlers.CreateBid(service)).Methods("POST")
-	r.HandleFunc("/api/bids/my", handlers.GetBidsByUser(service)).Methods("GET")
-	r.HandleFunc("/api/bids/{tenderId}/list", handlers.GetBidsByTender(service)).Methods("GET")
-	r.HandleFunc("/api/bids/{bidId}/status", handlers.GetBidStatus(service)).Methods("GET")
-	r.HandleFunc("/api/bids/{bidId}/status", handlers.UpdateBidStatus(service)).Methods("PUT")
-	r.HandleFunc("/api/bids/{bidId}/edit", handlers.UpdateBid(service)).Methods("PATCH")
-	r.HandleFunc("/api/bids/{bidId}/submit_decision", handlers.SubmitBid(service)).Methods("PUT")
-	r.HandleFunc("/api/bids/{bidId}/rollback/{version}", handlers.RollbackBid(service)).Methods("PUT")
-	r.HandleFunc("/api/bids/{bidId}/feedback", handlers.CreateFeedback(service)).Methods("PUT")
-	r.HandleFunc("/api/bids/{tenderId}/reviews", handlers.GetFeedbacks(service)).Methods("GET")
+	r.HandleFunc("/api/organizations", handlers.GetOrganizations(service)).Methods("GET")
+	r.HandleFunc("/api/organizations/new", handlers.CreateOrganization(service)).Methods("POST")
+	r.HandleFunc("/api/organizations/{organizationId}", handlers.GetOrganization(service)).Methods("GET")
+	r.HandleFunc("/api/organizations/{organizationId}", handlers.DeleteOrganization(service)).Methods("DELETE")
+	r.HandleFunc("/api/organizations/{organizationId}/edit", handlers.UpdateOrganization(service)).Methods("PATCH")
+	r.HandleFunc("/api/organizations/{organizationId}/responsibles", handlers.GetResponsibles(service)).Methods("GET")
+	r.HandleFunc("/api/organizations/{organizationId}/responsibles/{employeeId}", handlers.AssignResponsible(service)).Methods("PUT")
+	r.HandleFunc("/api/organizations/{organizationId}/responsibles/{employeeId}", handlers.RemoveResponsible(service)).Methods("DELETE")
+
+	r.HandleFunc("/api/employees", handlers.GetEmployees(service)).Methods("GET")
+	r.HandleFunc("/api/employees/new", handlers.CreateEmployee(service)).Methods("POST")
+	r.HandleFunc("/api/employees/{employeeId}", handlers.GetEmployee(service)).Methods("GET")
+	r.HandleFunc("/api/employees/{employeeId}", handlers.DeleteEmployee(service)).Methods("DELETE")
+	r.HandleFunc("/api/employees/{employeeId}/edit", handlers.UpdateEmployee(service)).Methods("PATCH")
 
 	return r
 }
@@ -0,0 +1,925 @@
+package routes_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+	"zadanie_6105/src/api"
+	"zadanie_6105/src/models"
+	"zadanie_6105/src/testharness"
+)
+
+const unknownID = "550e8400-e29b-41d4-a716-446655440000"
+
+func TestMain(m *testing.M) {
+	testharness.Main(m)
+}
+
+type routeCase struct {
+	name   string
+	method string
+	target string
+	body   interface{}
+	want   int
+}
+
+func runCases(t *testing.T, h *testharness.Harness, cases []routeCase) {
+	t.Helper()
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			rec := h.Do(t, tc.method, tc.target, tc.body)
+			if rec.Code != tc.want {
+				t.Errorf("%s %s: status %d, want %d: %s", tc.method, tc.target, rec.Code, tc.want, rec.Body.String())
+			}
+		})
+	}
+}
+
+func query(target string, params ...string) string {
+	values := url.Values{}
+	for i := 0; i+1 < len(params); i += 2 {
+		values.Add(params[i], params[i+1])
+	}
+	return target + "?" + values.Encode()
+}
+
+func tenderBody(creator string, organization models.Organization) api.CreateTenderJSONRequestBody {
+	return api.CreateTenderJSONRequestBody{
+		Name:            "Ремонт офиса",
+		Description:     "Косметический ремонт офиса",
+		ServiceType:     "Construction",
+		OrganizationId:  organization.ID.String(),
+		CreatorUsername: creator,
+	}
+}
+
+// newTender создает тендер организации Alpha и при необходимости публикует его
+func newTender(t *testing.T, h *testharness.Harness, publish bool) api.Tender {
+	t.Helper()
+	f := h.Fixtures
+
+	rec := h.Do(t, "POST", "/api/tenders/new", tenderBody(f.AlphaAdmin.Username, f.Alpha))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Failed to create tender: %d %s", rec.Code, rec.Body.String())
+	}
+	var tender api.Tender
+	testharness.Decode(t, rec, &tender)
+
+	if publish {
+		target := query("/api/tenders/"+tender.Id+"/status", "username", f.AlphaAdmin.Username, "status", "Published")
+		if rec := h.Do(t, "PUT", target, nil); rec.Code != http.StatusOK {
+			t.Fatalf("Failed to publish tender: %d %s", rec.Code, rec.Body.String())
+		}
+		tender.Status = api.TenderStatusPublished
+	}
+	return tender
+}
+
+func bidBody(tenderID string, author models.Employee) api.CreateBidJSONRequestBody {
+	return api.CreateBidJSONRequestBody{
+		Name:        "Предложение",
+		Description: "Выполним за две недели",
+		TenderId:    tenderID,
+		AuthorType:  "User",
+		AuthorId:    author.ID.String(),
+	}
+}
+
+// newBid создает предложение участника Bidder и при необходимости публикует его
+func newBid(t *testing.T, h *testharness.Harness, tenderID string, publish bool) api.Bid {
+	t.Helper()
+	f := h.Fixtures
+
+	rec := h.Do(t, "POST", "/api/bids/new", bidBody(tenderID, f.Bidder))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Failed to create bid: %d %s", rec.Code, rec.Body.String())
+	}
+	var bid api.Bid
+	testharness.Decode(t, rec, &bid)
+
+	if publish {
+		target := query("/api/bids/"+bid.Id+"/status", "username", f.Bidder.Username, "status", "Published")
+		if rec := h.Do(t, "PUT", target, nil); rec.Code != http.StatusOK {
+			t.Fatalf("Failed to publish bid: %d %s", rec.Code, rec.Body.String())
+		}
+		bid.Status = api.BidStatusPublished
+	}
+	return bid
+}
+
+// 01: GET /api/ping
+func TestPing(t *testing.T) {
+	h := testharness.New(t)
+	runCases(t, h, []routeCase{
+		{"ok", "GET", "/api/ping", nil, http.StatusOK},
+	})
+}
+
+// 02: POST /api/tenders/new
+func TestCreateTender(t *testing.T) {
+	h := testharness.New(t)
+	f := h.Fixtures
+
+	withName := func(name string) api.CreateTenderJSONRequestBody {
+		body := tenderBody(f.AlphaAdmin.Username, f.Alpha)
+		body.Name = name
+		return body
+	}
+	withServiceType := tenderBody(f.AlphaAdmin.Username, f.Alpha)
+	withServiceType.ServiceType = "Cleaning"
+	unknownOrganization := tenderBody(f.AlphaAdmin.Username, f.Alpha)
+	unknownOrganization.OrganizationId = unknownID
+
+	runCases(t, h, []routeCase{
+		{"admin", "POST", "/api/tenders/new", tenderBody(f.AlphaAdmin.Username, f.Alpha), http.StatusOK},
+		{"tender author", "POST", "/api/tenders/new", tenderBody(f.AlphaAuthor.Username, f.Alpha), http.StatusOK},
+		{"empty name", "POST", "/api/tenders/new", withName(""), http.StatusBadRequest},
+		{"long name", "POST", "/api/tenders/new", withName(strings.Repeat("а", 101)), http.StatusBadRequest},
+		{"unknown service type", "POST", "/api/tenders/new", withServiceType, http.StatusBadRequest},
+		{"no creator", "POST", "/api/tenders/new", tenderBody("", f.Alpha), http.StatusUnauthorized},
+		{"unknown creator", "POST", "/api/tenders/new", tenderBody("nobody", f.Alpha), http.StatusUnauthorized},
+		{"viewer", "POST", "/api/tenders/new", tenderBody(f.AlphaViewer.Username, f.Alpha), http.StatusForbidden},
+		{"other organization", "POST", "/api/tenders/new", tenderBody(f.BetaAdmin.Username, f.Alpha), http.StatusForbidden},
+		{"not responsible", "POST", "/api/tenders/new", tenderBody(f.Bidder.Username, f.Alpha), http.StatusForbidden},
+		{"unknown organization", "POST", "/api/tenders/new", unknownOrganization, http.StatusForbidden},
+	})
+}
+
+// 03: GET /api/tenders и GET /api/tenders/my
+func TestListTenders(t *testing.T) {
+	h := testharness.New(t)
+	f := h.Fixtures
+	newTender(t, h, true)
+
+	runCases(t, h, []routeCase{
+		{"all", "GET", "/api/tenders", nil, http.StatusOK},
+		{"service type", "GET", "/api/tenders?service_type=Construction&service_type=Delivery", nil, http.StatusOK},
+		{"pagination", "GET", "/api/tenders?limit=1&offset=1", nil, http.StatusOK},
+		{"unknown service type", "GET", "/api/tenders?service_type=Cleaning", nil, http.StatusBadRequest},
+		{"filters", "GET", query("/api/tenders", "organization_id", f.Alpha.ID.String(), "organization_type", "LLC", "region", "Москва",
+			"created_from", "2025-01-01T00:00:00Z", "deadline_to", "2030-01-01T00:00:00Z", "budget_min", "0", "budget_max", "1000000",
+			"search", "ремонт офиса", "sort", "-budget"), nil, http.StatusOK},
+		{"unknown organization type", "GET", "/api/tenders?organization_type=LTD", nil, http.StatusBadRequest},
+		{"invalid date", "GET", "/api/tenders?created_from=yesterday", nil, http.StatusBadRequest},
+		{"reversed date range", "GET", query("/api/tenders", "deadline_from", "2025-02-01T00:00:00Z", "deadline_to", "2025-01-01T00:00:00Z"), nil, http.StatusBadRequest},
+		{"reversed budget range", "GET", "/api/tenders?budget_min=10&budget_max=1", nil, http.StatusBadRequest},
+		{"negative budget", "GET", "/api/tenders?budget_min=-1", nil, http.StatusBadRequest},
+		{"long search", "GET", query("/api/tenders", "search", strings.Repeat("а", 101)), nil, http.StatusBadRequest},
+		{"unknown sort", "GET", "/api/tenders?sort=status", nil, http.StatusBadRequest},
+		{"limit too large", "GET", "/api/tenders?limit=51", nil, http.StatusBadRequest},
+		{"negative offset", "GET", "/api/tenders?offset=-1", nil, http.StatusBadRequest},
+		{"my", "GET", query("/api/tenders/my", "username", f.AlphaAdmin.Username), nil, http.StatusOK},
+		{"my without username", "GET", "/api/tenders/my", nil, http.StatusUnauthorized},
+		{"my unknown user", "GET", query("/api/tenders/my", "username", "nobody"), nil, http.StatusUnauthorized},
+	})
+}
+
+// Регион задается при создании и правке тендера и фильтрует публичный список вместе с поиском
+func TestListTendersByRegion(t *testing.T) {
+	h := testharness.New(t)
+	f := h.Fixtures
+	tender := newTender(t, h, true)
+
+	region := "Татарстан"
+	rec := h.Do(t, "PATCH", query("/api/tenders/"+tender.Id+"/edit", "username", f.AlphaAdmin.Username), api.EditTenderJSONRequestBody{Region: &region})
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Failed to edit tender: %d %s", rec.Code, rec.Body.String())
+	}
+
+	list := func(target string) []api.Tender {
+		t.Helper()
+		rec := h.Do(t, "GET", target, nil)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("GET %s = %d %s", target, rec.Code, rec.Body.String())
+		}
+		var tenders []api.Tender
+		testharness.Decode(t, rec, &tenders)
+		return tenders
+	}
+	if tenders := list(query("/api/tenders", "region", region, "search", "ремонт", "organization_type", "LLC")); len(tenders) != 1 ||
+		tenders[0].Region == nil || *tenders[0].Region != region {
+		t.Errorf("region filter = %+v, want the edited tender", tenders)
+	}
+	if tenders := list(query("/api/tenders", "region", "Москва")); len(tenders) != 0 {
+		t.Errorf("other region = %+v, want none", tenders)
+	}
+	if tenders := list(query("/api/tenders", "search", "доставка")); len(tenders) != 0 {
+		t.Errorf("search without match = %+v, want none", tenders)
+	}
+}
+
+// Повторный запрос списка с ETag получает 304, пока тендеры не изменились
+func TestListTendersConditional(t *testing.T) {
+	h := testharness.New(t)
+	f := h.Fixtures
+	tender := newTender(t, h, true)
+
+	get := func(header, value string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("GET", "/api/tenders", nil)
+		if header != "" {
+			req.Header.Set(header, value)
+		}
+		rec := httptest.NewRecorder()
+		h.Router.ServeHTTP(rec, req)
+		return rec
+	}
+
+	first := get("", "")
+	etag, lastModified := first.Header().Get("ETag"), first.Header().Get("Last-Modified")
+	if first.Code != http.StatusOK || etag == "" || lastModified == "" {
+		t.Fatalf("GET /api/tenders = %d, ETag %q, Last-Modified %q", first.Code, etag, lastModified)
+	}
+	if rec := get("If-None-Match", etag); rec.Code != http.StatusNotModified || rec.Body.Len() != 0 {
+		t.Errorf("If-None-Match with current ETag = %d, want 304 without body", rec.Code)
+	}
+	if rec := get("If-Modified-Since", lastModified); rec.Code != http.StatusNotModified {
+		t.Errorf("If-Modified-Since with Last-Modified = %d, want 304", rec.Code)
+	}
+
+	edit := query("/api/tenders/"+tender.Id+"/edit", "username", f.AlphaAdmin.Username)
+	if rec := h.Do(t, "PATCH", edit, map[string]string{"name": "Новое имя"}); rec.Code != http.StatusOK {
+		t.Fatalf("Failed to edit tender: %d %s", rec.Code, rec.Body.String())
+	}
+	rec := get("If-None-Match", etag)
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), "Новое имя") {
+		t.Errorf("If-None-Match after edit = %d %s, want 200 with the new name", rec.Code, rec.Body.String())
+	}
+}
+
+// Справочник видов услуг: чтение доступно всем, изменения - только администратору сервиса
+func TestServiceTypes(t *testing.T) {
+	h := testharness.New(t)
+	f := h.Fixtures
+	admin := models.Employee{Username: "service_admin", IsAdmin: true}
+	if err := h.Service.CreateEmployee(&admin); err != nil {
+		t.Fatalf("Failed to create admin: %v", err)
+	}
+
+	roadworks := map[string]interface{}{"code": "Roadworks", "parentCode": "Construction", "names": map[string]string{"ru": "Дорожные работы", "en": "Roadworks"}}
+	runCases(t, h, []routeCase{
+		{"create", "POST", query("/api/service_types/new", "username", admin.Username), roadworks, http.StatusOK},
+		{"create duplicate", "POST", query("/api/service_types/new", "username", admin.Username), roadworks, http.StatusConflict},
+		{"create without username", "POST", "/api/service_types/new", roadworks, http.StatusUnauthorized},
+		{"create not admin", "POST", query("/api/service_types/new", "username", f.AlphaAdmin.Username), roadworks, http.StatusForbidden},
+		{"create invalid code", "POST", query("/api/service_types/new", "username", admin.Username),
+			map[string]interface{}{"code": "Road works", "names": map[string]string{"ru": "Дороги"}}, http.StatusBadRequest},
+		{"create unknown locale", "POST", query("/api/service_types/new", "username", admin.Username),
+			map[string]interface{}{"code": "Paving", "names": map[string]string{"de": "Pflaster"}}, http.StatusBadRequest},
+		{"create unknown parent", "POST", query("/api/service_types/new", "username", admin.Username),
+			map[string]interface{}{"code": "Paving", "parentCode": "Missing", "names": map[string]string{"ru": "Укладка"}}, http.StatusNotFound},
+		{"get", "GET", "/api/service_types/Roadworks", nil, http.StatusOK},
+		{"get unknown", "GET", "/api/service_types/Missing", nil, http.StatusNotFound},
+		{"edit cycle", "PATCH", query("/api/service_types/Construction/edit", "username", admin.Username),
+			map[string]string{"parentCode": "Roadworks"}, http.StatusConflict},
+		{"edit names", "PATCH", query("/api/service_types/Roadworks/edit", "username", admin.Username),
+			map[string]interface{}{"names": map[string]string{"ru": "Дороги"}}, http.StatusOK},
+	})
+
+	req := httptest.NewRequest("GET", "/api/service_types", nil)
+	req.Header.Set("Accept-Language", "en-US,en;q=0.9")
+	rec := httptest.NewRecorder()
+	h.Router.ServeHTTP(rec, req)
+	var serviceTypes []map[string]interface{}
+	testharness.Decode(t, rec, &serviceTypes)
+	names := map[string]interface{}{}
+	for _, serviceType := range serviceTypes {
+		names[serviceType["code"].(string)] = serviceType["name"]
+	}
+	if names["Construction"] != "Construction" || names["Roadworks"] != "Roadworks" || len(names) != 4 {
+		t.Errorf("GET /api/service_types in English = %v", names)
+	}
+
+	body := tenderBody(f.AlphaAdmin.Username, f.Alpha)
+	body.ServiceType = "Roadworks"
+	rec = h.Do(t, "POST", "/api/tenders/new", body)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Failed to create tender: %d %s", rec.Code, rec.Body.String())
+	}
+	var tender api.Tender
+	testharness.Decode(t, rec, &tender)
+	target := query("/api/tenders/"+tender.Id+"/status", "username", f.AlphaAdmin.Username, "status", "Published")
+	if rec := h.Do(t, "PUT", target, nil); rec.Code != http.StatusOK {
+		t.Fatalf("Failed to publish tender: %d %s", rec.Code, rec.Body.String())
+	}
+
+	list := func(target string) []api.Tender {
+		t.Helper()
+		rec := h.Do(t, "GET", target, nil)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("GET %s = %d %s", target, rec.Code, rec.Body.String())
+		}
+		var tenders []api.Tender
+		testharness.Decode(t, rec, &tenders)
+		return tenders
+	}
+	if tenders := list("/api/tenders?service_type=Construction"); len(tenders) != 0 {
+		t.Errorf("Construction without subcategories = %d tenders, want 0", len(tenders))
+	}
+	if tenders := list("/api/tenders?service_type=Construction&include_subcategories=true"); len(tenders) != 1 || tenders[0].Id != tender.Id {
+		t.Errorf("Construction with subcategories = %v, want the roadworks tender", tenders)
+	}
+
+	runCases(t, h, []routeCase{
+		{"edit tender unknown type", "PATCH", query("/api/tenders/"+tender.Id+"/edit", "username", f.AlphaAdmin.Username),
+			map[string]string{"serviceType": "Cleaning"}, http.StatusBadRequest},
+		{"delete with subcategories", "DELETE", query("/api/service_types/Construction", "username", admin.Username), nil, http.StatusConflict},
+		{"delete in use", "DELETE", query("/api/service_types/Roadworks", "username", admin.Username), nil, http.StatusConflict},
+		{"delete not admin", "DELETE", query("/api/service_types/Manufacture", "username", f.AlphaAdmin.Username), nil, http.StatusForbidden},
+		{"delete", "DELETE", query("/api/service_types/Manufacture", "username", admin.Username), nil, http.StatusNoContent},
+		{"delete unknown", "DELETE", query("/api/service_types/Manufacture", "username", admin.Username), nil, http.StatusNotFound},
+	})
+}
+
+// Сохраненные поиски видит и запускает только их владелец
+func TestSavedSearches(t *testing.T) {
+	h := testharness.New(t)
+	f := h.Fixtures
+	tender := newTender(t, h, true)
+
+	body := map[string]interface{}{
+		"name":   "Ремонт",
+		"filter": map[string]interface{}{"serviceTypes": []string{"Construction"}, "search": " ремонт "},
+		"notify": true,
+	}
+	rec := h.Do(t, "POST", query("/api/searches/new", "username", f.Bidder.Username), body)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Failed to create saved search: %d %s", rec.Code, rec.Body.String())
+	}
+	var search map[string]interface{}
+	testharness.Decode(t, rec, &search)
+	id := search["id"].(string)
+
+	runCases(t, h, []routeCase{
+		{"create without username", "POST", "/api/searches/new", body, http.StatusUnauthorized},
+		{"create without name", "POST", query("/api/searches/new", "username", f.Bidder.Username),
+			map[string]interface{}{"filter": map[string]interface{}{}}, http.StatusBadRequest},
+		{"create invalid sort", "POST", query("/api/searches/new", "username", f.Bidder.Username),
+			map[string]interface{}{"name": "Сортировка", "filter": map[string]interface{}{"sort": "status"}}, http.StatusBadRequest},
+		{"create unknown service type", "POST", query("/api/searches/new", "username", f.Bidder.Username),
+			map[string]interface{}{"name": "Уборка", "filter": map[string]interface{}{"serviceTypes": []string{"Cleaning"}}}, http.StatusBadRequest},
+		{"list", "GET", query("/api/searches", "username", f.Bidder.Username), nil, http.StatusOK},
+		{"get", "GET", query("/api/searches/"+id, "username", f.Bidder.Username), nil, http.StatusOK},
+		{"get other employee", "GET", query("/api/searches/"+id, "username", f.AlphaViewer.Username), nil, http.StatusNotFound},
+		{"get invalid id", "GET", query("/api/searches/1", "username", f.Bidder.Username), nil, http.StatusNotFound},
+		{"edit", "PATCH", query("/api/searches/"+id+"/edit", "username", f.Bidder.Username),
+			map[string]interface{}{"notify": false}, http.StatusOK},
+		{"run invalid limit", "GET", query("/api/searches/"+id+"/tenders", "username", f.Bidder.Username, "limit", "-1"), nil, http.StatusBadRequest},
+	})
+
+	rec = h.Do(t, "GET", query("/api/searches/"+id+"/tenders", "username", f.Bidder.Username), nil)
+	var tenders []api.Tender
+	testharness.Decode(t, rec, &tenders)
+	if len(tenders) != 1 || tenders[0].Id != tender.Id {
+		t.Errorf("GET /api/searches/{searchId}/tenders = %v, want tender %s", tenders, tender.Id)
+	}
+
+	runCases(t, h, []routeCase{
+		{"delete other employee", "DELETE", query("/api/searches/"+id, "username", f.AlphaViewer.Username), nil, http.StatusNotFound},
+		{"delete", "DELETE", query("/api/searches/"+id, "username", f.Bidder.Username), nil, http.StatusNoContent},
+		{"get deleted", "GET", query("/api/searches/"+id, "username", f.Bidder.Username), nil, http.StatusNotFound},
+	})
+}
+
+// POST /api/tenders/import: предпросмотр ничего не создает, ошибка в любой строке отменяет импорт
+func TestImportTenders(t *testing.T) {
+	h := testharness.New(t)
+	f := h.Fixtures
+
+	post := func(target, csv string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("POST", target, strings.NewReader(csv))
+		req.Header.Set("Content-Type", "text/csv")
+		rec := httptest.NewRecorder()
+		h.Router.ServeHTTP(rec, req)
+		return rec
+	}
+	count := func() int {
+		var tenders []api.Tender
+		testharness.Decode(t, h.Do(t, "GET", query("/api/tenders/my", "username", f.AlphaAdmin.Username), nil), &tenders)
+		return len(tenders)
+	}
+
+	valid := "Name,Description,ServiceType,OrganizationId,Deadline,Budget\n" +
+		"Ремонт,Ремонт офиса,Construction," + f.Alpha.ID.String() + ",2030-01-31,150000.50\n" +
+		"Доставка,Доставка мебели,Delivery," + f.Alpha.ID.String() + ",,\n"
+	invalid := valid + "Уборка,Уборка офиса,Cleaning," + f.Alpha.ID.String() + ",завтра,-1\n" +
+		"Чужой,Чужой тендер,Delivery," + f.Beta.ID.String() + ",,\n"
+	importURL := func(username string, dryRun bool) string {
+		return query("/api/tenders/import", "username", username, "dry_run", strconv.FormatBool(dryRun))
+	}
+
+	rec := post(importURL(f.AlphaAdmin.Username, true), invalid)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("dry run = %d %s", rec.Code, rec.Body.String())
+	}
+	var report struct {
+		Imported int
+		Rows     []struct {
+			Row    int
+			Tender *api.Tender
+			Errors []string
+		}
+	}
+	testharness.Decode(t, rec, &report)
+	if len(report.Rows) != 4 || report.Imported != 0 {
+		t.Fatalf("dry run report = %s", rec.Body.String())
+	}
+	if report.Rows[0].Tender == nil || report.Rows[0].Tender.Budget == nil || *report.Rows[0].Tender.Budget != 150000.50 {
+		t.Errorf("row 2 preview = %s", rec.Body.String())
+	}
+	if report.Rows[2].Row != 4 || len(report.Rows[2].Errors) != 2 || len(report.Rows[3].Errors) != 1 {
+		t.Errorf("rows 4 and 5 errors = %s", rec.Body.String())
+	}
+	if n := count(); n != 0 {
+		t.Fatalf("dry run created %d tenders", n)
+	}
+
+	if rec := post(importURL(f.AlphaAdmin.Username, false), invalid); rec.Code != http.StatusBadRequest {
+		t.Fatalf("import with invalid rows = %d %s", rec.Code, rec.Body.String())
+	}
+	if n := count(); n != 0 {
+		t.Fatalf("failed import created %d tenders", n)
+	}
+	if rec := post(importURL(f.AlphaAdmin.Username, false), valid); rec.Code != http.StatusOK {
+		t.Fatalf("import = %d %s", rec.Code, rec.Body.String())
+	}
+	if n := count(); n != 2 {
+		t.Fatalf("import created %d tenders, want 2", n)
+	}
+
+	if rec := post(importURL("nobody", false), valid); rec.Code != http.StatusUnauthorized {
+		t.Errorf("unknown user = %d, want 401", rec.Code)
+	}
+	if rec := post(importURL(f.AlphaAdmin.Username, false), "name,organizationId\n"); rec.Code != http.StatusBadRequest {
+		t.Errorf("missing columns = %d, want 400", rec.Code)
+	}
+}
+
+// 04: GET и PUT /api/tenders/{tenderId}/status
+func TestTenderStatus(t *testing.T) {
+	h := testharness.New(t)
+	f := h.Fixtures
+	created := newTender(t, h, false)
+	published := newTender(t, h, true)
+	createdStatus := "/api/tenders/" + created.Id + "/status"
+	publishedStatus := "/api/tenders/" + published.Id + "/status"
+
+	runCases(t, h, []routeCase{
+		{"responsible sees created", "GET", query(createdStatus, "username", f.AlphaViewer.Username), nil, http.StatusOK},
+		{"anyone sees published", "GET", publishedStatus, nil, http.StatusOK},
+		{"created without username", "GET", createdStatus, nil, http.StatusUnauthorized},
+		{"created by unknown user", "GET", query(createdStatus, "username", "nobody"), nil, http.StatusUnauthorized},
+		{"created by outsider", "GET", query(createdStatus, "username", f.Bidder.Username), nil, http.StatusForbidden},
+		{"unknown tender", "GET", query("/api/tenders/"+unknownID+"/status", "username", f.AlphaAdmin.Username), nil, http.StatusNotFound},
+		{"invalid tender id", "GET", query("/api/tenders/not-a-uuid/status", "username", f.AlphaAdmin.Username), nil, http.StatusNotFound},
+
+		{"invalid status", "PUT", query(createdStatus, "username", f.AlphaAdmin.Username, "status", "Deleted"), nil, http.StatusBadRequest},
+		{"update by unknown user", "PUT", query(createdStatus, "username", "nobody", "status", "Published"), nil, http.StatusUnauthorized},
+		{"update by viewer", "PUT", query(createdStatus, "username", f.AlphaViewer.Username, "status", "Published"), nil, http.StatusForbidden},
+		{"update by outsider", "PUT", query(createdStatus, "username", f.BetaAdmin.Username, "status", "Published"), nil, http.StatusForbidden},
+		{"update unknown tender", "PUT", query("/api/tenders/"+unknownID+"/status", "username", f.AlphaAdmin.Username, "status", "Published"), nil, http.StatusNotFound},
+		{"publish", "PUT", query(createdStatus, "username", f.AlphaAuthor.Username, "status", "Published"), nil, http.StatusOK},
+		{"close", "PUT", query(createdStatus, "username", f.AlphaAdmin.Username, "status", "Closed"), nil, http.StatusOK},
+	})
+}
+
+// 05: PATCH /api/tenders/{tenderId}/edit и PUT /api/tenders/{tenderId}/rollback/{version}
+func TestTenderVersions(t *testing.T) {
+	h := testharness.New(t)
+	f := h.Fixtures
+	tender := newTender(t, h, false)
+	edit := "/api/tenders/" + tender.Id + "/edit"
+	rollback := "/api/tenders/" + tender.Id + "/rollback/"
+
+	runCases(t, h, []routeCase{
+		{"edit", "PATCH", query(edit, "username", f.AlphaAdmin.Username), map[string]string{"name": "Ремонт склада"}, http.StatusOK},
+		{"edit service type", "PATCH", query(edit, "username", f.AlphaAuthor.Username), map[string]string{"serviceType": "Delivery"}, http.StatusOK},
+		{"edit empty name", "PATCH", query(edit, "username", f.AlphaAdmin.Username), map[string]string{"name": ""}, http.StatusBadRequest},
+		{"edit long description", "PATCH", query(edit, "username", f.AlphaAdmin.Username), map[string]string{"description": strings.Repeat("а", 501)}, http.StatusBadRequest},
+		{"edit unknown service type", "PATCH", query(edit, "username", f.AlphaAdmin.Username), map[string]string{"serviceType": "Cleaning"}, http.StatusBadRequest},
+		{"edit by unknown user", "PATCH", query(edit, "username", "nobody"), map[string]string{"name": "Ремонт"}, http.StatusUnauthorized},
+		{"edit by viewer", "PATCH", query(edit, "username", f.AlphaViewer.Username), map[string]string{"name": "Ремонт"}, http.StatusForbidden},
+		{"edit unknown tender", "PATCH", query("/api/tenders/"+unknownID+"/edit", "username", f.AlphaAdmin.Username), map[string]string{"name": "Ремонт"}, http.StatusNotFound},
+
+		{"rollback", "PUT", query(rollback+"1", "username", f.AlphaAdmin.Username), nil, http.StatusOK},
+		{"rollback invalid version", "PUT", query(rollback+"0", "username", f.AlphaAdmin.Username), nil, http.StatusBadRequest},
+		{"rollback unknown version", "PUT", query(rollback+"100", "username", f.AlphaAdmin.Username), nil, http.StatusNotFound},
+		{"rollback by unknown user", "PUT", query(rollback+"1", "username", "nobody"), nil, http.StatusUnauthorized},
+		{"rollback by outsider", "PUT", query(rollback+"1", "username", f.Bidder.Username), nil, http.StatusForbidden},
+		{"rollback unknown tender", "PUT", query("/api/tenders/"+unknownID+"/rollback/1", "username", f.AlphaAdmin.Username), nil, http.StatusNotFound},
+	})
+}
+
+// 06: POST /api/bids/new
+func TestCreateBid(t *testing.T) {
+	h := testharness.New(t)
+	f := h.Fixtures
+	published := newTender(t, h, true)
+	created := newTender(t, h, false)
+
+	withName := func(name string) api.CreateBidJSONRequestBody {
+		body := bidBody(published.Id, f.Bidder)
+		body.Name = name
+		return body
+	}
+	withAuthorType := bidBody(published.Id, f.Bidder)
+	withAuthorType.AuthorType = "Company"
+	unknownAuthor := bidBody(published.Id, f.Bidder)
+	unknownAuthor.AuthorId = unknownID
+
+	runCases(t, h, []routeCase{
+		{"ok", "POST", "/api/bids/new", bidBody(published.Id, f.Bidder), http.StatusOK},
+		{"empty name", "POST", "/api/bids/new", withName(""), http.StatusBadRequest},
+		{"long name", "POST", "/api/bids/new", withName(strings.Repeat("а", 101)), http.StatusBadRequest},
+		{"unknown author type", "POST", "/api/bids/new", withAuthorType, http.StatusBadRequest},
+		{"unknown author", "POST", "/api/bids/new", unknownAuthor, http.StatusUnauthorized},
+		{"unpublished tender", "POST", "/api/bids/new", bidBody(created.Id, f.Bidder), http.StatusNotFound},
+		{"unknown tender", "POST", "/api/bids/new", bidBody(unknownID, f.Bidder), http.StatusNotFound},
+	})
+}
+
+// 07: PUT /api/bids/{bidId}/submit_decision
+func TestBidDecision(t *testing.T) {
+	h := testharness.New(t)
+	f := h.Fixtures
+	tender := newTender(t, h, true)
+	approved := newBid(t, h, tender.Id, true)
+	rejected := newBid(t, h, tender.Id, true)
+	decision := func(bidID, username, value string) string {
+		return query("/api/bids/"+bidID+"/submit_decision", "username", username, "decision", value)
+	}
+
+	runCases(t, h, []routeCase{
+		{"invalid decision", "PUT", decision(approved.Id, f.AlphaAdmin.Username, "Maybe"), nil, http.StatusBadRequest},
+		{"unknown user", "PUT", decision(approved.Id, "nobody", "Approved"), nil, http.StatusUnauthorized},
+		{"author", "PUT", decision(approved.Id, f.Bidder.Username, "Approved"), nil, http.StatusForbidden},
+		{"viewer", "PUT", decision(approved.Id, f.AlphaViewer.Username, "Approved"), nil, http.StatusForbidden},
+		{"tender author", "PUT", decision(approved.Id, f.AlphaAuthor.Username, "Approved"), nil, http.StatusForbidden},
+		{"other organization", "PUT", decision(approved.Id, f.BetaAdmin.Username, "Approved"), nil, http.StatusForbidden},
+		{"unknown bid", "PUT", decision(unknownID, f.AlphaAdmin.Username, "Approved"), nil, http.StatusNotFound},
+		{"reject", "PUT", decision(rejected.Id, f.AlphaApprover.Username, "Rejected"), nil, http.StatusOK},
+		{"approve", "PUT", decision(approved.Id, f.AlphaApprover.Username, "Approved"), nil, http.StatusOK},
+	})
+}
+
+// 08: GET /api/bids/my и GET /api/bids/{tenderId}/list
+func TestListBids(t *testing.T) {
+	h := testharness.New(t)
+	f := h.Fixtures
+	tender := newTender(t, h, true)
+	newBid(t, h, tender.Id, true)
+	list := "/api/bids/" + tender.Id + "/list"
+
+	runCases(t, h, []routeCase{
+		{"my", "GET", query("/api/bids/my", "username", f.Bidder.Username), nil, http.StatusOK},
+		{"my without username", "GET", "/api/bids/my", nil, http.StatusUnauthorized},
+		{"my unknown user", "GET", query("/api/bids/my", "username", "nobody"), nil, http.StatusUnauthorized},
+		{"my limit too large", "GET", query("/api/bids/my", "username", f.Bidder.Username, "limit", "51"), nil, http.StatusBadRequest},
+
+		{"tender", "GET", query(list, "username", f.AlphaAdmin.Username), nil, http.StatusOK},
+		{"tender by viewer", "GET", query(list, "username", f.AlphaViewer.Username, "limit", "1"), nil, http.StatusOK},
+		{"tender negative offset", "GET", query(list, "username", f.AlphaAdmin.Username, "offset", "-1"), nil, http.StatusBadRequest},
+		{"tender by unknown user", "GET", query(list, "username", "nobody"), nil, http.StatusUnauthorized},
+		{"tender by bidder", "GET", query(list, "username", f.Bidder.Username), nil, http.StatusForbidden},
+		{"tender by other organization", "GET", query(list, "username", f.BetaAdmin.Username), nil, http.StatusForbidden},
+		{"unknown tender", "GET", query("/api/bids/"+unknownID+"/list", "username", f.AlphaAdmin.Username), nil, http.StatusNotFound},
+	})
+}
+
+// 09: GET и PUT /api/bids/{bidId}/status
+func TestBidStatus(t *testing.T) {
+	h := testharness.New(t)
+	f := h.Fixtures
+	tender := newTender(t, h, true)
+	bid := newBid(t, h, tender.Id, false)
+	status := "/api/bids/" + bid.Id + "/status"
+
+	runCases(t, h, []routeCase{
+		{"author", "GET", query(status, "username", f.Bidder.Username), nil, http.StatusOK},
+		{"responsible", "GET", query(status, "username", f.AlphaViewer.Username), nil, http.StatusOK},
+		{"unknown user", "GET", query(status, "username", "nobody"), nil, http.StatusUnauthorized},
+		{"other organization", "GET", query(status, "username", f.BetaAdmin.Username), nil, http.StatusForbidden},
+		{"unknown bid", "GET", query("/api/bids/"+unknownID+"/status", "username", f.Bidder.Username), nil, http.StatusNotFound},
+
+		{"invalid status", "PUT", query(status, "username", f.Bidder.Username, "status", "Approved"), nil, http.StatusBadRequest},
+		{"update by unknown user", "PUT", query(status, "username", "nobody", "status", "Published"), nil, http.StatusUnauthorized},
+		{"update by responsible", "PUT", query(status, "username", f.AlphaAdmin.Username, "status", "Published"), nil, http.StatusForbidden},
+		{"update unknown bid", "PUT", query("/api/bids/"+unknownID+"/status", "username", f.Bidder.Username, "status", "Published"), nil, http.StatusNotFound},
+		{"publish", "PUT", query(status, "username", f.Bidder.Username, "status", "Published"), nil, http.StatusOK},
+	})
+}
+
+// 10: PATCH /api/bids/{bidId}/edit и PUT /api/bids/{bidId}/rollback/{version}
+func TestBidVersions(t *testing.T) {
+	h := testharness.New(t)
+	f := h.Fixtures
+	tender := newTender(t, h, true)
+	bid := newBid(t, h, tender.Id, false)
+	edit := "/api/bids/" + bid.Id + "/edit"
+	rollback := "/api/bids/" + bid.Id + "/rollback/"
+
+	runCases(t, h, []routeCase{
+		{"edit", "PATCH", query(edit, "username", f.Bidder.Username), map[string]string{"description": "Выполним за неделю"}, http.StatusOK},
+		{"edit empty description", "PATCH", query(edit, "username", f.Bidder.Username), map[string]string{"description": ""}, http.StatusBadRequest},
+		{"edit long name", "PATCH", query(edit, "username", f.Bidder.Username), map[string]string{"name": strings.Repeat("а", 101)}, http.StatusBadRequest},
+		{"edit by unknown user", "PATCH", query(edit, "username", "nobody"), map[string]string{"name": "Предложение"}, http.StatusUnauthorized},
+		{"edit by responsible", "PATCH", query(edit, "username", f.AlphaAdmin.Username), map[string]string{"name": "Предложение"}, http.StatusForbidden},
+		{"edit unknown bid", "PATCH", query("/api/bids/"+unknownID+"/edit", "username", f.Bidder.Username), map[string]string{"name": "Предложение"}, http.StatusNotFound},
+
+		{"rollback", "PUT", query(rollback+"1", "username", f.Bidder.Username), nil, http.StatusOK},
+		{"rollback invalid version", "PUT", query(rollback+"0", "username", f.Bidder.Username), nil, http.StatusBadRequest},
+		{"rollback unknown version", "PUT", query(rollback+"100", "username", f.Bidder.Username), nil, http.StatusNotFound},
+		{"rollback by unknown user", "PUT", query(rollback+"1", "username", "nobody"), nil, http.StatusUnauthorized},
+		{"rollback by responsible", "PUT", query(rollback+"1", "username", f.AlphaAdmin.Username), nil, http.StatusForbidden},
+	})
+}
+
+// 11: PUT /api/bids/{bidId}/feedback и GET /api/bids/{tenderId}/reviews
+func TestBidFeedback(t *testing.T) {
+	h := testharness.New(t)
+	f := h.Fixtures
+	tender := newTender(t, h, true)
+	bid := newBid(t, h, tender.Id, true)
+	otherTender := newTender(t, h, true)
+	feedback := func(username, text string) string {
+		return query("/api/bids/"+bid.Id+"/feedback", "username", username, "bidFeedback", text)
+	}
+	reviews := func(tenderID, author, requester string) string {
+		return query("/api/bids/"+tenderID+"/reviews", "authorUsername", author, "requesterUsername", requester)
+	}
+
+	runCases(t, h, []routeCase{
+		{"feedback", "PUT", feedback(f.AlphaApprover.Username, "Хорошее предложение"), nil, http.StatusOK},
+		{"empty feedback", "PUT", feedback(f.AlphaAdmin.Username, ""), nil, http.StatusBadRequest},
+		{"long feedback", "PUT", feedback(f.AlphaAdmin.Username, strings.Repeat("а", 1001)), nil, http.StatusBadRequest},
+		{"feedback by unknown user", "PUT", feedback("nobody", "Отзыв"), nil, http.StatusUnauthorized},
+		{"feedback by viewer", "PUT", feedback(f.AlphaViewer.Username, "Отзыв"), nil, http.StatusForbidden},
+		{"feedback by author", "PUT", feedback(f.Bidder.Username, "Отзыв"), nil, http.StatusForbidden},
+		{"feedback on unknown bid", "PUT", query("/api/bids/"+unknownID+"/feedback", "username", f.AlphaAdmin.Username, "bidFeedback", "Отзыв"), nil, http.StatusNotFound},
+
+		{"reviews", "GET", reviews(tender.Id, f.Bidder.Username, f.AlphaAdmin.Username), nil, http.StatusOK},
+		{"reviews by viewer", "GET", reviews(tender.Id, f.Bidder.Username, f.AlphaViewer.Username), nil, http.StatusOK},
+		{"reviews by unknown requester", "GET", reviews(tender.Id, f.Bidder.Username, "nobody"), nil, http.StatusUnauthorized},
+		{"reviews by other organization", "GET", reviews(tender.Id, f.Bidder.Username, f.BetaAdmin.Username), nil, http.StatusForbidden},
+		{"reviews of author without bids", "GET", reviews(otherTender.Id, f.Bidder.Username, f.AlphaAdmin.Username), nil, http.StatusNotFound},
+		{"reviews on unknown tender", "GET", reviews(unknownID, f.Bidder.Username, f.AlphaAdmin.Username), nil, http.StatusNotFound},
+	})
+}
+
+// GET /api/tenders/{tenderId}/export: формат по параметру или Accept, доступ как к списку предложений
+func TestExportTender(t *testing.T) {
+	h := testharness.New(t)
+	f := h.Fixtures
+	tender := newTender(t, h, true)
+	newBid(t, h, tender.Id, true)
+	export := "/api/tenders/" + tender.Id + "/export"
+
+	runCases(t, h, []routeCase{
+		{"csv", "GET", query(export, "username", f.AlphaAdmin.Username), nil, http.StatusOK},
+		{"xlsx", "GET", query(export, "username", f.AlphaViewer.Username, "format", "xlsx"), nil, http.StatusOK},
+		{"unknown format", "GET", query(export, "username", f.AlphaAdmin.Username, "format", "pdf"), nil, http.StatusBadRequest},
+		{"without username", "GET", export, nil, http.StatusUnauthorized},
+		{"unknown user", "GET", query(export, "username", "nobody"), nil, http.StatusUnauthorized},
+		{"bidder", "GET", query(export, "username", f.Bidder.Username), nil, http.StatusForbidden},
+		{"other organization", "GET", query(export, "username", f.BetaAdmin.Username), nil, http.StatusForbidden},
+		{"unknown tender", "GET", query("/api/tenders/"+unknownID+"/export", "username", f.AlphaAdmin.Username), nil, http.StatusNotFound},
+	})
+
+	req := httptest.NewRequest("GET", query(export, "username", f.AlphaAdmin.Username), nil)
+	req.Header.Set("Accept", "application/x-ndjson")
+	rec := httptest.NewRecorder()
+	h.Router.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK || rec.Header().Get("Content-Type") != "application/jsonl" {
+		t.Fatalf("JSONL export = %d %q", rec.Code, rec.Header().Get("Content-Type"))
+	}
+	lines := strings.Split(strings.TrimSpace(rec.Body.String()), "\n")
+	if len(lines) != 2 || !strings.HasPrefix(lines[0], `{"record":"tender"`) || !strings.HasPrefix(lines[1], `{"record":"bid"`) {
+		t.Errorf("JSONL export = %q, want the tender and its bid", rec.Body.String())
+	}
+
+	req.Header.Set("Accept", "application/json")
+	rec = httptest.NewRecorder()
+	h.Router.ServeHTTP(rec, req)
+	if rec.Code != http.StatusNotAcceptable {
+		t.Errorf("export as JSON = %d, want 406", rec.Code)
+	}
+}
+
+// GET /api/tenders/{tenderId}/protocol.pdf появляется, когда согласование закрывает тендер
+func TestTenderProtocol(t *testing.T) {
+	h := testharness.New(t)
+	f := h.Fixtures
+	tender := newTender(t, h, true)
+	bid := newBid(t, h, tender.Id, true)
+	protocol := "/api/tenders/" + tender.Id + "/protocol.pdf"
+
+	if rec := h.Do(t, "GET", query(protocol, "username", f.AlphaAdmin.Username), nil); rec.Code != http.StatusNotFound {
+		t.Fatalf("protocol of open tender = %d, want 404", rec.Code)
+	}
+
+	// Кворум - голоса обоих ответственных с правом согласования
+	for _, approver := range []models.Employee{f.AlphaAdmin, f.AlphaApprover} {
+		target := query("/api/bids/"+bid.Id+"/submit_decision", "username", approver.Username, "decision", "Approved")
+		if rec := h.Do(t, "PUT", target, nil); rec.Code != http.StatusOK {
+			t.Fatalf("Failed to approve bid: %d %s", rec.Code, rec.Body.String())
+		}
+	}
+
+	rec := h.Do(t, "GET", query(protocol, "username", f.AlphaViewer.Username), nil)
+	if rec.Code != http.StatusOK || rec.Header().Get("Content-Type") != "application/pdf" ||
+		!strings.HasPrefix(rec.Body.String(), "%PDF-") {
+		t.Fatalf("protocol = %d %q", rec.Code, rec.Header().Get("Content-Type"))
+	}
+
+	runCases(t, h, []routeCase{
+		{"without username", "GET", protocol, nil, http.StatusUnauthorized},
+		{"unknown user", "GET", query(protocol, "username", "nobody"), nil, http.StatusUnauthorized},
+		{"bidder", "GET", query(protocol, "username", f.Bidder.Username), nil, http.StatusForbidden},
+		{"other organization", "GET", query(protocol, "username", f.BetaAdmin.Username), nil, http.StatusForbidden},
+		{"unknown tender", "GET", query("/api/tenders/"+unknownID+"/protocol.pdf", "username", f.AlphaAdmin.Username), nil, http.StatusNotFound},
+	})
+}
+
+// Многораундовый тендер: во второй раунд проходят авторы из шорт-листа, решение - только в последнем раунде
+func TestTenderRounds(t *testing.T) {
+	h := testharness.New(t)
+	f := h.Fixtures
+	runner := models.Employee{Username: "runner_up"}
+	if err := h.Service.CreateEmployee(&runner); err != nil {
+		t.Fatalf("Failed to create employee: %v", err)
+	}
+
+	body := tenderBody(f.AlphaAdmin.Username, f.Alpha)
+	rounds := int32(2)
+	body.Rounds = &rounds
+	rec := h.Do(t, "POST", "/api/tenders/new", body)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Failed to create tender: %d %s", rec.Code, rec.Body.String())
+	}
+	var tender api.Tender
+	testharness.Decode(t, rec, &tender)
+	if tender.Rounds != 2 || tender.Round != 1 {
+		t.Fatalf("created tender rounds = %d, round = %d", tender.Rounds, tender.Round)
+	}
+	target := query("/api/tenders/"+tender.Id+"/status", "username", f.AlphaAdmin.Username, "status", "Published")
+	if rec := h.Do(t, "PUT", target, nil); rec.Code != http.StatusOK {
+		t.Fatalf("Failed to publish tender: %d %s", rec.Code, rec.Body.String())
+	}
+	bid := newBid(t, h, tender.Id, true)
+	rec = h.Do(t, "POST", "/api/bids/new", bidBody(tender.Id, runner))
+	var runnerBid api.Bid
+	testharness.Decode(t, rec, &runnerBid)
+
+	tenderPath := "/api/tenders/" + tender.Id
+	tooMany := body
+	tooMany.Rounds = new(int32)
+	*tooMany.Rounds = 6
+	runCases(t, h, []routeCase{
+		{"create with too many rounds", "POST", "/api/tenders/new", tooMany, http.StatusBadRequest},
+		{"decision in first round", "PUT", query("/api/bids/"+bid.Id+"/submit_decision", "username", f.AlphaApprover.Username, "decision", "Approved"), nil, http.StatusConflict},
+		{"next round with empty shortlist", "PUT", query(tenderPath+"/next_round", "username", f.AlphaAdmin.Username), nil, http.StatusConflict},
+		{"shortlist by viewer", "PUT", query(tenderPath+"/shortlist/"+bid.Id, "username", f.AlphaViewer.Username), nil, http.StatusForbidden},
+		{"shortlist draft bid", "PUT", query(tenderPath+"/shortlist/"+runnerBid.Id, "username", f.AlphaApprover.Username), nil, http.StatusConflict},
+		{"shortlist", "PUT", query(tenderPath+"/shortlist/"+bid.Id, "username", f.AlphaApprover.Username), nil, http.StatusOK},
+		{"get shortlist", "GET", query(tenderPath+"/shortlist", "username", f.AlphaViewer.Username), nil, http.StatusOK},
+		{"get shortlist invalid round", "GET", query(tenderPath+"/shortlist", "username", f.AlphaViewer.Username, "round", "0"), nil, http.StatusBadRequest},
+		{"next round by viewer", "PUT", query(tenderPath+"/next_round", "username", f.AlphaViewer.Username), nil, http.StatusForbidden},
+		{"next round", "PUT", query(tenderPath+"/next_round", "username", f.AlphaAdmin.Username), nil, http.StatusOK},
+		{"next round after final", "PUT", query(tenderPath+"/next_round", "username", f.AlphaAdmin.Username), nil, http.StatusConflict},
+		{"bid not shortlisted", "POST", "/api/bids/new", bidBody(tender.Id, runner), http.StatusForbidden},
+		{"edit not shortlisted", "PATCH", query("/api/bids/"+runnerBid.Id+"/edit", "username", runner.Username),
+			map[string]string{"description": "Снизим цену"}, http.StatusForbidden},
+		{"edit shortlisted", "PATCH", query("/api/bids/"+bid.Id+"/edit", "username", f.Bidder.Username),
+			map[string]string{"description": "Лучшее и окончательное предложение"}, http.StatusOK},
+		{"unknown round", "GET", query(tenderPath+"/rounds/3/bids", "username", f.AlphaViewer.Username), nil, http.StatusNotFound},
+		{"invalid round", "GET", query(tenderPath+"/rounds/last/bids", "username", f.AlphaViewer.Username), nil, http.StatusBadRequest},
+		{"round bids by bidder", "GET", query(tenderPath+"/rounds/1/bids", "username", f.Bidder.Username), nil, http.StatusForbidden},
+	})
+
+	for round, want := range map[string]string{"1": "Выполним за две недели", "2": "Лучшее и окончательное предложение"} {
+		rec := h.Do(t, "GET", query(tenderPath+"/rounds/"+round+"/bids", "username", f.AlphaViewer.Username), nil)
+		var bids []api.Bid
+		testharness.Decode(t, rec, &bids)
+		if len(bids) != 1 || bids[0].Description != want {
+			t.Errorf("bids of round %s = %+v, want %q", round, bids, want)
+		}
+	}
+
+	target = query("/api/bids/"+bid.Id+"/submit_decision", "username", f.AlphaApprover.Username, "decision", "Approved")
+	if rec := h.Do(t, "PUT", target, nil); rec.Code != http.StatusOK {
+		t.Errorf("decision in final round = %d %s", rec.Code, rec.Body.String())
+	}
+}
+
+// Реверсивный аукцион тендера Delivery: участник видит только свое место, решение - после окончания
+func TestReverseAuction(t *testing.T) {
+	h := testharness.New(t)
+	f := h.Fixtures
+	construction := newTender(t, h, true)
+	tender := newTender(t, h, true)
+	edit := query("/api/tenders/"+tender.Id+"/edit", "username", f.AlphaAuthor.Username)
+	if rec := h.Do(t, "PATCH", edit, map[string]string{"serviceType": "Delivery"}); rec.Code != http.StatusOK {
+		t.Fatalf("Failed to edit tender: %d %s", rec.Code, rec.Body.String())
+	}
+	bid := newBid(t, h, tender.Id, true)
+
+	auctionPath := "/api/tenders/" + tender.Id + "/auction"
+	bidPath := "/api/bids/" + bid.Id + "/auction"
+	now := time.Now()
+	config := map[string]interface{}{
+		"startPrice":       1000,
+		"minDecrement":     10,
+		"startsAt":         now.Add(-time.Minute).Format(time.RFC3339),
+		"endsAt":           now.Add(time.Hour).Format(time.RFC3339),
+		"extensionSeconds": 120,
+	}
+	reversed := map[string]interface{}{
+		"minDecrement": 10,
+		"startsAt":     now.Add(time.Hour).Format(time.RFC3339),
+		"endsAt":       now.Format(time.RFC3339),
+	}
+	runCases(t, h, []routeCase{
+		{"get before configure", "GET", auctionPath, nil, http.StatusNotFound},
+		{"configure without username", "PUT", auctionPath, config, http.StatusUnauthorized},
+		{"configure reversed window", "PUT", query(auctionPath, "username", f.AlphaAuthor.Username), reversed, http.StatusBadRequest},
+		{"configure by viewer", "PUT", query(auctionPath, "username", f.AlphaViewer.Username), config, http.StatusForbidden},
+		{"configure construction", "PUT", query("/api/tenders/"+construction.Id+"/auction", "username", f.AlphaAuthor.Username), config, http.StatusConflict},
+		{"configure", "PUT", query(auctionPath, "username", f.AlphaAuthor.Username), config, http.StatusOK},
+		{"get", "GET", auctionPath, nil, http.StatusOK},
+		{"offer without price", "POST", query(bidPath, "username", f.Bidder.Username), map[string]interface{}{}, http.StatusBadRequest},
+		{"offer by other employee", "POST", query(bidPath, "username", f.AlphaViewer.Username), map[string]interface{}{"price": 900}, http.StatusForbidden},
+		{"offer above start price", "POST", query(bidPath, "username", f.Bidder.Username), map[string]interface{}{"price": 1200}, http.StatusConflict},
+		{"offer", "POST", query(bidPath, "username", f.Bidder.Username), map[string]interface{}{"price": 900}, http.StatusOK},
+		{"offer below min decrement", "POST", query(bidPath, "username", f.Bidder.Username), map[string]interface{}{"price": 895}, http.StatusConflict},
+		{"reconfigure with offers", "PUT", query(auctionPath, "username", f.AlphaAuthor.Username), config, http.StatusConflict},
+		{"ranking by bidder", "GET", query(auctionPath+"/ranking", "username", f.Bidder.Username), nil, http.StatusForbidden},
+		{"ranking", "GET", query(auctionPath+"/ranking", "username", f.AlphaViewer.Username), nil, http.StatusOK},
+		{"decision before end", "PUT", query("/api/bids/"+bid.Id+"/submit_decision", "username", f.AlphaApprover.Username, "decision", "Approved"), nil, http.StatusConflict},
+	})
+
+	rec := h.Do(t, "GET", query(bidPath, "username", f.Bidder.Username), nil)
+	var standing map[string]interface{}
+	testharness.Decode(t, rec, &standing)
+	if standing["rank"] != 1.0 || standing["participants"] != 1.0 || standing["price"] != 900.0 {
+		t.Errorf("GET %s = %v, want rank 1 of 1 with price 900", bidPath, standing)
+	}
+}
+
+// Запечатанный тендер: до срока ответственные видят только метаданные и хеш предложения, автор - содержимое
+func TestSealedTender(t *testing.T) {
+	h := testharness.New(t)
+	f := h.Fixtures
+	sealed := true
+	deadline := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	body := tenderBody(f.AlphaAdmin.Username, f.Alpha)
+	body.Sealed = &sealed
+	runCases(t, h, []routeCase{
+		{"sealed without deadline", "POST", "/api/tenders/new", body, http.StatusBadRequest},
+	})
+
+	body.Deadline = &deadline
+	multiRound := body
+	rounds := api.TenderRounds(2)
+	multiRound.Rounds = &rounds
+	runCases(t, h, []routeCase{
+		{"sealed with several rounds", "POST", "/api/tenders/new", multiRound, http.StatusBadRequest},
+	})
+	rec := h.Do(t, "POST", "/api/tenders/new", body)
+	var tender api.Tender
+	testharness.Decode(t, rec, &tender)
+	if !tender.Sealed {
+		t.Fatalf("POST /api/tenders/new: sealed = false, want true")
+	}
+	publish := query("/api/tenders/"+tender.Id+"/status", "username", f.AlphaAdmin.Username, "status", "Published")
+	if rec := h.Do(t, "PUT", publish, nil); rec.Code != http.StatusOK {
+		t.Fatalf("Failed to publish tender: %d %s", rec.Code, rec.Body.String())
+	}
+	bid := newBid(t, h, tender.Id, true)
+	if bid.Name != "Предложение" || bid.Commitment == nil {
+		t.Errorf("POST /api/bids/new = %+v, want opened bid with commitment", bid)
+	}
+
+	auditPath := "/api/tenders/" + tender.Id + "/audit"
+	runCases(t, h, []routeCase{
+		{"decision before deadline", "PUT", query("/api/bids/"+bid.Id+"/submit_decision", "username", f.AlphaApprover.Username, "decision", "Approved"), nil, http.StatusConflict},
+		{"earlier deadline", "PATCH", query("/api/tenders/"+tender.Id+"/edit", "username", f.AlphaAuthor.Username), map[string]string{"deadline": time.Now().Add(time.Minute).UTC().Format(time.RFC3339)}, http.StatusConflict},
+		{"more rounds", "PATCH", query("/api/tenders/"+tender.Id+"/edit", "username", f.AlphaAuthor.Username), map[string]int{"rounds": 2}, http.StatusConflict},
+		{"audit without username", "GET", auditPath, nil, http.StatusUnauthorized},
+		{"audit by bidder", "GET", query(auditPath, "username", f.Bidder.Username), nil, http.StatusForbidden},
+		{"audit", "GET", query(auditPath, "username", f.AlphaViewer.Username), nil, http.StatusOK},
+	})
+
+	var listed []api.Bid
+	testharness.Decode(t, h.Do(t, "GET", query("/api/bids/"+tender.Id+"/list", "username", f.AlphaViewer.Username), nil), &listed)
+	if len(listed) != 1 || listed[0].Name != "" || !listed[0].Sealed || listed[0].Commitment == nil || *listed[0].Commitment != *bid.Commitment {
+		t.Errorf("GET /api/bids/%s/list = %+v, want sealed bid with commitment", tender.Id, listed)
+	}
+	var own []api.Bid
+	testharness.Decode(t, h.Do(t, "GET", query("/api/bids/my", "username", f.Bidder.Username), nil), &own)
+	if len(own) != 1 || own[0].Name != "Предложение" {
+		t.Errorf("GET /api/bids/my = %+v, want opened bid", own)
+	}
+}
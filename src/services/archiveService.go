@@ -0,0 +1,135 @@
+package services
+
+import (
+	"github.com/google/uuid"
+	"zadanie_6105/src/models"
+)
+
+// archivePageSize - сколько предложений архива читается из хранилища за раз
+const archivePageSize = 100
+
+// TenderArchive - итог закупки: все версии тендера и опубликованных предложений,
+// голоса и отзывы по ним. Записи читаются из хранилища постранично при обходе,
+// поэтому выгрузка не держит архив в памяти целиком.
+type TenderArchive struct {
+	service  *Service
+	tender   *models.Tender
+	pageSize int
+}
+
+// GetTenderArchive проверяет тендер и возвращает его архив. Предложения отбираются как в списке
+// предложений тендера: только опубликованные, но со всеми версиями.
+func (s *Service) GetTenderArchive(tenderId string) (*TenderArchive, error) {
+	archive := &TenderArchive{service: s, pageSize: archivePageSize}
+	s, span := s.startSpan("GetTenderArchive")
+	defer span.End()
+
+	tender, err := s.getTenderLastVersion(tenderId)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.unsealIfDue(tender); err != nil {
+		return nil, err
+	}
+	archive.tender = tender
+	return archive, nil
+}
+
+// Tenders передает в fn все версии тендера
+func (a *TenderArchive) Tenders(fn func(tender *models.Tender) error) error {
+	s, span := a.service.startSpan("TenderArchive.Tenders")
+	defer span.End()
+
+	versions, err := s.tenders.ListVersions(s.ctx, a.tender.ID)
+	if err != nil {
+		return err
+	}
+	for i := range versions {
+		if err := fn(&versions[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Bids передает в fn все версии опубликованных предложений
+func (a *TenderArchive) Bids(fn func(bid *models.Bid) error) error {
+	s, span := a.service.startSpan("TenderArchive.Bids")
+	defer span.End()
+
+	return a.bidPages(s, func(bids []models.Bid, _ []uuid.UUID) error {
+		for _, bid := range bids {
+			versions, err := s.bids.ListVersions(s.ctx, bid.ID)
+			if err != nil {
+				return err
+			}
+			for i := range versions {
+				if err := fn(&versions[i]); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// Decisions передает в fn голоса по опубликованным предложениям
+func (a *TenderArchive) Decisions(fn func(decision *models.BidDecision) error) error {
+	s, span := a.service.startSpan("TenderArchive.Decisions")
+	defer span.End()
+
+	return a.bidPages(s, func(_ []models.Bid, bidIDs []uuid.UUID) error {
+		decisions, err := s.bids.ListDecisionsByBids(s.ctx, bidIDs)
+		if err != nil {
+			return err
+		}
+		for i := range decisions {
+			if err := fn(&decisions[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Feedbacks передает в fn отзывы на опубликованные предложения
+func (a *TenderArchive) Feedbacks(fn func(feedback *models.BidFeedback) error) error {
+	s, span := a.service.startSpan("TenderArchive.Feedbacks")
+	defer span.End()
+
+	return a.bidPages(s, func(_ []models.Bid, bidIDs []uuid.UUID) error {
+		feedbacks, err := s.feedbacks.ListByBids(s.ctx, bidIDs, 0, 0)
+		if err != nil {
+			return err
+		}
+		for i := range feedbacks {
+			if err := fn(&feedbacks[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// bidPages читает опубликованные предложения тендера страницами по pageSize
+func (a *TenderArchive) bidPages(s *Service, fn func(bids []models.Bid, bidIDs []uuid.UUID) error) error {
+	for offset := 0; ; offset += a.pageSize {
+		bids, err := s.bids.ListPublishedByTender(s.ctx, a.tender.ID, a.pageSize, offset)
+		if err != nil {
+			return err
+		}
+		if len(bids) == 0 {
+			return nil
+		}
+		bidIDs := make([]uuid.UUID, len(bids))
+		for i, bid := range bids {
+			bidIDs[i] = bid.ID
+		}
+		if err := fn(bids, bidIDs); err != nil {
+			return err
+		}
+		if len(bids) < a.pageSize {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,279 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"math"
+	"slices"
+	"time"
+	"zadanie_6105/src/models"
+	"zadanie_6105/src/repository"
+)
+
+// auctionServiceType - вид услуг, тендеры которого вместе с подкатегориями можно проводить аукционом
+const auctionServiceType = "Delivery"
+
+// AuctionStanding - положение предложения в аукционе, которое видит его автор:
+// своя лучшая цена и место без цен конкурентов. Rank = 0 - ставок по предложению нет.
+type AuctionStanding struct {
+	BidId        uuid.UUID
+	Price        *float64
+	Rank         int
+	Participants int
+	EndsAt       time.Time
+}
+
+// cents переводит цену в копейки, чтобы сравнивать цены без ошибок округления
+func cents(price float64) int64 {
+	return int64(math.Round(price * 100))
+}
+
+// acceptOffer проверяет ставку по настройкам аукциона и последней ставке того же предложения
+// и при ставке в последние секунды продлевает аукцион
+func acceptOffer(auction *models.TenderAuction, previous *models.AuctionOffer, offer *models.AuctionOffer) error {
+	if offer.CreatedAt.Before(auction.StartsAt) {
+		return fmt.Errorf("%w: auction has not started yet", ErrConflict)
+	}
+	if !offer.CreatedAt.Before(auction.EndsAt) {
+		return fmt.Errorf("%w: auction is over", ErrConflict)
+	}
+	if previous == nil {
+		if auction.StartPrice != nil && cents(offer.Price) > cents(*auction.StartPrice) {
+			return fmt.Errorf("%w: price is above the start price %.2f", ErrConflict, *auction.StartPrice)
+		}
+	} else if limit := cents(previous.Price) - cents(auction.MinDecrement); cents(offer.Price) > limit {
+		return fmt.Errorf("%w: price must be at most %.2f", ErrConflict, float64(limit)/100)
+	}
+
+	extension := time.Duration(auction.ExtensionSeconds) * time.Second
+	if auction.EndsAt.Sub(offer.CreatedAt) < extension {
+		auction.EndsAt = offer.CreatedAt.Add(extension)
+	}
+	return nil
+}
+
+// checkAuctionTender проверяет, что тендер можно проводить аукционом
+func (s *Service) checkAuctionTender(tender *models.Tender) error {
+	if tender.Status == "Closed" || tender.Status == "Canceled" {
+		return fmt.Errorf("%w: tender is %s", ErrConflict, tender.Status)
+	}
+	if tender.Sealed {
+		return fmt.Errorf("%w: auction is not available for sealed tenders", ErrConflict)
+	}
+	return s.checkAuctionServiceType(tender)
+}
+
+func (s *Service) checkAuctionServiceType(tender *models.Tender) error {
+	tree, err := s.loadServiceTypeTree()
+	if err != nil {
+		return err
+	}
+	if !slices.Contains(tree.expand([]string{auctionServiceType}, true), tender.ServiceType) {
+		return fmt.Errorf("%w: auction is available for %s tenders only", ErrConflict, auctionServiceType)
+	}
+	return nil
+}
+
+// checkAuctionEdit не дает перевести тендер с аукционом на вид услуг, для которого аукцион недоступен
+func (s *Service) checkAuctionEdit(current, next *models.Tender) error {
+	if next.ServiceType == current.ServiceType {
+		return nil
+	}
+	_, err := s.auctions.Get(s.ctx, current.ID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return s.checkAuctionServiceType(next)
+}
+
+// auctionRanking возвращает лучшие ставки по опубликованным в текущем раунде предложениям;
+// отозванные и отклоненные предложения из рейтинга выбывают
+func (s *Service) auctionRanking(tender *models.Tender) ([]models.AuctionOffer, error) {
+	s, span := s.startSpan("auctionRanking")
+	defer span.End()
+
+	offers, err := s.auctions.ListBestOffers(s.ctx, tender.ID)
+	if err != nil {
+		return nil, err
+	}
+	bids, err := s.bids.ListPublishedByTender(s.ctx, tender.ID, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	active := map[uuid.UUID]bool{}
+	for _, bid := range bids {
+		active[bid.ID] = bid.Round == tender.Round
+	}
+	return slices.DeleteFunc(offers, func(offer models.AuctionOffer) bool { return !active[offer.BidId] }), nil
+}
+
+func (s *Service) GetAuction(tenderId string) (*models.TenderAuction, error) {
+	s, span := s.startSpan("GetAuction")
+	defer span.End()
+
+	tender, err := s.getTenderLastVersion(tenderId)
+	if err != nil {
+		return nil, err
+	}
+	auction, err := s.auctions.Get(s.ctx, tender.ID)
+	if err != nil {
+		return nil, notFound("auction", err)
+	}
+	return auction, nil
+}
+
+// ConfigureAuction создает или меняет аукцион тендера; после первой ставки настройки не меняются
+func (s *Service) ConfigureAuction(tenderId string, auction *models.TenderAuction) (*models.TenderAuction, error) {
+	s, span := s.startSpan("ConfigureAuction")
+	defer span.End()
+
+	tender, err := s.getTenderLastVersion(tenderId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkAuctionTender(tender); err != nil {
+		return nil, err
+	}
+	offers, err := s.auctions.CountOffers(s.ctx, tender.ID)
+	if err != nil {
+		return nil, err
+	}
+	if offers > 0 {
+		return nil, fmt.Errorf("%w: auction already has offers", ErrConflict)
+	}
+
+	auction.TenderId = tender.ID
+	if existing, err := s.auctions.Get(s.ctx, tender.ID); err == nil {
+		auction.CreatedAt = existing.CreatedAt
+	} else if !errors.Is(err, repository.ErrNotFound) {
+		return nil, err
+	}
+	if err := s.auctions.Save(s.ctx, auction); err != nil {
+		return nil, err
+	}
+	return auction, nil
+}
+
+// PlaceAuctionOffer принимает ставку автора опубликованного предложения и возвращает
+// положение предложения после ставки
+func (s *Service) PlaceAuctionOffer(bidId string, price float64) (*AuctionStanding, error) {
+	s, span := s.startSpan("PlaceAuctionOffer")
+	defer span.End()
+
+	bid, err := s.getBidLastVersion(bidId)
+	if err != nil {
+		return nil, err
+	}
+	tender, err := s.getTenderLastVersion(bid.TenderId.String())
+	if err != nil {
+		return nil, err
+	}
+	if tender.Status != "Published" {
+		return nil, fmt.Errorf("%w: tender is not published", ErrConflict)
+	}
+	if bid.Status != "Published" || bid.Round != tender.Round {
+		return nil, fmt.Errorf("%w: bid is not published in the current round", ErrConflict)
+	}
+
+	offer := models.AuctionOffer{
+		TenderId:  tender.ID,
+		BidId:     bid.ID,
+		AuthorId:  bid.AuthorId,
+		Price:     price,
+		CreatedAt: time.Now(),
+	}
+	var auction models.TenderAuction
+	err = s.auctions.PlaceOffer(s.ctx, &offer, func(current *models.TenderAuction, previous *models.AuctionOffer) error {
+		if err := acceptOffer(current, previous, &offer); err != nil {
+			return err
+		}
+		auction = *current
+		return nil
+	})
+	if err != nil {
+		return nil, notFound("auction", err)
+	}
+	return s.auctionStanding(tender, &auction, bid.ID)
+}
+
+// GetAuctionStanding возвращает положение предложения в аукционе тендера
+func (s *Service) GetAuctionStanding(bidId string) (*AuctionStanding, error) {
+	s, span := s.startSpan("GetAuctionStanding")
+	defer span.End()
+
+	bid, err := s.getBidLastVersion(bidId)
+	if err != nil {
+		return nil, err
+	}
+	tender, err := s.getTenderLastVersion(bid.TenderId.String())
+	if err != nil {
+		return nil, err
+	}
+	auction, err := s.auctions.Get(s.ctx, tender.ID)
+	if err != nil {
+		return nil, notFound("auction", err)
+	}
+	return s.auctionStanding(tender, auction, bid.ID)
+}
+
+func (s *Service) auctionStanding(tender *models.Tender, auction *models.TenderAuction, bidID uuid.UUID) (*AuctionStanding, error) {
+	ranking, err := s.auctionRanking(tender)
+	if err != nil {
+		return nil, err
+	}
+	standing := &AuctionStanding{BidId: bidID, Participants: len(ranking), EndsAt: auction.EndsAt}
+	for i, offer := range ranking {
+		if offer.BidId == bidID {
+			standing.Rank = i + 1
+			standing.Price = &offer.Price
+			break
+		}
+	}
+	return standing, nil
+}
+
+// GetAuctionRanking возвращает лучшие ставки участников по возрастанию цены
+func (s *Service) GetAuctionRanking(tenderId string) ([]models.AuctionOffer, error) {
+	s, span := s.startSpan("GetAuctionRanking")
+	defer span.End()
+
+	tender, err := s.getTenderLastVersion(tenderId)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.auctions.Get(s.ctx, tender.ID); err != nil {
+		return nil, notFound("auction", err)
+	}
+	return s.auctionRanking(tender)
+}
+
+// checkAuctionResult проверяет, что предложение можно согласовать по итогам аукциона:
+// аукцион завершен и предложение в нем лидирует. Без аукциона или без ставок решение
+// принимается как обычно.
+func (s *Service) checkAuctionResult(bid *models.Bid, tender *models.Tender) error {
+	s, span := s.startSpan("checkAuctionResult")
+	defer span.End()
+
+	auction, err := s.auctions.Get(s.ctx, tender.ID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	ranking, err := s.auctionRanking(tender)
+	if err != nil || len(ranking) == 0 {
+		return err
+	}
+	if time.Now().Before(auction.EndsAt) {
+		return fmt.Errorf("%w: auction is not over yet", ErrConflict)
+	}
+	if ranking[0].BidId != bid.ID {
+		return fmt.Errorf("%w: bid is not the auction leader", ErrConflict)
+	}
+	return nil
+}
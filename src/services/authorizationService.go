@@ -0,0 +1,192 @@
+package services
+
+import (
+	"github.com/google/uuid"
+	"zadanie_6105/src/models"
+)
+
+type Permission string
+
+const (
+	PermissionTenderView        Permission = "tender:view"
+	PermissionTenderCreate      Permission = "tender:create"
+	PermissionTenderEdit        Permission = "tender:edit"
+	PermissionBidView           Permission = "bid:view"
+	PermissionBidDecide         Permission = "bid:decide"
+	PermissionBidFeedback       Permission = "bid:feedback"
+	PermissionResponsibleManage Permission = "responsible:manage"
+)
+
+// rolePermissions - матрица прав ролей ответственных
+var rolePermissions = map[string][]Permission{
+	models.RoleOrgAdmin: {
+		PermissionTenderView,
+		PermissionTenderCreate,
+		PermissionTenderEdit,
+		PermissionBidView,
+		PermissionBidDecide,
+		PermissionBidFeedback,
+		PermissionResponsibleManage,
+	},
+	models.RoleTenderAuthor: {
+		PermissionTenderView,
+		PermissionTenderCreate,
+		PermissionTenderEdit,
+		PermissionBidView,
+		PermissionBidFeedback,
+	},
+	models.RoleApprover: {
+		PermissionTenderView,
+		PermissionBidView,
+		PermissionBidDecide,
+		PermissionBidFeedback,
+	},
+	models.RoleViewer: {
+		PermissionTenderView,
+		PermissionBidView,
+	},
+}
+
+func IsValidRole(role string) bool {
+	_, ok := rolePermissions[role]
+	return ok
+}
+
+func roleHasPermission(role string, permission Permission) bool {
+	for _, p := range rolePermissions[role] {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// rolesWithPermission возвращает роли, которым выдано право
+func rolesWithPermission(permission Permission) []string {
+	var roles []string
+	for role := range rolePermissions {
+		if roleHasPermission(role, permission) {
+			roles = append(roles, role)
+		}
+	}
+	return roles
+}
+
+// Authorize проверяет право пользователя в организации.
+// Ошибка возвращается, только если пользователь не существует или запрос к БД не удался.
+func (s *Service) Authorize(username string, organizationID string, permission Permission) (bool, error) {
+	s, span := s.startSpan("Authorize")
+	defer span.End()
+
+	employee, err := s.getEmployeeByUsername(username)
+	if err != nil {
+		return false, err
+	}
+	return s.authorizeEmployee(employee, organizationID, permission)
+}
+
+func (s *Service) authorizeEmployee(employee *models.Employee, organizationID string, permission Permission) (bool, error) {
+	s, span := s.startSpan("authorizeEmployee")
+	defer span.End()
+
+	// Администратор сервиса управляет ответственными любой организации
+	if employee.IsAdmin && permission == PermissionResponsibleManage {
+		return true, nil
+	}
+
+	// Организация с идентификатором не в формате UUID не может иметь ответственных
+	orgID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return false, nil
+	}
+	responsibilities, err := s.employees.ListResponsibilities(s.ctx, employee.ID)
+	if err != nil {
+		return false, err
+	}
+
+	for _, responsible := range responsibilities {
+		if responsible.OrganizationID == orgID && roleHasPermission(responsible.Role, permission) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// AuthorizeTender сначала проверяет пользователя, затем тендер,
+// чтобы неизвестный пользователь получал 401 раньше, чем 404
+func (s *Service) AuthorizeTender(username string, tenderID string, permission Permission) (bool, error) {
+	s, span := s.startSpan("AuthorizeTender")
+	defer span.End()
+
+	employee, err := s.getEmployeeByUsername(username)
+	if err != nil {
+		return false, err
+	}
+	tender, err := s.getTenderLastVersion(tenderID)
+	if err != nil {
+		return false, err
+	}
+
+	return s.authorizeEmployee(employee, tender.OrganizationId, permission)
+}
+
+func (s *Service) AuthorizeTenderByBidID(username string, bidID string, permission Permission) (bool, error) {
+	s, span := s.startSpan("AuthorizeTenderByBidID")
+	defer span.End()
+
+	employee, err := s.getEmployeeByUsername(username)
+	if err != nil {
+		return false, err
+	}
+	bid, err := s.getBidLastVersion(bidID)
+	if err != nil {
+		return false, err
+	}
+	tender, err := s.getTenderLastVersion(bid.TenderId.String())
+	if err != nil {
+		return false, err
+	}
+
+	return s.authorizeEmployee(employee, tender.OrganizationId, permission)
+}
+
+// AuthorizeBidAuthor проверяет, что пользователь - автор предложения
+func (s *Service) AuthorizeBidAuthor(username string, bidID string) (bool, error) {
+	s, span := s.startSpan("AuthorizeBidAuthor")
+	defer span.End()
+
+	user, err := s.getEmployeeByUsername(username)
+	if err != nil {
+		return false, err
+	}
+
+	bid, err := s.getBidLastVersion(bidID)
+	if err != nil {
+		return false, err
+	}
+
+	return bid.AuthorId == user.ID, nil
+}
+
+// AuthorizeBidViewer пропускает автора предложения и ответственных тендера с правом просмотра предложений
+func (s *Service) AuthorizeBidViewer(username string, bidID string) (bool, error) {
+	s, span := s.startSpan("AuthorizeBidViewer")
+	defer span.End()
+
+	isAuthor, err := s.AuthorizeBidAuthor(username, bidID)
+	if err != nil || isAuthor {
+		return isAuthor, err
+	}
+	return s.AuthorizeTenderByBidID(username, bidID, PermissionBidView)
+}
+
+func (s *Service) AuthorizeAdmin(username string) (bool, error) {
+	s, span := s.startSpan("AuthorizeAdmin")
+	defer span.End()
+
+	employee, err := s.getEmployeeByUsername(username)
+	if err != nil {
+		return false, err
+	}
+	return employee.IsAdmin, nil
+}
@@ -4,58 +4,47 @@ import (
 	"errors"
 	"fmt"
 	"github.com/google/uuid"
-	"gorm.io/gorm"
+	"time"
+	"zadanie_6105/src/mailer"
+	"zadanie_6105/src/metrics"
 	"zadanie_6105/src/models"
+	"zadanie_6105/src/repository"
 )
 
-func (s *Service) CheckIfUserIsResponsibleForTenderByBidID(creatorUsername string, bidId string) (bool, error) {
-	bid, err := s.getBidLastVersion(bidId)
-	if err != nil {
-		return false, err
-	}
-	tenderId := bid.TenderId.String()
-
-	return s.CheckIfUserIsResponsibleForTender(creatorUsername, tenderId)
-}
-
 func (s *Service) getEmployeeByUsername(username string) (*models.Employee, error) {
-	var employee models.Employee
-
-	err := s.db.Where("username = ?", username).
-		First(&employee).Error
+	s, span := s.startSpan("getEmployeeByUsername")
+	defer span.End()
 
-	return &employee, err
-}
-
-func (s *Service) CheckIfUserIsResponsibleForBid(username string, bidId string) (bool, error) {
-	bid, err := s.getBidLastVersion(bidId)
-	if err != nil {
-		return false, err
+	employee, err := s.employees.GetByUsername(s.ctx, username)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, ErrUserNotFound
 	}
-
-	user, err := s.getEmployeeByUsername(username)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
-
-	return bid.AuthorId == user.ID, nil
+	return employee, nil
 }
 
 func (s *Service) getEmployee(id string) (*models.Employee, error) {
-	var employee models.Employee
+	s, span := s.startSpan("getEmployee")
+	defer span.End()
 
 	employeeID, err := uuid.Parse(id)
 	if err != nil {
-		return nil, errors.New("invalid employee ID format")
+		return nil, invalidID("employee")
 	}
 
-	err = s.db.Where("id = ?", employeeID).
-		First(&employee).Error
-
-	return &employee, err
+	employee, err := s.employees.Get(s.ctx, employeeID)
+	if err != nil {
+		return nil, notFound("employee", err)
+	}
+	return employee, nil
 }
 
 func (s *Service) CheckEmployeeExistence(id string) (bool, error) {
+	s, span := s.startSpan("CheckEmployeeExistence")
+	defer span.End()
+
 	employee, err := s.getEmployee(id)
 	if err != nil {
 		return false, err
@@ -64,93 +53,135 @@ func (s *Service) CheckEmployeeExistence(id string) (bool, error) {
 }
 
 func (s *Service) CheckIfBidByUserExist(username string, tenderID string) (bool, error) {
-	var bid models.Bid
-
-	result := s.db.Joins("JOIN employees ON employees.id = bids.author_id").
-		Where("employees.username = ? AND bids.tender_id = ? AND bids.status = ?", username, tenderID, "Published").
-		First(&bid)
+	s, span := s.startSpan("CheckIfBidByUserExist")
+	defer span.End()
 
-	if result.Error != nil {
-		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
-			return false, nil
-		}
-		return false, result.Error
+	employee, err := s.getEmployeeByUsername(username)
+	if errors.Is(err, ErrUserNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	id, err := uuid.Parse(tenderID)
+	if err != nil {
+		return false, nil
 	}
 
-	return true, nil
+	return s.bids.ExistsByAuthor(s.ctx, employee.ID, id)
 }
 
+// CreateBid создает предложение в текущем раунде тендера
 func (s *Service) CreateBid(bid *models.Bid) error {
-	if err := s.db.Create(bid).Error; err != nil {
+	s, span := s.startSpan("CreateBid")
+	defer span.End()
+
+	tender, err := s.getTenderLastVersion(bid.TenderId.String())
+	if err != nil {
 		return err
 	}
+	bid.Round = tender.Round
+	if err := s.createBidVersion(tender, bid); err != nil {
+		return err
+	}
+	metrics.BidsCreated.Inc()
 	return nil
 }
 
 func (s *Service) GetBidsByUser(username string, limit, offset int) (*[]models.Bid, error) {
-	var bids []models.Bid
+	s, span := s.startSpan("GetBidsByUser")
+	defer span.End()
 
-	subQuery := s.db.Table("bids as b1").
-		Select("MAX(version)").
-		Where("b1.id = bids.id")
-	query := s.db.Joins("JOIN employee ON employee.id = bids.author_id").
-		Where("employee.username = ?", username).
-		Where("version = (?)", subQuery)
+	employee, err := s.getEmployeeByUsername(username)
+	if err != nil {
+		return nil, err
+	}
 
-	if limit > 0 {
-		query = query.Limit(limit)
+	bids, err := s.bids.ListByAuthor(s.ctx, employee.ID, limit, offset)
+	if err != nil {
+		return nil, err
 	}
-	if offset > 0 {
-		query = query.Offset(offset)
+	if err := s.openBids(bids); err != nil {
+		return nil, err
 	}
-
-	err := query.Find(&bids).Error
-
-	return &bids, err
+	return &bids, nil
 }
 
 // TODO: figure it out
 
+// GetBidsByTender возвращает опубликованные предложения тендера. Запечатанные до срока
+// предложения отдаются без содержимого, после срока тендер вскрывается при первом запросе.
 func (s *Service) GetBidsByTender(tenderId string, limit, offset int) (*[]models.Bid, error) {
+	s, span := s.startSpan("GetBidsByTender")
+	defer span.End()
 
-	var bids []models.Bid
-
-	subQuery := s.db.Table("bids as b1").
-		Select("MAX(b1.version)").
-		Where("b1.id = bids.id").
-		Where("b1.status = ?", "Published")
-	query := s.db.Where("version = (?)", subQuery).
-		Where("status = ?", "Published").
-		Where("tender_id = ?", tenderId)
-
-	if limit > 0 {
-		query = query.Limit(limit)
+	tender, err := s.getTenderLastVersion(tenderId)
+	if err != nil {
+		return nil, err
 	}
-	if offset > 0 {
-		query = query.Offset(offset)
+	if _, err := s.unsealIfDue(tender); err != nil {
+		return nil, err
 	}
 
-	err := query.Find(&bids).Error
-
+	bids, err := s.bids.ListPublishedByTender(s.ctx, tender.ID, limit, offset)
 	return &bids, err
 }
 
 func (s *Service) getBidLastVersion(id string) (*models.Bid, error) {
-	var bid models.Bid
+	s, span := s.startSpan("getBidLastVersion")
+	defer span.End()
 
 	bidID, err := uuid.Parse(id)
 	if err != nil {
-		return nil, errors.New("invalid bid ID format")
+		return nil, invalidID("bid")
 	}
 
-	err = s.db.Where("id = ?", bidID).
-		Order("version DESC").
-		First(&bid).Error
+	bid, err := s.bids.GetLast(s.ctx, bidID)
+	if err != nil {
+		return nil, notFound("bid", err)
+	}
+	return bid, nil
+}
+
+func (s *Service) GetBid(id string) (*models.Bid, error) {
+	s, span := s.startSpan("GetBid")
+	defer span.End()
 
-	return &bid, err
+	return s.getBidLastVersion(id)
+}
+
+// GetBidVersions возвращает все версии предложения по возрастанию номера
+func (s *Service) GetBidVersions(id string) (*[]models.Bid, error) {
+	s, span := s.startSpan("GetBidVersions")
+	defer span.End()
+
+	bid, err := s.getBidLastVersion(id)
+	if err != nil {
+		return nil, err
+	}
+
+	bids, err := s.bids.ListVersions(s.ctx, bid.ID)
+	return &bids, err
+}
+
+// ListBids возвращает последние версии предложений тендера в любом статусе
+func (s *Service) ListBids(tenderId string, limit, offset int) (*[]models.Bid, error) {
+	s, span := s.startSpan("ListBids")
+	defer span.End()
+
+	tenderID, err := uuid.Parse(tenderId)
+	if err != nil {
+		return nil, invalidID("tender")
+	}
+
+	bids, err := s.bids.ListByTender(s.ctx, tenderID, limit, offset)
+	return &bids, err
 }
 
 func (s *Service) GetBidStatus(id string) (string, error) {
+	s, span := s.startSpan("GetBidStatus")
+	defer span.End()
+
 	bid, err := s.getBidLastVersion(id)
 	if err != nil {
 		return "", err
@@ -159,22 +190,48 @@ func (s *Service) GetBidStatus(id string) (string, error) {
 }
 
 func (s *Service) UpdateBidStatus(id string, status string) (*models.Bid, error) {
+	s, span := s.startSpan("UpdateBidStatus")
+	defer span.End()
+
 	bid, err := s.getBidLastVersion(id)
 	if err != nil {
 		return nil, err
 	}
+	wasPublished := bid.Status == "Published"
 	bid.Status = status
-	if err := s.db.Save(bid).Error; err != nil {
+	if err := s.bids.Save(s.ctx, bid); err != nil {
+		return nil, err
+	}
+
+	if status == "Published" && !wasPublished {
+		tender, err := s.getTenderLastVersion(bid.TenderId.String())
+		if err == nil {
+			s.notifyTenderResponsibles(tender, bid, mailer.TemplateBidPublished)
+		}
+	}
+	// Статус меняет автор, ему предложение возвращается открытым
+	if err := s.openBid(bid); err != nil {
 		return nil, err
 	}
 	return bid, nil
 }
 
+// UpdateBid создает новую версию предложения; она относится к текущему раунду тендера
 func (s *Service) UpdateBid(id string, edit *models.BidEdit) (*models.Bid, error) {
+	s, span := s.startSpan("UpdateBid")
+	defer span.End()
+
 	bid, err := s.getBidLastVersion(id)
 	if err != nil {
 		return nil, err
 	}
+	tender, err := s.getTenderLastVersion(bid.TenderId.String())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.openBid(bid); err != nil {
+		return nil, err
+	}
 
 	newBid := models.Bid{
 		ID:          bid.ID,
@@ -184,6 +241,7 @@ func (s *Service) UpdateBid(id string, edit *models.BidEdit) (*models.Bid, error
 		TenderId:    bid.TenderId,
 		AuthorType:  bid.AuthorType,
 		AuthorId:    bid.AuthorId,
+		Round:       tender.Round,
 		Version:     bid.Version + 1,
 	}
 	if edit.Name != "" {
@@ -193,7 +251,7 @@ func (s *Service) UpdateBid(id string, edit *models.BidEdit) (*models.Bid, error
 		newBid.Description = edit.Description
 	}
 
-	if err := s.db.Create(&newBid).Error; err != nil {
+	if err := s.createBidVersion(tender, &newBid); err != nil {
 		return nil, err
 	}
 
@@ -202,40 +260,175 @@ func (s *Service) UpdateBid(id string, edit *models.BidEdit) (*models.Bid, error
 
 // TODO: figure it out
 
-func (s *Service) SubmitBid(bidId string, decision bool) (*models.Bid, error) {
+func (s *Service) SubmitBid(bidId string, username string, decision bool) (*models.Bid, error) {
+	s, span := s.startSpan("SubmitBid")
+	defer span.End()
+
 	bid, err := s.getBidLastVersion(bidId)
 	if err != nil {
 		return nil, err
 	}
-	tenderId := bid.TenderId.String()
+	employee, err := s.getEmployeeByUsername(username)
+	if err != nil {
+		return nil, err
+	}
+	tender, err := s.getTenderLastVersion(bid.TenderId.String())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkBidUnsealed(bid, tender); err != nil {
+		return nil, err
+	}
+	if err := checkFinalRound(bid, tender); err != nil {
+		return nil, err
+	}
+	// Отклонить можно любое предложение, согласовать - только лидера завершенного аукциона
 	if decision {
-		tender, err := s.getTenderLastVersion(tenderId)
-		if err != nil {
-			return nil, err
-		}
-		tender.Status = "Closed"
-		if err := s.db.Save(tender).Error; err != nil {
+		if err := s.checkAuctionResult(bid, tender); err != nil {
 			return nil, err
 		}
+	}
+
+	if err := s.saveBidDecision(bid.ID, employee.ID, decision); err != nil {
+		return nil, err
+	}
+	if decision {
+		metrics.BidDecisions.WithLabelValues("Approved").Inc()
 	} else {
-		bid.Status = "Cancelled"
-		if err := s.db.Save(bid).Error; err != nil {
+		metrics.BidDecisions.WithLabelValues("Rejected").Inc()
+	}
+
+	if !decision {
+		bid.Status = "Canceled"
+		if err := s.bids.Save(s.ctx, bid); err != nil {
 			return nil, err
 		}
+		return bid, nil
+	}
+
+	if _, err := s.closeTenderIfApproved(bid); err != nil {
+		return nil, err
 	}
 	return bid, nil
 }
 
+// EvaluateBidQuorum заново проверяет кворум по уже поданным голосам и закрывает тендер,
+// если предложение одобрено
+func (s *Service) EvaluateBidQuorum(bidId string) (bool, error) {
+	s, span := s.startSpan("EvaluateBidQuorum")
+	defer span.End()
+
+	bid, err := s.getBidLastVersion(bidId)
+	if err != nil {
+		return false, err
+	}
+	return s.closeTenderIfApproved(bid)
+}
+
+func (s *Service) closeTenderIfApproved(bid *models.Bid) (bool, error) {
+	s, span := s.startSpan("closeTenderIfApproved")
+	defer span.End()
+
+	tender, err := s.getTenderLastVersion(bid.TenderId.String())
+	if err != nil {
+		return false, err
+	}
+	if checkFinalRound(bid, tender) != nil {
+		return false, nil
+	}
+	if err := s.checkAuctionResult(bid, tender); err != nil {
+		if errors.Is(err, ErrConflict) {
+			return false, nil
+		}
+		return false, err
+	}
+	isApproved, err := s.checkBidQuorum(bid, tender)
+	if err != nil || !isApproved {
+		return false, err
+	}
+	if tender.Status == "Closed" {
+		return true, nil
+	}
+
+	tender.Status = "Closed"
+	if err := s.tenders.Save(s.ctx, tender); err != nil {
+		return false, err
+	}
+	s.invalidateTenderPages()
+	metrics.TendersClosed.Inc()
+	// Тендер уже закрыт; если протокол не сформировался, его соберет первый запрос
+	if _, err := s.saveProtocol(tender, bid, time.Now()); err != nil {
+		s.logger.Error("Failed to generate tender protocol", "tender", tender.ID, "error", err)
+	}
+	s.notifyTenderResponsibles(tender, bid, mailer.TemplateBidApproved)
+	return true, nil
+}
+
+func (s *Service) saveBidDecision(bidID uuid.UUID, employeeID uuid.UUID, decision bool) error {
+	s, span := s.startSpan("saveBidDecision")
+	defer span.End()
+
+	bidDecision := models.BidDecision{
+		BidId:      bidID,
+		EmployeeId: employeeID,
+		Decision:   "Rejected",
+	}
+	if decision {
+		bidDecision.Decision = "Approved"
+	}
+
+	return s.bids.SaveDecision(s.ctx, &bidDecision)
+}
+
+// checkBidQuorum считает голоса только тех ответственных, чья роль дает право согласования.
+// Кворум = min(3, количество таких ответственных), любой отказ отклоняет предложение.
+func (s *Service) checkBidQuorum(bid *models.Bid, tender *models.Tender) (bool, error) {
+	s, span := s.startSpan("checkBidQuorum")
+	defer span.End()
+
+	approverIDs, err := s.employees.ListResponsibleIDs(s.ctx, tender.OrganizationId, rolesWithPermission(PermissionBidDecide))
+	if err != nil {
+		return false, err
+	}
+	if len(approverIDs) == 0 {
+		return false, nil
+	}
+
+	decisions, err := s.bids.ListDecisions(s.ctx, bid.ID, approverIDs)
+	if err != nil {
+		return false, err
+	}
+
+	approvals := 0
+	for _, decision := range decisions {
+		if decision.Decision == "Rejected" {
+			return false, nil
+		}
+		approvals++
+	}
+
+	quorum := min(3, len(approverIDs))
+	return approvals >= quorum, nil
+}
+
 func (s *Service) RollbackBid(id string, version int32) (*models.Bid, error) {
-	var bid models.Bid
+	s, span := s.startSpan("RollbackBid")
+	defer span.End()
 
-	err := s.db.Where("id = ? AND version = ?", id, version).First(&bid).Error
+	lastBid, err := s.getBidLastVersion(id)
+	if err != nil {
+		return nil, err
+	}
+	tender, err := s.getTenderLastVersion(lastBid.TenderId.String())
 	if err != nil {
 		return nil, err
 	}
 
-	lastBid, err := s.getBidLastVersion(id)
+	bid, err := s.bids.GetVersion(s.ctx, lastBid.ID, version)
 	if err != nil {
+		return nil, notFound("bid version", err)
+	}
+	if err := s.openBid(bid); err != nil {
 		return nil, err
 	}
 
@@ -247,50 +440,42 @@ func (s *Service) RollbackBid(id string, version int32) (*models.Bid, error) {
 		TenderId:    bid.TenderId,
 		AuthorType:  bid.AuthorType,
 		AuthorId:    bid.AuthorId,
+		Round:       tender.Round,
 		Version:     lastBid.Version + 1,
 	}
 
-	if err := s.db.Create(&newBid).Error; err != nil {
+	if err := s.createBidVersion(tender, &newBid); err != nil {
 		return nil, err
 	}
 	return &newBid, nil
 }
 
 func (s *Service) CreateFeedback(feedback *models.BidFeedback) (*models.Bid, error) {
-	if err := s.db.Create(feedback).Error; err != nil {
+	s, span := s.startSpan("CreateFeedback")
+	defer span.End()
+
+	if err := s.feedbacks.Create(s.ctx, feedback); err != nil {
 		return nil, err
 	}
 	return s.getBidLastVersion(feedback.BidId.String())
 }
 
 func (s *Service) GetFeedbacks(username string, limit, offset int) (*[]models.BidFeedback, error) {
-	var employee models.Employee
+	s, span := s.startSpan("GetFeedbacks")
+	defer span.End()
 
-	if err := s.db.Where("username = ?", username).First(&employee).Error; err != nil {
-		return nil, fmt.Errorf("employee not found: %w", err)
+	employee, err := s.getEmployeeByUsername(username)
+	if err != nil {
+		return nil, err
 	}
 
-	var bids []models.Bid
-	if err := s.db.Where("author_id = ?", employee.ID).Find(&bids).Error; err != nil {
+	bidIDs, err := s.bids.ListIDsByAuthor(s.ctx, employee.ID)
+	if err != nil {
 		return nil, fmt.Errorf("bids not found: %w", err)
 	}
 
-	var bidIDs []uuid.UUID
-	for _, bid := range bids {
-		bidIDs = append(bidIDs, bid.ID)
-	}
-
-	query := s.db.Where("bid_id IN (?)", bidIDs)
-
-	if limit > 0 {
-		query = query.Limit(limit)
-	}
-	if offset > 0 {
-		query = query.Offset(offset)
-	}
-
-	var feedbacks []models.BidFeedback
-	if err := query.Find(&feedbacks).Error; err != nil {
+	feedbacks, err := s.feedbacks.ListByBids(s.ctx, bidIDs, limit, offset)
+	if err != nil {
 		return nil, fmt.Errorf("feedbacks not found: %w", err)
 	}
 
@@ -0,0 +1,548 @@
+package services
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"testing"
+	"time"
+	"zadanie_6105/src/models"
+	"zadanie_6105/src/repository"
+)
+
+func TestBidQuorum(t *testing.T) {
+	tests := []struct {
+		name      string
+		approvers int
+		approvals int
+		reject    bool
+		closed    bool
+	}{
+		{"single approver", 1, 1, false, true},
+		{"two of two", 2, 2, false, true},
+		{"one of two", 2, 1, false, false},
+		{"three of five", 5, 3, false, true},
+		{"two of five", 5, 2, false, false},
+		{"rejected", 3, 2, true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newTestEnv(t)
+			approvers := make([]*models.Employee, tt.approvers)
+			for i := range approvers {
+				approvers[i] = e.employee("approver"+string(rune('a'+i)), models.RoleApprover)
+			}
+			// Голоса ответственных без права согласования не учитываются
+			viewer := e.employee("viewer", models.RoleViewer)
+			author := e.employee("author", "")
+			tender := e.tender("Доставка", "Published")
+			bid := e.bid(tender, author, "Published")
+
+			if _, err := e.service.SubmitBid(bid.ID.String(), viewer.Username, true); err != nil {
+				t.Fatal(err)
+			}
+			for i := 0; i < tt.approvals; i++ {
+				if _, err := e.service.SubmitBid(bid.ID.String(), approvers[i].Username, true); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if tt.reject {
+				if _, err := e.service.SubmitBid(bid.ID.String(), approvers[tt.approvers-1].Username, false); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			closed, err := e.service.EvaluateBidQuorum(bid.ID.String())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if closed != tt.closed {
+				t.Errorf("EvaluateBidQuorum = %v, want %v", closed, tt.closed)
+			}
+			status, err := e.service.GetTenderStatus(tender.ID.String())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := map[bool]string{true: "Closed", false: "Published"}[tt.closed]; status != want {
+				t.Errorf("tender status = %q, want %q", status, want)
+			}
+		})
+	}
+}
+
+func TestSubmitBidRejectCancelsBid(t *testing.T) {
+	e := newTestEnv(t)
+	approver := e.employee("approver", models.RoleApprover)
+	author := e.employee("author", "")
+	tender := e.tender("Доставка", "Published")
+	bid := e.bid(tender, author, "Published")
+
+	rejected, err := e.service.SubmitBid(bid.ID.String(), approver.Username, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rejected.Status != "Canceled" {
+		t.Errorf("rejected bid status = %q, want Canceled", rejected.Status)
+	}
+}
+
+// Повторный голос сотрудника заменяет прежний
+func TestSubmitBidRevote(t *testing.T) {
+	e := newTestEnv(t)
+	first := e.employee("first", models.RoleApprover)
+	second := e.employee("second", models.RoleApprover)
+	author := e.employee("author", "")
+	tender := e.tender("Доставка", "Published")
+	bid := e.bid(tender, author, "Published")
+
+	for _, username := range []string{first.Username, first.Username} {
+		if _, err := e.service.SubmitBid(bid.ID.String(), username, true); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if closed, _ := e.service.EvaluateBidQuorum(bid.ID.String()); closed {
+		t.Fatal("two votes of one approver reached a quorum of two")
+	}
+	if _, err := e.service.SubmitBid(bid.ID.String(), second.Username, true); err != nil {
+		t.Fatal(err)
+	}
+	if closed, _ := e.service.EvaluateBidQuorum(bid.ID.String()); !closed {
+		t.Error("quorum of two is not reached")
+	}
+}
+
+func TestBidVisibility(t *testing.T) {
+	e := newTestEnv(t)
+	viewer := e.employee("viewer", models.RoleViewer)
+	author := e.employee("author", "")
+	other := e.employee("other", "")
+	tender := e.tender("Доставка", "Published")
+	draft := e.bid(tender, author, "")
+	published := e.bid(tender, other, "Published")
+
+	bids, err := e.service.GetBidsByTender(tender.ID.String(), 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*bids) != 1 || (*bids)[0].ID != published.ID {
+		t.Errorf("GetBidsByTender returned %d bids, want only the published one", len(*bids))
+	}
+
+	tests := []struct {
+		username string
+		bid      *models.Bid
+		want     bool
+	}{
+		{author.Username, draft, true},
+		{viewer.Username, draft, true},
+		{other.Username, draft, false},
+		{author.Username, published, false},
+	}
+	for _, tt := range tests {
+		allowed, err := e.service.AuthorizeBidViewer(tt.username, tt.bid.ID.String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if allowed != tt.want {
+			t.Errorf("AuthorizeBidViewer(%s, %s) = %v, want %v", tt.username, tt.bid.Name, allowed, tt.want)
+		}
+	}
+}
+
+func TestBidFeedbacks(t *testing.T) {
+	e := newTestEnv(t)
+	author := e.employee("author", "")
+	tender := e.tender("Доставка", "Published")
+	bid := e.bid(tender, author, "Published")
+	if _, err := e.service.UpdateBid(bid.ID.String(), &models.BidEdit{Name: "Предложение 2"}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, text := range []string{"Первый", "Второй"} {
+		feedback := models.BidFeedback{Description: text, BidId: bid.ID}
+		if _, err := e.service.CreateFeedback(&feedback); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	feedbacks, err := e.service.GetFeedbacks(author.Username, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*feedbacks) != 2 {
+		t.Fatalf("GetFeedbacks returned %d feedbacks, want 2", len(*feedbacks))
+	}
+	if (*feedbacks)[0].CreatedAt.Before((*feedbacks)[1].CreatedAt) {
+		t.Error("feedbacks are not ordered newest first")
+	}
+
+	hasBid, err := e.service.CheckIfBidByUserExist(author.Username, tender.ID.String())
+	if err != nil || !hasBid {
+		t.Errorf("CheckIfBidByUserExist = %v, %v, want true", hasBid, err)
+	}
+	hasBid, err = e.service.CheckIfBidByUserExist("nobody", tender.ID.String())
+	if err != nil || hasBid {
+		t.Errorf("CheckIfBidByUserExist(nobody) = %v, %v, want false", hasBid, err)
+	}
+}
+
+// Протокол формируется при закрытии тендера, а для закрытых раньше - при первом запросе
+func TestTenderProtocol(t *testing.T) {
+	e := newTestEnv(t)
+	approver := e.employee("approver", models.RoleApprover)
+	author := e.employee("author", "")
+	tender := e.tender("Доставка", "Published")
+	bid := e.bid(tender, author, "Published")
+
+	if _, err := e.service.GetTenderProtocol(tender.ID.String()); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("GetTenderProtocol before close error = %v, want ErrNotFound", err)
+	}
+
+	if _, err := e.service.SubmitBid(bid.ID.String(), approver.Username, true); err != nil {
+		t.Fatal(err)
+	}
+	stored, err := e.service.GetTenderProtocol(tender.ID.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.BidID != bid.ID || !bytes.HasPrefix(stored.Content, []byte("%PDF-")) {
+		t.Errorf("protocol for bid %s starts with %q", stored.BidID, stored.Content[:min(8, len(stored.Content))])
+	}
+
+	// Тендер закрыт до появления протоколов
+	e.service.protocols = repository.NewMemory().Protocols
+	generated, err := e.service.GetTenderProtocol(tender.ID.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if generated.BidID != bid.ID || len(generated.Content) == 0 {
+		t.Errorf("generated protocol for bid %s has %d bytes", generated.BidID, len(generated.Content))
+	}
+}
+
+// Решение принимается только в последнем раунде, а подать в него предложение может только автор из шорт-листа
+func TestMultiRoundTender(t *testing.T) {
+	e := newTestEnv(t)
+	approver := e.employee("approver", models.RoleApprover)
+	finalist := e.employee("finalist", "")
+	other := e.employee("other", "")
+	tender := e.tender("Стройка", "Published")
+	rounds := int32(2)
+	if _, err := e.service.UpdateTender(tender.ID.String(), &models.TenderEdit{Rounds: &rounds}); err != nil {
+		t.Fatal(err)
+	}
+	finalistBid := e.bid(tender, finalist, "Published")
+	otherBid := e.bid(tender, other, "Published")
+
+	if _, err := e.service.SubmitBid(finalistBid.ID.String(), approver.Username, true); !errors.Is(err, ErrConflict) {
+		t.Errorf("SubmitBid(first round) error = %v, want ErrConflict", err)
+	}
+	if _, err := e.service.AdvanceTenderRound(tender.ID.String()); !errors.Is(err, ErrConflict) {
+		t.Errorf("AdvanceTenderRound(empty shortlist) error = %v, want ErrConflict", err)
+	}
+	if _, err := e.service.ShortlistBid(tender.ID.String(), finalistBid.ID.String(), approver.Username); err != nil {
+		t.Fatalf("ShortlistBid: %v", err)
+	}
+	advanced, err := e.service.AdvanceTenderRound(tender.ID.String())
+	if err != nil {
+		t.Fatalf("AdvanceTenderRound: %v", err)
+	}
+	if advanced.Round != 2 {
+		t.Errorf("round after advance = %d, want 2", advanced.Round)
+	}
+
+	if ok, err := e.service.AuthorizeRoundBidder(tender.ID.String(), finalist.ID); err != nil || !ok {
+		t.Errorf("AuthorizeRoundBidder(finalist) = %v, %v", ok, err)
+	}
+	if ok, err := e.service.AuthorizeBidRound(otherBid.ID.String()); err != nil || ok {
+		t.Errorf("AuthorizeBidRound(not shortlisted) = %v, %v", ok, err)
+	}
+	one := int32(1)
+	if _, err := e.service.UpdateTender(tender.ID.String(), &models.TenderEdit{Rounds: &one}); !errors.Is(err, ErrConflict) {
+		t.Errorf("UpdateTender(rounds below current) error = %v, want ErrConflict", err)
+	}
+	// Версия первого раунда в последнем раунде не рассматривается
+	if _, err := e.service.SubmitBid(finalistBid.ID.String(), approver.Username, true); !errors.Is(err, ErrConflict) {
+		t.Errorf("SubmitBid(first round version) error = %v, want ErrConflict", err)
+	}
+
+	final, err := e.service.UpdateBid(finalistBid.ID.String(), &models.BidEdit{Description: "Лучшее и окончательное"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if final.Round != 2 {
+		t.Errorf("edited bid round = %d, want 2", final.Round)
+	}
+	for round, want := range map[int32]int{1: 2, 2: 1} {
+		bids, err := e.service.GetRoundBids(tender.ID.String(), round)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(bids) != want {
+			t.Errorf("GetRoundBids(%d) = %d bids, want %d", round, len(bids), want)
+		}
+	}
+
+	if _, err := e.service.SubmitBid(finalistBid.ID.String(), approver.Username, true); err != nil {
+		t.Fatalf("SubmitBid(final round): %v", err)
+	}
+	if status, _ := e.service.GetTenderStatus(tender.ID.String()); status != "Closed" {
+		t.Errorf("tender status = %q, want Closed", status)
+	}
+}
+
+func TestReverseAuction(t *testing.T) {
+	e := newTestEnv(t)
+	approver := e.employee("approver", models.RoleApprover)
+	first := e.employee("first", "")
+	second := e.employee("second", "")
+	tender := e.tender("Перевозка", "Published")
+	firstBid := e.bid(tender, first, "Published")
+	secondBid := e.bid(tender, second, "Published")
+
+	construction := models.Tender{
+		Name: "Стройка", Description: "Описание", ServiceType: "Construction", OrganizationId: e.organization.String(),
+	}
+	if err := e.service.CreateTender(&construction); err != nil {
+		t.Fatal(err)
+	}
+	startPrice := 1000.0
+	config := func() *models.TenderAuction {
+		return &models.TenderAuction{
+			StartPrice:   &startPrice,
+			MinDecrement: 10,
+			StartsAt:     time.Now().Add(-time.Minute),
+			EndsAt:       time.Now().Add(time.Hour),
+		}
+	}
+	if _, err := e.service.ConfigureAuction(construction.ID.String(), config()); !errors.Is(err, ErrConflict) {
+		t.Errorf("ConfigureAuction(Construction) error = %v, want ErrConflict", err)
+	}
+	if _, err := e.service.ConfigureAuction(tender.ID.String(), config()); err != nil {
+		t.Fatalf("ConfigureAuction: %v", err)
+	}
+	if _, err := e.service.UpdateTender(tender.ID.String(), &models.TenderEdit{ServiceType: "Construction"}); !errors.Is(err, ErrConflict) {
+		t.Errorf("UpdateTender(Construction with auction) error = %v, want ErrConflict", err)
+	}
+	// Откат к версии с другим видом услуг проверяется так же
+	moved, err := e.service.UpdateTender(construction.ID.String(), &models.TenderEdit{ServiceType: "Delivery"})
+	if err != nil {
+		t.Fatalf("UpdateTender(without auction): %v", err)
+	}
+	if _, err := e.service.ConfigureAuction(moved.ID.String(), config()); err != nil {
+		t.Fatalf("ConfigureAuction: %v", err)
+	}
+	if _, err := e.service.RollbackTender(moved.ID.String(), construction.Version); !errors.Is(err, ErrConflict) {
+		t.Errorf("RollbackTender(Construction with auction) error = %v, want ErrConflict", err)
+	}
+
+	offer := func(bid *models.Bid, price float64) (*AuctionStanding, error) {
+		t.Helper()
+		return e.service.PlaceAuctionOffer(bid.ID.String(), price)
+	}
+	if _, err := offer(firstBid, 1000.01); !errors.Is(err, ErrConflict) {
+		t.Errorf("offer above start price error = %v, want ErrConflict", err)
+	}
+	if _, err := offer(firstBid, 900); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := offer(firstBid, 890.01); !errors.Is(err, ErrConflict) {
+		t.Errorf("offer below min decrement error = %v, want ErrConflict", err)
+	}
+	if _, err := offer(secondBid, 880); err != nil {
+		t.Fatal(err)
+	}
+	// Автор видит свою цену и место, но не цены конкурентов
+	standing, err := e.service.GetAuctionStanding(firstBid.ID.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if standing.Rank != 2 || standing.Participants != 2 || standing.Price == nil || *standing.Price != 900 {
+		t.Errorf("standing = rank %d of %d, price %v; want rank 2 of 2, price 900", standing.Rank, standing.Participants, standing.Price)
+	}
+	if _, err := e.service.ConfigureAuction(tender.ID.String(), config()); !errors.Is(err, ErrConflict) {
+		t.Errorf("ConfigureAuction(with offers) error = %v, want ErrConflict", err)
+	}
+	if _, err := e.service.SubmitBid(secondBid.ID.String(), approver.Username, true); !errors.Is(err, ErrConflict) {
+		t.Errorf("SubmitBid(auction running) error = %v, want ErrConflict", err)
+	}
+
+	// Ставка в последние секунды продлевает аукцион
+	auction, err := e.service.auctions.Get(e.service.ctx, tender.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	auction.EndsAt = time.Now().Add(5 * time.Second)
+	auction.ExtensionSeconds = 60
+	if err := e.service.auctions.Save(e.service.ctx, auction); err != nil {
+		t.Fatal(err)
+	}
+	standing, err = offer(firstBid, 870)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if standing.Rank != 1 || !standing.EndsAt.After(time.Now().Add(50*time.Second)) {
+		t.Errorf("standing after late offer = rank %d, ends at %v; want rank 1 and extended end", standing.Rank, standing.EndsAt)
+	}
+
+	auction.EndsAt = time.Now().Add(-time.Second)
+	if err := e.service.auctions.Save(e.service.ctx, auction); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := offer(secondBid, 800); !errors.Is(err, ErrConflict) {
+		t.Errorf("offer after end error = %v, want ErrConflict", err)
+	}
+	if _, err := e.service.SubmitBid(secondBid.ID.String(), approver.Username, true); !errors.Is(err, ErrConflict) {
+		t.Errorf("SubmitBid(not the leader) error = %v, want ErrConflict", err)
+	}
+	// Отклоненный лидер выбывает, и согласовать можно следующего
+	if _, err := e.service.SubmitBid(firstBid.ID.String(), approver.Username, false); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.service.SubmitBid(secondBid.ID.String(), approver.Username, true); err != nil {
+		t.Fatalf("SubmitBid(new leader): %v", err)
+	}
+	if status, _ := e.service.GetTenderStatus(tender.ID.String()); status != "Closed" {
+		t.Errorf("tender status = %q, want Closed", status)
+	}
+}
+
+func TestSealedBids(t *testing.T) {
+	e := newTestEnv(t)
+	approver := e.employee("approver", models.RoleApprover)
+	bidder := e.employee("bidder", "")
+	sealedTender := func(name string) *models.Tender {
+		deadline := time.Now().Add(time.Hour)
+		tender := models.Tender{
+			Name: name, Description: "Описание", ServiceType: "Delivery", OrganizationId: e.organization.String(),
+			Deadline: &deadline, Sealed: true,
+		}
+		if err := e.service.CreateTender(&tender); err != nil {
+			t.Fatal(err)
+		}
+		published, err := e.service.UpdateTenderStatus(tender.ID.String(), "Published")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return published
+	}
+	// expire переносит срок приема в прошлое в обход проверок сервиса
+	expire := func(tender *models.Tender) {
+		t.Helper()
+		past := time.Now().Add(-time.Second)
+		tender.Deadline = &past
+		if err := e.service.tenders.Save(e.service.ctx, tender); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tender := sealedTender("Перевозка")
+	bid := e.bid(tender, bidder, "Published")
+	if bid.Name != "Предложение" {
+		t.Errorf("author sees bid name %q", bid.Name)
+	}
+
+	listed, err := e.service.GetBidsByTender(tender.ID.String(), 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*listed) != 1 || (*listed)[0].Name != "" || (*listed)[0].Description != "" || (*listed)[0].Sealed == nil {
+		t.Fatalf("GetBidsByTender before deadline = %+v, want metadata only", *listed)
+	}
+	if want := bidCommitment("Предложение", "Описание"); (*listed)[0].Commitment != want {
+		t.Errorf("commitment = %q, want %q", (*listed)[0].Commitment, want)
+	}
+	own, err := e.service.GetBidsByUser(bidder.Username, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*own) != 1 || (*own)[0].Description != "Описание" {
+		t.Errorf("GetBidsByUser = %+v, want opened bid", *own)
+	}
+	edited, err := e.service.UpdateBid(bid.ID.String(), &models.BidEdit{Description: "Дешевле"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if edited.Name != "Предложение" || edited.Description != "Дешевле" {
+		t.Errorf("UpdateBid = %q / %q", edited.Name, edited.Description)
+	}
+	if _, err := e.service.SubmitBid(bid.ID.String(), approver.Username, true); !errors.Is(err, ErrConflict) {
+		t.Errorf("SubmitBid(sealed) error = %v, want ErrConflict", err)
+	}
+	earlier := time.Now().Add(time.Minute)
+	if _, err := e.service.UpdateTender(tender.ID.String(), &models.TenderEdit{Deadline: &earlier}); !errors.Is(err, ErrConflict) {
+		t.Errorf("UpdateTender(earlier deadline) error = %v, want ErrConflict", err)
+	}
+
+	// После срока первый же запрос списка вскрывает все версии разом
+	expire(tender)
+	listed, err = e.service.GetBidsByTender(tender.ID.String(), 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*listed) != 1 || (*listed)[0].Description != "Дешевле" || (*listed)[0].Sealed != nil {
+		t.Fatalf("GetBidsByTender after deadline = %+v, want opened bid", *listed)
+	}
+	versions, err := e.service.GetBidVersions(bid.ID.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if (*versions)[0].Description != "Описание" || (*versions)[0].Sealed != nil {
+		t.Errorf("first version = %+v, want opened", (*versions)[0])
+	}
+	if _, err := e.service.UpdateBid(bid.ID.String(), &models.BidEdit{Description: "Поздно"}); !errors.Is(err, ErrConflict) {
+		t.Errorf("UpdateBid(after deadline) error = %v, want ErrConflict", err)
+	}
+	if _, err := e.service.SubmitBid(bid.ID.String(), approver.Username, true); err != nil {
+		t.Fatalf("SubmitBid(unsealed): %v", err)
+	}
+
+	// Остальные тендеры вскрывает фоновая проверка сроков
+	other := sealedTender("Хранение")
+	otherBid := e.bid(other, bidder, "Published")
+	// Копии, прочитанные до вскрытия, как у запросов, которые вскрытие обогнало
+	staleTender := *other
+	staleBid, err := e.service.bids.GetLast(e.service.ctx, otherBid.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expire(other)
+	if err := e.service.UnsealDueTenders(); err != nil {
+		t.Fatal(err)
+	}
+	if opened, err := e.service.GetBid(otherBid.ID.String()); err != nil || opened.Name != "Предложение" {
+		t.Errorf("GetBid after UnsealDueTenders = %+v, %v", opened, err)
+	}
+	late := *staleBid
+	late.Version, late.VersionID, late.Name, late.Description = staleBid.Version+1, uuid.Nil, "Позже", "Позже"
+	if err := e.service.createBidVersion(&staleTender, &late); !errors.Is(err, ErrConflict) {
+		t.Errorf("createBidVersion after unsealing error = %v, want ErrConflict", err)
+	}
+	staleBid.Status = "Canceled"
+	if err := e.service.bids.Save(e.service.ctx, staleBid); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.service.openBid(staleBid); err != nil || staleBid.Name != "Предложение" {
+		t.Errorf("openBid of a stale copy = %+v, %v", staleBid, err)
+	}
+	if saved, err := e.service.bids.GetLast(e.service.ctx, otherBid.ID); err != nil || saved.Sealed != nil || saved.Name != "Предложение" {
+		t.Errorf("bid after saving a stale copy = %+v, %v, want opened", saved, err)
+	}
+	// Журнал фиксирует обязательства тех версий, которые были вскрыты
+	commitments := map[uuid.UUID]string{
+		tender.ID: fmt.Sprint(map[string]string{bid.ID.String(): bidCommitment("Предложение", "Дешевле")}),
+		other.ID:  fmt.Sprint(map[string]string{otherBid.ID.String(): bidCommitment("Предложение", "Описание")}),
+	}
+	for id, want := range commitments {
+		events, err := e.service.GetAuditEvents(id.String(), 0, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(events) != 1 || events[0].Action != models.AuditBidsUnsealed {
+			t.Fatalf("audit of tender %s = %+v, want one unsealing", id, events)
+		}
+		if got := fmt.Sprint(events[0].Details["commitments"]); got != want {
+			t.Errorf("audit commitments of tender %s = %s, want %s", id, got, want)
+		}
+	}
+}
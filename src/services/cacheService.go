@@ -0,0 +1,117 @@
+package services
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+	"zadanie_6105/src/cache"
+	"zadanie_6105/src/models"
+)
+
+const (
+	tenderPagePrefix = "tenders:published:"
+	// tenderGenerationKey хранит время последнего изменения опубликованных тендеров.
+	// Оно входит в ключ страницы, поэтому страница, собранная до изменения, больше не читается.
+	tenderGenerationKey = "tenders:generation"
+	tenderGenerationTTL = 24 * time.Hour
+)
+
+// TenderPage - страница публичного списка тендеров. ModifiedAt - время последнего
+// известного кешу изменения тендеров, а без кеша - время сборки страницы.
+type TenderPage struct {
+	Tenders    []models.Tender `json:"tenders"`
+	ModifiedAt time.Time       `json:"modifiedAt"`
+}
+
+// UseCache включает кеш публичного списка тендеров. Вызывается до начала работы сервиса.
+func (s *Service) UseCache(store cache.Store, ttl time.Duration) {
+	s.cache = store
+	s.cacheTTL = ttl
+}
+
+// GetTenderPage отдает страницу опубликованных тендеров из кеша или собирает ее заново.
+// Ошибки кеша только логируются: без кеша страница читается из хранилища.
+func (s *Service) GetTenderPage(filter *models.TenderFilter, limit, offset int) (*TenderPage, error) {
+	s, span := s.startSpan("GetTenderPage")
+	defer span.End()
+
+	if s.cache == nil {
+		tenders, err := s.tenders.ListPublished(s.ctx, filter, limit, offset)
+		if err != nil {
+			return nil, err
+		}
+		return &TenderPage{Tenders: tenders, ModifiedAt: time.Now()}, nil
+	}
+
+	modifiedAt, generation := s.tenderGeneration()
+	key := tenderPageKey(generation, filter, limit, offset)
+	if value, ok, err := s.cache.Get(s.ctx, key); err != nil {
+		s.logger.Warn("Failed to read tender page from cache", "error", err)
+	} else if ok {
+		var page TenderPage
+		if err := json.Unmarshal(value, &page); err == nil {
+			return &page, nil
+		}
+	}
+
+	tenders, err := s.tenders.ListPublished(s.ctx, filter, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	page := &TenderPage{Tenders: tenders, ModifiedAt: modifiedAt}
+	if value, err := json.Marshal(page); err == nil {
+		if err := s.cache.Set(s.ctx, key, value, s.cacheTTL); err != nil {
+			s.logger.Warn("Failed to write tender page to cache", "error", err)
+		}
+	}
+	return page, nil
+}
+
+// tenderGeneration возвращает время последнего изменения тендеров и его строковый вид для ключа.
+// Пока изменений не было, страницы считаются измененными в момент сборки.
+func (s *Service) tenderGeneration() (time.Time, string) {
+	value, ok, err := s.cache.Get(s.ctx, tenderGenerationKey)
+	if err != nil {
+		s.logger.Warn("Failed to read tender generation from cache", "error", err)
+	}
+	if ok {
+		if modifiedAt, err := time.Parse(time.RFC3339Nano, string(value)); err == nil {
+			return modifiedAt, string(value)
+		}
+	}
+	return time.Now(), "0"
+}
+
+// invalidateTenderPages сбрасывает закешированные страницы после изменения тендеров
+func (s *Service) invalidateTenderPages() {
+	if s.cache == nil {
+		return
+	}
+	generation := time.Now().UTC().Format(time.RFC3339Nano)
+	if err := s.cache.Set(s.ctx, tenderGenerationKey, []byte(generation), tenderGenerationTTL); err != nil {
+		s.logger.Error("Failed to invalidate tender pages", "error", err)
+	}
+	if err := s.cache.DeletePrefix(s.ctx, tenderPagePrefix); err != nil {
+		s.logger.Warn("Failed to delete stale tender pages", "error", err)
+	}
+}
+
+// tenderPageKey строит ключ страницы из хеша фильтра: фильтр может не поместиться в ключ целиком.
+// Списки фильтра сортируются, чтобы порядок параметров запроса не влиял на ключ.
+func tenderPageKey(generation string, filter *models.TenderFilter, limit, offset int) string {
+	normalized := models.TenderFilter{}
+	if filter != nil {
+		normalized = *filter
+	}
+	for _, list := range []*[]string{
+		&normalized.ServiceTypes, &normalized.OrganizationIDs, &normalized.OrganizationTypes, &normalized.Regions,
+	} {
+		*list = slices.Compact(slices.Sorted(slices.Values(*list)))
+	}
+	encoded, _ := json.Marshal(normalized)
+	hash := sha256.Sum256(encoded)
+	return fmt.Sprintf("%s%s:%s:%d:%d", tenderPagePrefix, generation, hex.EncodeToString(hash[:]), limit, offset)
+}
@@ -0,0 +1,81 @@
+package services
+
+import (
+	"zadanie_6105/src/models"
+)
+
+func (s *Service) GetEmployees(limit, offset int) (*[]models.Employee, error) {
+	s, span := s.startSpan("GetEmployees")
+	defer span.End()
+
+	employees, err := s.employees.List(s.ctx, limit, offset)
+	return &employees, err
+}
+
+func (s *Service) GetEmployee(id string) (*models.Employee, error) {
+	s, span := s.startSpan("GetEmployee")
+	defer span.End()
+
+	return s.getEmployee(id)
+}
+
+func (s *Service) CreateEmployee(employee *models.Employee) error {
+	s, span := s.startSpan("CreateEmployee")
+	defer span.End()
+
+	return s.employees.Create(s.ctx, employee)
+}
+
+func (s *Service) UpdateEmployee(id string, edit *models.EmployeeEdit) (*models.Employee, error) {
+	s, span := s.startSpan("UpdateEmployee")
+	defer span.End()
+
+	employee, err := s.getEmployee(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if edit.Username != "" {
+		employee.Username = edit.Username
+	}
+	if edit.FirstName != "" {
+		employee.FirstName = edit.FirstName
+	}
+	if edit.LastName != "" {
+		employee.LastName = edit.LastName
+	}
+	if edit.Email != "" {
+		employee.Email = edit.Email
+	}
+	if edit.Locale != "" {
+		employee.Locale = edit.Locale
+	}
+
+	if err := s.employees.Save(s.ctx, employee); err != nil {
+		return nil, err
+	}
+	return employee, nil
+}
+
+func (s *Service) DeleteEmployee(id string) error {
+	s, span := s.startSpan("DeleteEmployee")
+	defer span.End()
+
+	employee, err := s.getEmployee(id)
+	if err != nil {
+		return err
+	}
+
+	// Удаление сотрудника каскадно удаляет его ответственность в организациях
+	responsibles, err := s.employees.ListResponsibilities(s.ctx, employee.ID)
+	if err != nil {
+		return err
+	}
+	for _, responsible := range responsibles {
+		if err := s.checkResponsibleRemoval(responsible.OrganizationID.String()); err != nil {
+			return err
+		}
+	}
+
+	return s.employees.Delete(s.ctx, employee.ID)
+}
@@ -0,0 +1,31 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"gorm.io/gorm"
+	"zadanie_6105/src/repository"
+)
+
+// Ошибки сервиса, по которым обработчики выбирают код ответа
+var (
+	ErrUserNotFound = errors.New("user does not exist")
+	ErrNotFound     = errors.New("not found")
+	ErrConflict     = errors.New("conflict")
+	ErrInvalidInput = errors.New("invalid input")
+
+	ErrLastResponsible = fmt.Errorf("%w: cannot remove the last responsible of an organization with open tenders", ErrConflict)
+)
+
+// notFound заменяет ненайденную запись GORM или репозитория на ErrNotFound с названием сущности
+func notFound(entity string, err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) || errors.Is(err, repository.ErrNotFound) {
+		return fmt.Errorf("%s %w", entity, ErrNotFound)
+	}
+	return err
+}
+
+// invalidID - идентификатор не в формате UUID, такой сущности точно нет
+func invalidID(entity string) error {
+	return fmt.Errorf("%s %w: invalid ID format", entity, ErrNotFound)
+}
@@ -0,0 +1,154 @@
+package services
+
+import (
+	"context"
+	"time"
+	"zadanie_6105/src/mailer"
+	"zadanie_6105/src/models"
+)
+
+const (
+	emailMaxAttempts = 5
+	emailRetryDelay  = time.Minute
+	emailBatchSize   = 20
+	// emailClaimTimeout - на сколько письмо забирается обработчиком очереди; если он не сохранит
+	// результат отправки, письмо вернется в очередь по истечении этого срока
+	emailClaimTimeout = 10 * time.Minute
+)
+
+type notificationData struct {
+	FirstName  string
+	TenderID   string
+	TenderName string
+	BidID      string
+	BidName    string
+}
+
+func (s *Service) getOrganizationResponsibles(organizationID string) (*[]models.Employee, error) {
+	s, span := s.startSpan("getOrganizationResponsibles")
+	defer span.End()
+
+	employees, err := s.employees.ListResponsibles(s.ctx, organizationID)
+	return &employees, err
+}
+
+// notifyTenderResponsibles ставит в очередь письма всем ответственным за организацию тендера.
+// Ошибки только логируются: уведомления не должны ломать основной запрос.
+func (s *Service) notifyTenderResponsibles(tender *models.Tender, bid *models.Bid, templateName string) {
+	s, span := s.startSpan("notifyTenderResponsibles")
+	defer span.End()
+
+	employees, err := s.getOrganizationResponsibles(tender.OrganizationId)
+	if err != nil {
+		s.logger.Error("Failed to load responsibles for notification", "error", err)
+		return
+	}
+
+	// Название запечатанного предложения ответственным не раскрывается
+	bidName := bid.Name
+	if bid.Sealed != nil {
+		bidName = bid.ID.String()
+	}
+	for _, employee := range *employees {
+		if employee.Email == "" {
+			continue
+		}
+		data := notificationData{
+			FirstName:  employee.FirstName,
+			TenderID:   tender.ID.String(),
+			TenderName: tender.Name,
+			BidID:      bid.ID.String(),
+			BidName:    bidName,
+		}
+		if err := s.enqueueEmail(employee.Email, employee.Locale, templateName, data); err != nil {
+			s.logger.Error("Failed to enqueue email", "recipient", employee.Email, "error", err)
+		}
+	}
+}
+
+func (s *Service) enqueueEmail(recipient string, locale string, templateName string, data interface{}) error {
+	s, span := s.startSpan("enqueueEmail")
+	defer span.End()
+
+	message, err := renderEmail(recipient, locale, templateName, data)
+	if err != nil {
+		return err
+	}
+	return s.emails.Create(s.ctx, message)
+}
+
+// renderEmail готовит письмо к постановке в очередь
+func renderEmail(recipient string, locale string, templateName string, data interface{}) (*models.EmailMessage, error) {
+	subject, body, err := mailer.Render(locale, templateName, data)
+	if err != nil {
+		return nil, err
+	}
+	return &models.EmailMessage{
+		Recipient:     recipient,
+		Subject:       subject,
+		Body:          body,
+		Status:        models.EmailStatusPending,
+		NextAttemptAt: time.Now(),
+	}, nil
+}
+
+// ProcessEmailQueue отправляет готовые к отправке письма. Неудачные попытки повторяются
+// с растущей задержкой, после emailMaxAttempts письмо помечается как Failed. Письма забираются
+// атомарно, поэтому несколько реплик не отправят одно письмо дважды.
+func (s *Service) ProcessEmailQueue() error {
+	s, span := s.startSpan("ProcessEmailQueue")
+	defer span.End()
+
+	// Письма о подходящих тендерах готовятся здесь же и уходят в этом же проходе
+	if err := s.processTenderMatches(); err != nil {
+		s.logger.Error("Failed to process saved search matches", "error", err)
+	}
+
+	now := time.Now()
+	messages, err := s.emails.ClaimDue(s.ctx, now, now.Add(emailClaimTimeout), emailBatchSize)
+	if err != nil {
+		return err
+	}
+
+	for _, message := range messages {
+		message.Attempts++
+		err := s.mailer.Send(mailer.Message{
+			To:      []string{message.Recipient},
+			Subject: message.Subject,
+			Body:    message.Body,
+		})
+		if err == nil {
+			now := time.Now()
+			message.Status = models.EmailStatusSent
+			message.SentAt = &now
+			message.LastError = ""
+		} else {
+			message.LastError = err.Error()
+			if message.Attempts >= emailMaxAttempts {
+				message.Status = models.EmailStatusFailed
+			} else {
+				message.NextAttemptAt = time.Now().Add(emailRetryDelay << (message.Attempts - 1))
+			}
+		}
+		if err := s.emails.Save(s.ctx, &message); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Service) RunEmailQueue(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.ProcessEmailQueue(); err != nil {
+				s.logger.Error("Failed to process email queue", "error", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"zadanie_6105/src/models"
+	"zadanie_6105/src/repository"
+)
+
+func (s *Service) GetOrganizations(limit, offset int) (*[]models.Organization, error) {
+	s, span := s.startSpan("GetOrganizations")
+	defer span.End()
+
+	organizations, err := s.organizations.List(s.ctx, limit, offset)
+	return &organizations, err
+}
+
+func (s *Service) GetOrganization(id string) (*models.Organization, error) {
+	s, span := s.startSpan("GetOrganization")
+	defer span.End()
+
+	organizationID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, invalidID("organization")
+	}
+
+	organization, err := s.organizations.Get(s.ctx, organizationID)
+	if err != nil {
+		return nil, notFound("organization", err)
+	}
+	return organization, nil
+}
+
+func (s *Service) CreateOrganization(organization *models.Organization) error {
+	s, span := s.startSpan("CreateOrganization")
+	defer span.End()
+
+	return s.organizations.Create(s.ctx, organization)
+}
+
+func (s *Service) UpdateOrganization(id string, edit *models.OrganizationEdit) (*models.Organization, error) {
+	s, span := s.startSpan("UpdateOrganization")
+	defer span.End()
+
+	organization, err := s.GetOrganization(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if edit.Name != "" {
+		organization.Name = edit.Name
+	}
+	if edit.Description != "" {
+		organization.Description = edit.Description
+	}
+	if edit.Type != "" {
+		organization.Type = edit.Type
+	}
+
+	if err := s.organizations.Save(s.ctx, organization); err != nil {
+		return nil, err
+	}
+	return organization, nil
+}
+
+func (s *Service) DeleteOrganization(id string) error {
+	s, span := s.startSpan("DeleteOrganization")
+	defer span.End()
+
+	organization, err := s.GetOrganization(id)
+	if err != nil {
+		return err
+	}
+
+	hasOpenTenders, err := s.checkIfOrganizationHasOpenTenders(organization.ID.String())
+	if err != nil {
+		return err
+	}
+	if hasOpenTenders {
+		return fmt.Errorf("%w: cannot delete an organization with open tenders", ErrConflict)
+	}
+
+	return s.organizations.Delete(s.ctx, organization.ID)
+}
+
+func (s *Service) checkIfOrganizationHasOpenTenders(organizationID string) (bool, error) {
+	s, span := s.startSpan("checkIfOrganizationHasOpenTenders")
+	defer span.End()
+
+	count, err := s.tenders.CountOpen(s.ctx, organizationID)
+	return count > 0, err
+}
+
+func (s *Service) GetResponsibles(organizationID string) (*[]models.OrganizationResponsible, error) {
+	s, span := s.startSpan("GetResponsibles")
+	defer span.End()
+
+	organization, err := s.GetOrganization(organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	responsibles, err := s.organizations.ListResponsibles(s.ctx, organization.ID)
+	return &responsibles, err
+}
+
+func (s *Service) AssignResponsible(organizationID string, employeeID string, role string) (*models.OrganizationResponsible, error) {
+	s, span := s.startSpan("AssignResponsible")
+	defer span.End()
+
+	organization, err := s.GetOrganization(organizationID)
+	if err != nil {
+		return nil, err
+	}
+	employee, err := s.getEmployee(employeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	responsible, err := s.organizations.GetResponsible(s.ctx, organization.ID, employee.ID)
+	if errors.Is(err, repository.ErrNotFound) {
+		responsible = &models.OrganizationResponsible{
+			OrganizationID: organization.ID,
+			UserID:         employee.ID,
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	responsible.Role = role
+	if err := s.organizations.SaveResponsible(s.ctx, responsible); err != nil {
+		return nil, err
+	}
+	responsible.Employee = *employee
+	return responsible, nil
+}
+
+func (s *Service) RemoveResponsible(organizationID string, employeeID string) error {
+	s, span := s.startSpan("RemoveResponsible")
+	defer span.End()
+
+	organization, err := s.GetOrganization(organizationID)
+	if err != nil {
+		return err
+	}
+	employee, err := s.getEmployee(employeeID)
+	if err != nil {
+		return err
+	}
+
+	responsible, err := s.organizations.GetResponsible(s.ctx, organization.ID, employee.ID)
+	if err != nil {
+		return notFound("responsible", err)
+	}
+
+	if err := s.checkResponsibleRemoval(organization.ID.String()); err != nil {
+		return err
+	}
+
+	return s.organizations.DeleteResponsible(s.ctx, responsible.ID)
+}
+
+// checkResponsibleRemoval запрещает оставить организацию с открытыми тендерами без ответственных
+func (s *Service) checkResponsibleRemoval(organizationID string) error {
+	s, span := s.startSpan("checkResponsibleRemoval")
+	defer span.End()
+
+	orgID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return invalidID("organization")
+	}
+	count, err := s.organizations.CountResponsibles(s.ctx, orgID)
+	if err != nil {
+		return err
+	}
+	if count > 1 {
+		return nil
+	}
+
+	hasOpenTenders, err := s.checkIfOrganizationHasOpenTenders(organizationID)
+	if err != nil {
+		return err
+	}
+	if hasOpenTenders {
+		return ErrLastResponsible
+	}
+	return nil
+}
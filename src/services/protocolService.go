@@ -0,0 +1,184 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"strings"
+	"time"
+	"zadanie_6105/src/mailer"
+	"zadanie_6105/src/models"
+	"zadanie_6105/src/protocol"
+	"zadanie_6105/src/repository"
+)
+
+func employeeName(employee *models.Employee) string {
+	name := strings.TrimSpace(employee.FirstName + " " + employee.LastName)
+	if name == "" {
+		return employee.Username
+	}
+	return name + " (" + employee.Username + ")"
+}
+
+// saveProtocol формирует протокол закрытого тендера и сохраняет его вместе с тендером
+func (s *Service) saveProtocol(tender *models.Tender, winner *models.Bid, closedAt time.Time) (*models.TenderProtocol, error) {
+	s, span := s.startSpan("saveProtocol")
+	defer span.End()
+
+	data, err := s.protocolData(tender, winner, closedAt)
+	if err != nil {
+		return nil, err
+	}
+	content, err := protocol.Render(data)
+	if err != nil {
+		return nil, err
+	}
+
+	tenderProtocol := models.TenderProtocol{TenderID: tender.ID, BidID: winner.ID, Content: content}
+	if err := s.protocols.Save(s.ctx, &tenderProtocol); err != nil {
+		return nil, err
+	}
+	return &tenderProtocol, nil
+}
+
+// protocolData собирает данные протокола. В протокол попадают все поданные предложения,
+// черновики в статусе Created не учитываются.
+func (s *Service) protocolData(tender *models.Tender, winner *models.Bid, closedAt time.Time) (*protocol.Data, error) {
+	s, span := s.startSpan("protocolData")
+	defer span.End()
+
+	organizationID, err := uuid.Parse(tender.OrganizationId)
+	if err != nil {
+		return nil, invalidID("organization")
+	}
+	organization, err := s.organizations.Get(s.ctx, organizationID)
+	if err != nil {
+		return nil, notFound("organization", err)
+	}
+	history, err := s.tenders.ListVersions(s.ctx, tender.ID)
+	if err != nil {
+		return nil, err
+	}
+	responsibles, err := s.organizations.ListResponsibles(s.ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	serviceTypes, err := s.serviceTypes.List(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	names := map[uuid.UUID]string{}
+	roles := map[uuid.UUID]string{}
+	data := &protocol.Data{
+		Organization: *organization,
+		Tender:       *tender,
+		History:      history,
+		ClosedAt:     closedAt,
+		ServiceTypes: map[string]string{},
+	}
+	for _, serviceType := range serviceTypes {
+		data.ServiceTypes[serviceType.Code] = serviceType.Name(mailer.LocaleRu, mailer.LocaleEn)
+	}
+	for _, responsible := range responsibles {
+		names[responsible.UserID] = employeeName(&responsible.Employee)
+		roles[responsible.UserID] = responsible.Role
+		if roleHasPermission(responsible.Role, PermissionBidDecide) {
+			data.Signatories = append(data.Signatories, protocol.Signatory{
+				Name: names[responsible.UserID],
+				Role: responsible.Role,
+			})
+		}
+	}
+	// Авторы предложений и проголосовавшие, которые уже не отвечают за организацию
+	name := func(id uuid.UUID) string {
+		if _, ok := names[id]; !ok {
+			names[id] = id.String()
+			if employee, err := s.employees.Get(s.ctx, id); err == nil {
+				names[id] = employeeName(employee)
+			}
+		}
+		return names[id]
+	}
+
+	bids, err := s.bids.ListByTender(s.ctx, tender.ID, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	bidNames := map[uuid.UUID]string{}
+	var bidIDs []uuid.UUID
+	for _, bid := range bids {
+		if bid.Status == "Created" {
+			continue
+		}
+		bidIDs = append(bidIDs, bid.ID)
+		bidNames[bid.ID] = bid.Name
+		data.Bids = append(data.Bids, protocol.Bid{Bid: bid, Author: name(bid.AuthorId)})
+	}
+	data.Winner = protocol.Bid{Bid: *winner, Author: name(winner.AuthorId)}
+
+	decisions, err := s.bids.ListDecisionsByBids(s.ctx, bidIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, decision := range decisions {
+		data.Decisions = append(data.Decisions, protocol.Decision{
+			Bid:       bidNames[decision.BidId],
+			Employee:  name(decision.EmployeeId),
+			Role:      roles[decision.EmployeeId],
+			Decision:  decision.Decision,
+			CreatedAt: decision.CreatedAt,
+		})
+	}
+	return data, nil
+}
+
+// GetTenderProtocol возвращает PDF-протокол закрытого тендера. Для тендеров, закрытых
+// до появления протоколов, он формируется при первом запросе по согласованному предложению.
+func (s *Service) GetTenderProtocol(tenderId string) (*models.TenderProtocol, error) {
+	s, span := s.startSpan("GetTenderProtocol")
+	defer span.End()
+
+	tender, err := s.getTenderLastVersion(tenderId)
+	if err != nil {
+		return nil, err
+	}
+	stored, err := s.protocols.Get(s.ctx, tender.ID)
+	if err == nil {
+		return stored, nil
+	}
+	if !errors.Is(err, repository.ErrNotFound) {
+		return nil, err
+	}
+	if tender.Status != "Closed" {
+		return nil, fmt.Errorf("protocol %w: tender is not closed", ErrNotFound)
+	}
+
+	bids, err := s.bids.ListByTender(s.ctx, tender.ID, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	for i := range bids {
+		approved, err := s.checkBidQuorum(&bids[i], tender)
+		if err != nil {
+			return nil, err
+		}
+		if !approved {
+			continue
+		}
+
+		// Время закрытия не сохранялось, поэтому берется время последнего голоса
+		var closedAt time.Time
+		decisions, err := s.bids.ListDecisionsByBids(s.ctx, []uuid.UUID{bids[i].ID})
+		if err != nil {
+			return nil, err
+		}
+		for _, decision := range decisions {
+			if decision.CreatedAt.After(closedAt) {
+				closedAt = decision.CreatedAt
+			}
+		}
+		return s.saveProtocol(tender, &bids[i], closedAt)
+	}
+	return nil, fmt.Errorf("protocol %w: tender has no approved bid", ErrNotFound)
+}
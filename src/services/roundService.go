@@ -0,0 +1,225 @@
+package services
+
+import (
+	"fmt"
+	"github.com/google/uuid"
+	"slices"
+	"zadanie_6105/src/models"
+)
+
+// checkShortlistOpen проверяет, что в текущем раунде тендера можно вести шорт-лист
+func checkShortlistOpen(tender *models.Tender) error {
+	if tender.Status != "Published" {
+		return fmt.Errorf("%w: tender is not published", ErrConflict)
+	}
+	if tender.Round >= tender.Rounds {
+		return fmt.Errorf("%w: tender is in its final round", ErrConflict)
+	}
+	return nil
+}
+
+// checkFinalRound проверяет, что решение по предложению принимается в последнем раунде
+// по версии, поданной в этом раунде
+func checkFinalRound(bid *models.Bid, tender *models.Tender) error {
+	if tender.Round < tender.Rounds {
+		return fmt.Errorf("%w: decisions are accepted in the final round only", ErrConflict)
+	}
+	if bid.Round != tender.Round {
+		return fmt.Errorf("%w: bid was not submitted in the final round", ErrConflict)
+	}
+	return nil
+}
+
+// checkRound возвращает номер раунда тендера; 0 означает текущий раунд
+func checkRound(tender *models.Tender, round int32) (int32, error) {
+	if round == 0 {
+		return tender.Round, nil
+	}
+	if round < 1 || round > tender.Rounds {
+		return 0, fmt.Errorf("round %w", ErrNotFound)
+	}
+	return round, nil
+}
+
+// getTenderBid возвращает предложение, поданное на тендер
+func (s *Service) getTenderBid(tender *models.Tender, bidId string) (*models.Bid, error) {
+	bid, err := s.getBidLastVersion(bidId)
+	if err != nil {
+		return nil, err
+	}
+	if bid.TenderId != tender.ID {
+		return nil, fmt.Errorf("bid %w in tender", ErrNotFound)
+	}
+	return bid, nil
+}
+
+// canBidInRound проверяет, что автор может подавать предложения в текущем раунде:
+// в первом раунде - любой, дальше - только прошедший в шорт-лист предыдущего раунда
+func (s *Service) canBidInRound(tender *models.Tender, authorID uuid.UUID) (bool, error) {
+	s, span := s.startSpan("canBidInRound")
+	defer span.End()
+
+	if tender.Round <= 1 {
+		return true, nil
+	}
+	shortlist, err := s.bids.ListShortlist(s.ctx, tender.ID, tender.Round-1)
+	if err != nil {
+		return false, err
+	}
+	return slices.ContainsFunc(shortlist, func(entry models.BidShortlist) bool {
+		return entry.AuthorId == authorID
+	}), nil
+}
+
+// AuthorizeRoundBidder проверяет, что автор может подать предложение на тендер в текущем раунде
+func (s *Service) AuthorizeRoundBidder(tenderId string, authorID uuid.UUID) (bool, error) {
+	s, span := s.startSpan("AuthorizeRoundBidder")
+	defer span.End()
+
+	tender, err := s.getTenderLastVersion(tenderId)
+	if err != nil {
+		return false, err
+	}
+	return s.canBidInRound(tender, authorID)
+}
+
+// AuthorizeBidRound проверяет, что автор предложения может менять его в текущем раунде тендера
+func (s *Service) AuthorizeBidRound(bidId string) (bool, error) {
+	s, span := s.startSpan("AuthorizeBidRound")
+	defer span.End()
+
+	bid, err := s.getBidLastVersion(bidId)
+	if err != nil {
+		return false, err
+	}
+	tender, err := s.getTenderLastVersion(bid.TenderId.String())
+	if err != nil {
+		return false, err
+	}
+	return s.canBidInRound(tender, bid.AuthorId)
+}
+
+// GetShortlist возвращает шорт-лист раунда тендера; round = 0 - текущий раунд
+func (s *Service) GetShortlist(tenderId string, round int32) ([]models.BidShortlist, error) {
+	s, span := s.startSpan("GetShortlist")
+	defer span.End()
+
+	tender, err := s.getTenderLastVersion(tenderId)
+	if err != nil {
+		return nil, err
+	}
+	if round, err = checkRound(tender, round); err != nil {
+		return nil, err
+	}
+	return s.bids.ListShortlist(s.ctx, tender.ID, round)
+}
+
+// ShortlistBid добавляет в шорт-лист текущего раунда опубликованное предложение,
+// поданное в этом раунде
+func (s *Service) ShortlistBid(tenderId string, bidId string, username string) (*models.BidShortlist, error) {
+	s, span := s.startSpan("ShortlistBid")
+	defer span.End()
+
+	employee, err := s.getEmployeeByUsername(username)
+	if err != nil {
+		return nil, err
+	}
+	tender, err := s.getTenderLastVersion(tenderId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkShortlistOpen(tender); err != nil {
+		return nil, err
+	}
+	bid, err := s.getTenderBid(tender, bidId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkBidUnsealed(bid, tender); err != nil {
+		return nil, err
+	}
+	if bid.Status != "Published" || bid.Round != tender.Round {
+		return nil, fmt.Errorf("%w: bid is not published in the current round", ErrConflict)
+	}
+
+	entry := models.BidShortlist{
+		TenderId:   tender.ID,
+		Round:      tender.Round,
+		BidId:      bid.ID,
+		AuthorId:   bid.AuthorId,
+		EmployeeId: employee.ID,
+	}
+	if err := s.bids.SaveShortlist(s.ctx, &entry); err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+func (s *Service) RemoveFromShortlist(tenderId string, bidId string) error {
+	s, span := s.startSpan("RemoveFromShortlist")
+	defer span.End()
+
+	tender, err := s.getTenderLastVersion(tenderId)
+	if err != nil {
+		return err
+	}
+	if err := checkShortlistOpen(tender); err != nil {
+		return err
+	}
+	bid, err := s.getTenderBid(tender, bidId)
+	if err != nil {
+		return err
+	}
+	return notFound("shortlisted bid", s.bids.DeleteShortlist(s.ctx, tender.ID, tender.Round, bid.ID))
+}
+
+// AdvanceTenderRound закрывает текущий раунд и открывает следующий для авторов из шорт-листа
+func (s *Service) AdvanceTenderRound(tenderId string) (*models.Tender, error) {
+	s, span := s.startSpan("AdvanceTenderRound")
+	defer span.End()
+
+	tender, err := s.getTenderLastVersion(tenderId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkShortlistOpen(tender); err != nil {
+		return nil, err
+	}
+	shortlist, err := s.bids.ListShortlist(s.ctx, tender.ID, tender.Round)
+	if err != nil {
+		return nil, err
+	}
+	if len(shortlist) == 0 {
+		return nil, fmt.Errorf("%w: shortlist of the current round is empty", ErrConflict)
+	}
+
+	tender.Round++
+	if err := s.tenders.Save(s.ctx, tender); err != nil {
+		return nil, err
+	}
+	s.invalidateTenderPages()
+	return tender, nil
+}
+
+// GetRoundBids возвращает опубликованные предложения раунда: по каждому предложению -
+// последнюю версию, поданную в этом раунде; round = 0 - текущий раунд
+func (s *Service) GetRoundBids(tenderId string, round int32) ([]models.Bid, error) {
+	s, span := s.startSpan("GetRoundBids")
+	defer span.End()
+
+	tender, err := s.getTenderLastVersion(tenderId)
+	if err != nil {
+		return nil, err
+	}
+	if round, err = checkRound(tender, round); err != nil {
+		return nil, err
+	}
+	if _, err := s.unsealIfDue(tender); err != nil {
+		return nil, err
+	}
+	bids, err := s.bids.ListRoundVersions(s.ctx, tender.ID, round)
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(bids, func(bid models.Bid) bool { return bid.Status != "Published" }), nil
+}
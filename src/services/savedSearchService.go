@@ -0,0 +1,254 @@
+package services
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"time"
+	"zadanie_6105/src/mailer"
+	"zadanie_6105/src/models"
+	"zadanie_6105/src/repository"
+)
+
+type tenderMatchData struct {
+	FirstName  string
+	TenderID   string
+	TenderName string
+	SearchName string
+	Updated    bool
+}
+
+// getOwnSavedSearch возвращает поиск сотрудника; чужой поиск не отличается от несуществующего
+func (s *Service) getOwnSavedSearch(username string, id string) (*models.SavedSearch, error) {
+	employee, err := s.getEmployeeByUsername(username)
+	if err != nil {
+		return nil, err
+	}
+	searchID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, invalidID("saved search")
+	}
+	search, err := s.searches.Get(s.ctx, searchID)
+	if err != nil {
+		return nil, notFound("saved search", err)
+	}
+	if search.EmployeeID != employee.ID {
+		return nil, notFound("saved search", repository.ErrNotFound)
+	}
+	return search, nil
+}
+
+func (s *Service) GetSavedSearches(username string) ([]models.SavedSearch, error) {
+	s, span := s.startSpan("GetSavedSearches")
+	defer span.End()
+
+	employee, err := s.getEmployeeByUsername(username)
+	if err != nil {
+		return nil, err
+	}
+	return s.searches.ListByEmployee(s.ctx, employee.ID)
+}
+
+func (s *Service) GetSavedSearch(username string, id string) (*models.SavedSearch, error) {
+	s, span := s.startSpan("GetSavedSearch")
+	defer span.End()
+
+	return s.getOwnSavedSearch(username, id)
+}
+
+// CreateSavedSearch сохраняет поиск сотрудника; виды услуг проверяются по справочнику
+func (s *Service) CreateSavedSearch(username string, search *models.SavedSearch) error {
+	s, span := s.startSpan("CreateSavedSearch")
+	defer span.End()
+
+	employee, err := s.getEmployeeByUsername(username)
+	if err != nil {
+		return err
+	}
+	if _, err := s.ResolveServiceTypes(search.Filter.ServiceTypes, false); err != nil {
+		return err
+	}
+	search.EmployeeID = employee.ID
+	return s.searches.Create(s.ctx, search)
+}
+
+func (s *Service) UpdateSavedSearch(username string, id string, edit *models.SavedSearchEdit) (*models.SavedSearch, error) {
+	s, span := s.startSpan("UpdateSavedSearch")
+	defer span.End()
+
+	search, err := s.getOwnSavedSearch(username, id)
+	if err != nil {
+		return nil, err
+	}
+	if edit.Name != nil {
+		search.Name = *edit.Name
+	}
+	if edit.Filter != nil {
+		if _, err := s.ResolveServiceTypes(edit.Filter.ServiceTypes, false); err != nil {
+			return nil, err
+		}
+		search.Filter = *edit.Filter
+	}
+	if edit.IncludeSubcategories != nil {
+		search.IncludeSubcategories = *edit.IncludeSubcategories
+	}
+	if edit.Notify != nil {
+		search.Notify = *edit.Notify
+	}
+
+	if err := s.searches.Save(s.ctx, search); err != nil {
+		return nil, err
+	}
+	return search, nil
+}
+
+func (s *Service) DeleteSavedSearch(username string, id string) error {
+	s, span := s.startSpan("DeleteSavedSearch")
+	defer span.End()
+
+	search, err := s.getOwnSavedSearch(username, id)
+	if err != nil {
+		return err
+	}
+	return s.searches.Delete(s.ctx, search.ID)
+}
+
+// savedSearchFilter подставляет в фильтр поиска подкатегории по текущему справочнику.
+// Удаленные из справочника виды услуг остаются в фильтре и просто ничему не соответствуют.
+func savedSearchFilter(search *models.SavedSearch, tree *serviceTypeTree) *models.TenderFilter {
+	filter := search.Filter
+	filter.ServiceTypes = tree.expand(search.Filter.ServiceTypes, search.IncludeSubcategories)
+	return &filter
+}
+
+// RunSavedSearch возвращает страницу опубликованных тендеров по сохраненному поиску
+func (s *Service) RunSavedSearch(username string, id string, limit, offset int) (*TenderPage, error) {
+	s, span := s.startSpan("RunSavedSearch")
+	defer span.End()
+
+	search, err := s.getOwnSavedSearch(username, id)
+	if err != nil {
+		return nil, err
+	}
+	tree, err := s.loadServiceTypeTree()
+	if err != nil {
+		return nil, err
+	}
+	return s.GetTenderPage(savedSearchFilter(search, tree), limit, offset)
+}
+
+// notifySavedSearches ставит опубликованную версию тендера в очередь сверки с сохраненными
+// поисками; письма подготовит обработчик очереди писем. Ошибки только логируются:
+// уведомления не должны ломать основной запрос.
+func (s *Service) notifySavedSearches(tender *models.Tender, updated bool) {
+	s, span := s.startSpan("notifySavedSearches")
+	defer span.End()
+
+	match := models.TenderMatch{
+		TenderId:      tender.ID,
+		Version:       tender.Version,
+		Updated:       updated,
+		NextAttemptAt: time.Now(),
+	}
+	if err := s.searches.EnqueueMatch(s.ctx, &match); err != nil {
+		s.logger.Error("Failed to enqueue saved search match", "tender", tender.ID, "error", err)
+	}
+}
+
+// processTenderMatches сверяет тендеры из очереди с сохраненными поисками. Письма задачи
+// ставятся в очередь вместе с ее удалением, поэтому повтор не отправит их дважды. Задача,
+// которую не удалось обработать, вернется в очередь через emailClaimTimeout.
+func (s *Service) processTenderMatches() error {
+	s, span := s.startSpan("processTenderMatches")
+	defer span.End()
+
+	now := time.Now()
+	matches, err := s.searches.ClaimMatches(s.ctx, now, now.Add(emailClaimTimeout), emailBatchSize)
+	if err != nil {
+		return err
+	}
+	for _, match := range matches {
+		messages, err := s.matchSavedSearches(&match)
+		if err == nil {
+			err = s.searches.CompleteMatch(s.ctx, match.ID, messages)
+		}
+		// ErrNotFound - задачу уже завершил другой обработчик
+		if err != nil && !errors.Is(err, repository.ErrNotFound) {
+			s.logger.Error("Failed to process saved search match", "tender", match.TenderId, "error", err)
+		}
+	}
+	return nil
+}
+
+// matchSavedSearches готовит письма владельцам поисков с уведомлениями, под которые
+// подходит версия тендера. Сотрудник получает одно письмо, даже если подошло несколько поисков.
+// Если тендер уже изменен или снят с публикации, писем нет: о новой версии
+// сообщит ее собственная задача.
+func (s *Service) matchSavedSearches(match *models.TenderMatch) ([]models.EmailMessage, error) {
+	s, span := s.startSpan("matchSavedSearches")
+	defer span.End()
+
+	tender, err := s.tenders.GetLast(s.ctx, match.TenderId)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if tender.Version != match.Version || tender.Status != "Published" {
+		return nil, nil
+	}
+
+	searches, err := s.searches.ListNotified(s.ctx)
+	if err != nil || len(searches) == 0 {
+		return nil, err
+	}
+	tree, err := s.loadServiceTypeTree()
+	if err != nil {
+		return nil, err
+	}
+	var organizationType string
+	if organizationID, err := uuid.Parse(tender.OrganizationId); err == nil {
+		if organization, err := s.organizations.Get(s.ctx, organizationID); err == nil {
+			organizationType = organization.Type
+		}
+	}
+
+	matched := map[uuid.UUID]*models.SavedSearch{}
+	var ownerIDs []uuid.UUID
+	for i := range searches {
+		search := &searches[i]
+		if matched[search.EmployeeID] != nil || !savedSearchFilter(search, tree).Match(tender, organizationType) {
+			continue
+		}
+		matched[search.EmployeeID] = search
+		ownerIDs = append(ownerIDs, search.EmployeeID)
+	}
+	if len(ownerIDs) == 0 {
+		return nil, nil
+	}
+	owners, err := s.employees.ListByIDs(s.ctx, ownerIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var messages []models.EmailMessage
+	for _, employee := range owners {
+		if employee.Email == "" {
+			continue
+		}
+		data := tenderMatchData{
+			FirstName:  employee.FirstName,
+			TenderID:   tender.ID.String(),
+			TenderName: tender.Name,
+			SearchName: matched[employee.ID].Name,
+			Updated:    match.Updated,
+		}
+		message, err := renderEmail(employee.Email, employee.Locale, mailer.TemplateTenderMatched, data)
+		if err != nil {
+			s.logger.Error("Failed to render email", "recipient", employee.Email, "error", err)
+			continue
+		}
+		messages = append(messages, *message)
+	}
+	return messages, nil
+}
@@ -0,0 +1,108 @@
+package services
+
+import (
+	"errors"
+	"testing"
+	"time"
+	"zadanie_6105/src/models"
+)
+
+// queuedEmails сверяет тендеры из очереди с поисками и возвращает адресатов писем в очереди
+func queuedEmails(t *testing.T, service *Service) []string {
+	t.Helper()
+	if err := service.processTenderMatches(); err != nil {
+		t.Fatal(err)
+	}
+	// Забранные письма откладываются до того же момента, поэтому следующий вызов увидит их снова
+	later := time.Now().Add(time.Hour)
+	messages, err := service.emails.ClaimDue(service.ctx, later, later, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	recipients := []string{}
+	for _, message := range messages {
+		recipients = append(recipients, message.Recipient)
+	}
+	return recipients
+}
+
+func TestSavedSearches(t *testing.T) {
+	e := newTestEnv(t)
+	supplier := models.Employee{Username: "supplier", Email: "supplier@example.com"}
+	if err := e.service.CreateEmployee(&supplier); err != nil {
+		t.Fatal(err)
+	}
+	e.employee("other", "")
+
+	search := models.SavedSearch{
+		Name:   "Доставка",
+		Filter: models.TenderFilter{ServiceTypes: []string{"Delivery"}, Search: "мебели"},
+		Notify: true,
+	}
+	if err := e.service.CreateSavedSearch("supplier", &search); err != nil {
+		t.Fatalf("CreateSavedSearch: %v", err)
+	}
+	unknown := models.SavedSearch{Name: "Уборка", Filter: models.TenderFilter{ServiceTypes: []string{"Cleaning"}}}
+	if err := e.service.CreateSavedSearch("supplier", &unknown); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("CreateSavedSearch(unknown service type) error = %v, want ErrInvalidInput", err)
+	}
+	if _, err := e.service.GetSavedSearch("other", search.ID.String()); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetSavedSearch(other employee) error = %v, want ErrNotFound", err)
+	}
+	if err := e.service.DeleteSavedSearch("other", search.ID.String()); !errors.Is(err, ErrNotFound) {
+		t.Errorf("DeleteSavedSearch(other employee) error = %v, want ErrNotFound", err)
+	}
+
+	// Неподходящий тендер и черновик писем не вызывают
+	e.tender("Доставка цемента", "Published")
+	furniture := e.tender("Доставка мебели", "")
+	if recipients := queuedEmails(t, e.service); len(recipients) != 0 {
+		t.Fatalf("emails before publication = %v, want none", recipients)
+	}
+
+	if _, err := e.service.UpdateTenderStatus(furniture.ID.String(), "Published"); err != nil {
+		t.Fatal(err)
+	}
+	if recipients := queuedEmails(t, e.service); len(recipients) != 1 || recipients[0] != "supplier@example.com" {
+		t.Fatalf("emails after publication = %v, want [supplier@example.com]", recipients)
+	}
+	if _, err := e.service.UpdateTender(furniture.ID.String(), &models.TenderEdit{Description: "Доставка офисной мебели"}); err != nil {
+		t.Fatal(err)
+	}
+	if recipients := queuedEmails(t, e.service); len(recipients) != 2 {
+		t.Errorf("emails after edit = %v, want 2", recipients)
+	}
+
+	// Сверка идет в обработчике очереди: тендер, отмененный до нее, писем не вызывает
+	canceled := e.tender("Доставка мебели в офис", "Published")
+	if _, err := e.service.UpdateTenderStatus(canceled.ID.String(), "Canceled"); err != nil {
+		t.Fatal(err)
+	}
+	if recipients := queuedEmails(t, e.service); len(recipients) != 2 {
+		t.Errorf("emails after a canceled publication = %v, want 2", recipients)
+	}
+
+	page, err := e.service.RunSavedSearch("supplier", search.ID.String(), 10, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if names := tenderNames(&page.Tenders); len(names) != 1 || names[0] != "Доставка мебели" {
+		t.Errorf("RunSavedSearch = %v, want [Доставка мебели]", names)
+	}
+
+	off := false
+	if _, err := e.service.UpdateSavedSearch("supplier", search.ID.String(), &models.SavedSearchEdit{Notify: &off}); err != nil {
+		t.Fatal(err)
+	}
+	e.tender("Доставка мебели на склад", "Published")
+	if recipients := queuedEmails(t, e.service); len(recipients) != 2 {
+		t.Errorf("emails with notifications off = %v, want 2", recipients)
+	}
+
+	if err := e.service.DeleteSavedSearch("supplier", search.ID.String()); err != nil {
+		t.Fatal(err)
+	}
+	if searches, err := e.service.GetSavedSearches("supplier"); err != nil || len(searches) != 0 {
+		t.Errorf("GetSavedSearches after delete = %v, %v", searches, err)
+	}
+}
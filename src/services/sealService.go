@@ -0,0 +1,336 @@
+package services
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"time"
+	"zadanie_6105/src/models"
+	"zadanie_6105/src/repository"
+)
+
+// sealKeySize - длина ключа тендера, AES-256
+const sealKeySize = 32
+
+// sealedContent - зашифрованная часть предложения
+type sealedContent struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// bidCommitment возвращает SHA-256 содержимого предложения: название и описание через нулевой байт
+func bidCommitment(name, description string) string {
+	sum := sha256.Sum256([]byte(name + "\x00" + description))
+	return hex.EncodeToString(sum[:])
+}
+
+func sealContent(key []byte, name, description string) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := json.Marshal(sealedContent{Name: name, Description: description})
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+// openContent расшифровывает содержимое, зашифрованное sealContent
+func openContent(key, sealed []byte) (name, description string, err error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return "", "", err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return "", "", err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return "", "", errors.New("sealed bid is too short")
+	}
+	plaintext, err := gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], nil)
+	if err != nil {
+		return "", "", fmt.Errorf("open sealed bid: %w", err)
+	}
+	var content sealedContent
+	if err := json.Unmarshal(plaintext, &content); err != nil {
+		return "", "", err
+	}
+	return content.Name, content.Description, nil
+}
+
+// checkSealedDeadline запрещает переносить срок запечатанного тендера на более ранний,
+// иначе предложения можно было бы вскрыть досрочно
+func checkSealedDeadline(current, next *models.Tender) error {
+	if !current.Sealed || current.Deadline == nil || next.Deadline != nil && !next.Deadline.Before(*current.Deadline) {
+		return nil
+	}
+	return fmt.Errorf("%w: deadline of a sealed tender can only be extended", ErrConflict)
+}
+
+// checkSealedRounds запрещает запечатанному тендеру несколько раундов: после вскрытия
+// предложения больше не принимаются
+func checkSealedRounds(tender *models.Tender) error {
+	if tender.Sealed && tender.Rounds > 1 {
+		return fmt.Errorf("%w: sealed tender can have only one round", ErrConflict)
+	}
+	return nil
+}
+
+// tenderSeal возвращает ключ запечатанного тендера, при первом обращении создает его
+func (s *Service) tenderSeal(tender *models.Tender) (*models.TenderSeal, error) {
+	s, span := s.startSpan("tenderSeal")
+	defer span.End()
+
+	seal, err := s.seals.Get(s.ctx, tender.ID)
+	if !errors.Is(err, repository.ErrNotFound) {
+		return seal, err
+	}
+	key := make([]byte, sealKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	seal = &models.TenderSeal{TenderId: tender.ID, Key: key}
+	err = s.seals.Create(s.ctx, seal)
+	if errors.Is(err, repository.ErrDuplicate) {
+		// Ключ одновременно создал другой запрос
+		return s.seals.Get(s.ctx, tender.ID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return seal, nil
+}
+
+// createBidVersion сохраняет новую версию предложения. Для запечатанного тендера содержимое
+// шифруется, а прием предложений заканчивается со сроком; вызывающему версия
+// возвращается открытой.
+func (s *Service) createBidVersion(tender *models.Tender, bid *models.Bid) error {
+	s, span := s.startSpan("createBidVersion")
+	defer span.End()
+
+	if !tender.Sealed {
+		return s.bids.Create(s.ctx, bid)
+	}
+	if sealedBidsClosed(tender, nil) {
+		return fmt.Errorf("%w: sealed tender no longer accepts bids", ErrConflict)
+	}
+	if _, err := s.tenderSeal(tender); err != nil {
+		return err
+	}
+
+	name, description := bid.Name, bid.Description
+	err := s.seals.CreateBid(s.ctx, bid, func(seal *models.TenderSeal) error {
+		// Проверка повторяется под блокировкой ключа, чтобы вскрытие не прошло между ней и записью
+		if sealedBidsClosed(tender, seal) {
+			return fmt.Errorf("%w: sealed tender no longer accepts bids", ErrConflict)
+		}
+		sealed, err := sealContent(seal.Key, name, description)
+		if err != nil {
+			return err
+		}
+		bid.Sealed, bid.Commitment = sealed, bidCommitment(name, description)
+		bid.Name, bid.Description = "", ""
+		return nil
+	})
+	bid.Name, bid.Description, bid.Sealed = name, description, nil
+	return err
+}
+
+// sealedBidsClosed сообщает, что запечатанный тендер больше не принимает предложения
+func sealedBidsClosed(tender *models.Tender, seal *models.TenderSeal) bool {
+	if seal != nil && seal.UnsealedAt != nil {
+		return true
+	}
+	return tender.Deadline != nil && !time.Now().Before(*tender.Deadline)
+}
+
+// openBids расшифровывает запечатанные предложения для их автора
+func (s *Service) openBids(bids []models.Bid) error {
+	s, span := s.startSpan("openBids")
+	defer span.End()
+
+	seals := map[uuid.UUID]*models.TenderSeal{}
+	for i := range bids {
+		if bids[i].Sealed == nil {
+			continue
+		}
+		tenderID := bids[i].TenderId
+		if _, ok := seals[tenderID]; !ok {
+			seal, err := s.seals.Get(s.ctx, tenderID)
+			if err != nil {
+				return notFound("tender seal", err)
+			}
+			seals[tenderID] = seal
+		}
+		if seals[tenderID].UnsealedAt != nil {
+			// Тендер вскрыли после чтения предложения: открытое содержимое уже в хранилище
+			opened, err := s.bids.GetVersion(s.ctx, bids[i].ID, bids[i].Version)
+			if err != nil {
+				return err
+			}
+			bids[i].Name, bids[i].Description, bids[i].Sealed = opened.Name, opened.Description, nil
+			continue
+		}
+		name, description, err := openContent(seals[tenderID].Key, bids[i].Sealed)
+		if err != nil {
+			return err
+		}
+		bids[i].Name, bids[i].Description, bids[i].Sealed = name, description, nil
+	}
+	return nil
+}
+
+// openBid расшифровывает одно запечатанное предложение для его автора
+func (s *Service) openBid(bid *models.Bid) error {
+	bids := []models.Bid{*bid}
+	if err := s.openBids(bids); err != nil {
+		return err
+	}
+	*bid = bids[0]
+	return nil
+}
+
+// unsealIfDue вскрывает запечатанный тендер, если срок приема предложений наступил.
+// Возвращает true, если предложения тендера открыты.
+func (s *Service) unsealIfDue(tender *models.Tender) (bool, error) {
+	s, span := s.startSpan("unsealIfDue")
+	defer span.End()
+
+	if !tender.Sealed {
+		return true, nil
+	}
+	seal, err := s.seals.Get(s.ctx, tender.ID)
+	if errors.Is(err, repository.ErrNotFound) {
+		// Предложений еще не было, вскрывать нечего
+		return tender.Deadline != nil && !time.Now().Before(*tender.Deadline), nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if seal.UnsealedAt != nil {
+		return true, nil
+	}
+	if tender.Deadline == nil || time.Now().Before(*tender.Deadline) {
+		return false, nil
+	}
+	return true, s.unsealTender(tender)
+}
+
+// unsealTender расшифровывает все предложения тендера разом и записывает вскрытие в журнал аудита
+func (s *Service) unsealTender(tender *models.Tender) error {
+	s, span := s.startSpan("unsealTender")
+	defer span.End()
+
+	count := 0
+	unsealed, err := s.seals.Unseal(s.ctx, tender.ID, openContent, func(bids []models.Bid) *models.AuditEvent {
+		count = len(bids)
+		commitments := map[string]string{}
+		for _, bid := range bids {
+			commitments[bid.ID.String()] = bid.Commitment
+		}
+		return &models.AuditEvent{
+			TenderId: tender.ID,
+			Action:   models.AuditBidsUnsealed,
+			Details: map[string]interface{}{
+				"deadline":    tender.Deadline.UTC().Format(time.RFC3339),
+				"bids":        count,
+				"commitments": commitments,
+			},
+			CreatedAt: time.Now(),
+		}
+	})
+	if err != nil {
+		return err
+	}
+	if unsealed {
+		s.logger.Info("Tender bids unsealed", "tender", tender.ID, "bids", count)
+	}
+	return nil
+}
+
+// checkBidUnsealed проверяет, что содержимое предложения уже вскрыто, и при наступившем
+// сроке вскрывает тендер; bid перечитывается открытым
+func (s *Service) checkBidUnsealed(bid *models.Bid, tender *models.Tender) error {
+	if bid.Sealed == nil {
+		return nil
+	}
+	unsealed, err := s.unsealIfDue(tender)
+	if err != nil {
+		return err
+	}
+	if !unsealed {
+		return fmt.Errorf("%w: bid is sealed until the tender deadline", ErrConflict)
+	}
+	opened, err := s.getBidLastVersion(bid.ID.String())
+	if err != nil {
+		return err
+	}
+	*bid = *opened
+	return nil
+}
+
+// UnsealDueTenders вскрывает запечатанные тендеры, у которых наступил срок приема
+func (s *Service) UnsealDueTenders() error {
+	s, span := s.startSpan("UnsealDueTenders")
+	defer span.End()
+
+	seals, err := s.seals.ListDue(s.ctx, time.Now())
+	if err != nil {
+		return err
+	}
+	for _, seal := range seals {
+		tender, err := s.getTenderLastVersion(seal.TenderId.String())
+		if err != nil {
+			return err
+		}
+		if err := s.unsealTender(tender); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Service) RunUnsealing(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.UnsealDueTenders(); err != nil {
+				s.logger.Error("Failed to unseal tenders", "error", err)
+			}
+		}
+	}
+}
+
+// GetAuditEvents возвращает журнал аудита тендера
+func (s *Service) GetAuditEvents(tenderId string, limit, offset int) ([]models.AuditEvent, error) {
+	s, span := s.startSpan("GetAuditEvents")
+	defer span.End()
+
+	tender, err := s.getTenderLastVersion(tenderId)
+	if err != nil {
+		return nil, err
+	}
+	return s.audit.ListByTender(s.ctx, tender.ID, limit, offset)
+}
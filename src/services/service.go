@@ -1,11 +1,82 @@
 package services
 
-import "gorm.io/gorm"
+import (
+	"context"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+	"log/slog"
+	"time"
+	"zadanie_6105/src/cache"
+	"zadanie_6105/src/logging"
+	"zadanie_6105/src/mailer"
+	"zadanie_6105/src/repository"
+)
+
+var tracer = otel.Tracer("zadanie_6105/src/services")
 
 type Service struct {
-	db *gorm.DB
+	ctx    context.Context
+	logger *slog.Logger
+	mailer mailer.Mailer
+
+	cache    cache.Store
+	cacheTTL time.Duration
+
+	tenders       repository.TenderRepository
+	bids          repository.BidRepository
+	feedbacks     repository.FeedbackRepository
+	employees     repository.EmployeeRepository
+	organizations repository.OrganizationRepository
+	emails        repository.EmailRepository
+	snapshots     repository.SnapshotRepository
+	protocols     repository.ProtocolRepository
+	serviceTypes  repository.ServiceTypeRepository
+	searches      repository.SavedSearchRepository
+	auctions      repository.AuctionRepository
+	seals         repository.SealRepository
+	audit         repository.AuditRepository
+}
+
+func NewService(db *gorm.DB, m mailer.Mailer) *Service {
+	return NewServiceWithRepositories(repository.NewPostgres(db), m)
+}
+
+// NewServiceWithRepositories создает сервис поверх заданных репозиториев,
+// например repository.NewMemory() для тестов и локального запуска
+func NewServiceWithRepositories(repos repository.Repositories, m mailer.Mailer) *Service {
+	return &Service{
+		ctx:           context.Background(),
+		logger:        slog.Default(),
+		mailer:        m,
+		tenders:       repos.Tenders,
+		bids:          repos.Bids,
+		feedbacks:     repos.Feedbacks,
+		employees:     repos.Employees,
+		organizations: repos.Organizations,
+		emails:        repos.Emails,
+		snapshots:     repos.Snapshots,
+		protocols:     repos.Protocols,
+		serviceTypes:  repos.ServiceTypes,
+		searches:      repos.SavedSearches,
+		auctions:      repos.Auctions,
+		seals:         repos.Seals,
+		audit:         repos.Audit,
+	}
+}
+
+// WithContext возвращает копию сервиса, в которой все запросы к хранилищу выполняются в контексте ctx,
+// а сообщения пишутся в логгер запроса
+func (s *Service) WithContext(ctx context.Context) *Service {
+	clone := *s
+	clone.ctx = ctx
+	clone.logger = logging.FromContext(ctx)
+	return &clone
 }
 
-func NewService(db *gorm.DB) *Service {
-	return &Service{db: db}
+// startSpan открывает span метода сервиса; вложенные вызовы через возвращенную копию
+// становятся дочерними span'ами
+func (s *Service) startSpan(name string) (*Service, trace.Span) {
+	ctx, span := tracer.Start(s.ctx, "Service."+name)
+	return s.WithContext(ctx), span
 }
@@ -0,0 +1,210 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"zadanie_6105/src/models"
+	"zadanie_6105/src/repository"
+)
+
+func (s *Service) GetServiceTypes() ([]models.ServiceType, error) {
+	s, span := s.startSpan("GetServiceTypes")
+	defer span.End()
+
+	return s.serviceTypes.List(s.ctx)
+}
+
+func (s *Service) GetServiceType(code string) (*models.ServiceType, error) {
+	s, span := s.startSpan("GetServiceType")
+	defer span.End()
+
+	serviceType, err := s.serviceTypes.Get(s.ctx, code)
+	if err != nil {
+		return nil, notFound("service type", err)
+	}
+	return serviceType, nil
+}
+
+// ServiceTypeCodes возвращает множество кодов справочника для проверки тендеров
+func (s *Service) ServiceTypeCodes() (map[string]bool, error) {
+	s, span := s.startSpan("ServiceTypeCodes")
+	defer span.End()
+
+	serviceTypes, err := s.serviceTypes.List(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+	codes := make(map[string]bool, len(serviceTypes))
+	for _, serviceType := range serviceTypes {
+		codes[serviceType.Code] = true
+	}
+	return codes, nil
+}
+
+// serviceTypeTree - коды справочника и подкатегории каждого кода
+type serviceTypeTree struct {
+	known    map[string]bool
+	children map[string][]string
+}
+
+func (s *Service) loadServiceTypeTree() (*serviceTypeTree, error) {
+	serviceTypes, err := s.serviceTypes.List(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+	tree := &serviceTypeTree{known: map[string]bool{}, children: map[string][]string{}}
+	for _, serviceType := range serviceTypes {
+		tree.known[serviceType.Code] = true
+		if serviceType.ParentCode != nil {
+			tree.children[*serviceType.ParentCode] = append(tree.children[*serviceType.ParentCode], serviceType.Code)
+		}
+	}
+	return tree, nil
+}
+
+// expand возвращает коды без повторов, с includeSubcategories - вместе со всеми подкатегориями
+func (t *serviceTypeTree) expand(codes []string, includeSubcategories bool) []string {
+	var result []string
+	added := map[string]bool{}
+	var add func(code string)
+	add = func(code string) {
+		if added[code] {
+			return
+		}
+		added[code] = true
+		result = append(result, code)
+		if includeSubcategories {
+			for _, child := range t.children[code] {
+				add(child)
+			}
+		}
+	}
+	for _, code := range codes {
+		add(code)
+	}
+	return result
+}
+
+// ResolveServiceTypes проверяет коды фильтра по справочнику и, если нужно,
+// добавляет к ним все подкатегории. Неизвестный код - ErrInvalidInput.
+func (s *Service) ResolveServiceTypes(codes []string, includeSubcategories bool) ([]string, error) {
+	s, span := s.startSpan("ResolveServiceTypes")
+	defer span.End()
+
+	if len(codes) == 0 {
+		return nil, nil
+	}
+	tree, err := s.loadServiceTypeTree()
+	if err != nil {
+		return nil, err
+	}
+	for _, code := range codes {
+		if !tree.known[code] {
+			return nil, fmt.Errorf("%w: unknown service type %q", ErrInvalidInput, code)
+		}
+	}
+	return tree.expand(codes, includeSubcategories), nil
+}
+
+// checkParent проверяет, что родитель существует и не приводит к циклу
+func (s *Service) checkParent(code, parentCode string) error {
+	for current := parentCode; current != ""; {
+		if current == code {
+			return fmt.Errorf("%w: service type %q can not be a subcategory of itself", ErrConflict, code)
+		}
+		parent, err := s.serviceTypes.Get(s.ctx, current)
+		if err != nil {
+			if current == parentCode {
+				return notFound("parent service type", err)
+			}
+			return err
+		}
+		current = ""
+		if parent.ParentCode != nil {
+			current = *parent.ParentCode
+		}
+	}
+	return nil
+}
+
+func (s *Service) CreateServiceType(serviceType *models.ServiceType) error {
+	s, span := s.startSpan("CreateServiceType")
+	defer span.End()
+
+	if serviceType.ParentCode != nil {
+		if err := s.checkParent(serviceType.Code, *serviceType.ParentCode); err != nil {
+			return err
+		}
+	}
+	if err := s.serviceTypes.Create(s.ctx, serviceType); err != nil {
+		if errors.Is(err, repository.ErrDuplicate) {
+			return fmt.Errorf("service type %q already exists: %w", serviceType.Code, ErrConflict)
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *Service) UpdateServiceType(code string, edit *models.ServiceTypeEdit) (*models.ServiceType, error) {
+	s, span := s.startSpan("UpdateServiceType")
+	defer span.End()
+
+	serviceType, err := s.GetServiceType(code)
+	if err != nil {
+		return nil, err
+	}
+
+	if edit.ParentCode != nil {
+		if *edit.ParentCode == "" {
+			serviceType.ParentCode = nil
+		} else {
+			if err := s.checkParent(code, *edit.ParentCode); err != nil {
+				return nil, err
+			}
+			parentCode := *edit.ParentCode
+			serviceType.ParentCode = &parentCode
+		}
+	}
+	if serviceType.Names == nil {
+		serviceType.Names = map[string]string{}
+	}
+	for locale, name := range edit.Names {
+		if name == "" {
+			delete(serviceType.Names, locale)
+		} else {
+			serviceType.Names[locale] = name
+		}
+	}
+
+	if err := s.serviceTypes.Save(s.ctx, serviceType); err != nil {
+		return nil, err
+	}
+	return serviceType, nil
+}
+
+// DeleteServiceType удаляет вид услуг без подкатегорий, который не встречается ни в одной версии тендеров
+func (s *Service) DeleteServiceType(code string) error {
+	s, span := s.startSpan("DeleteServiceType")
+	defer span.End()
+
+	if _, err := s.GetServiceType(code); err != nil {
+		return err
+	}
+	serviceTypes, err := s.serviceTypes.List(s.ctx)
+	if err != nil {
+		return err
+	}
+	for _, serviceType := range serviceTypes {
+		if serviceType.ParentCode != nil && *serviceType.ParentCode == code {
+			return fmt.Errorf("%w: service type %q has subcategories", ErrConflict, code)
+		}
+	}
+	count, err := s.serviceTypes.CountTenders(s.ctx, code)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return fmt.Errorf("%w: service type %q is used by %d tenders", ErrConflict, code, count)
+	}
+	return s.serviceTypes.Delete(s.ctx, code)
+}
@@ -0,0 +1,89 @@
+package services
+
+import (
+	"github.com/google/uuid"
+	"testing"
+	"zadanie_6105/src/mailer"
+	"zadanie_6105/src/models"
+	"zadanie_6105/src/repository"
+)
+
+// testEnv - сервис поверх репозиториев в памяти с одной организацией
+type testEnv struct {
+	t            *testing.T
+	service      *Service
+	organization uuid.UUID
+}
+
+func newTestEnv(t *testing.T) *testEnv {
+	t.Helper()
+	service := NewServiceWithRepositories(repository.NewMemory(), mailer.LogMailer{})
+	organization := models.Organization{Name: "Заказчик", Type: "LLC"}
+	if err := service.CreateOrganization(&organization); err != nil {
+		t.Fatalf("CreateOrganization: %v", err)
+	}
+	return &testEnv{
+		t:            t,
+		service:      service,
+		organization: organization.ID,
+	}
+}
+
+// employee создает сотрудника; с непустой ролью он становится ответственным организации
+func (e *testEnv) employee(username string, role string) *models.Employee {
+	e.t.Helper()
+	employee := models.Employee{Username: username}
+	if err := e.service.CreateEmployee(&employee); err != nil {
+		e.t.Fatalf("CreateEmployee(%s): %v", username, err)
+	}
+	if role != "" {
+		_, err := e.service.AssignResponsible(e.organization.String(), employee.ID.String(), role)
+		if err != nil {
+			e.t.Fatalf("AssignResponsible(%s): %v", username, err)
+		}
+	}
+	return &employee
+}
+
+func (e *testEnv) tender(name string, status string) *models.Tender {
+	e.t.Helper()
+	tender := models.Tender{
+		Name:           name,
+		Description:    "Описание",
+		ServiceType:    "Delivery",
+		OrganizationId: e.organization.String(),
+	}
+	if err := e.service.CreateTender(&tender); err != nil {
+		e.t.Fatalf("CreateTender: %v", err)
+	}
+	if status != "" {
+		updated, err := e.service.UpdateTenderStatus(tender.ID.String(), status)
+		if err != nil {
+			e.t.Fatalf("UpdateTenderStatus: %v", err)
+		}
+		tender = *updated
+	}
+	return &tender
+}
+
+func (e *testEnv) bid(tender *models.Tender, author *models.Employee, status string) *models.Bid {
+	e.t.Helper()
+	bid := models.Bid{
+		Name:        "Предложение",
+		Description: "Описание",
+		TenderId:    tender.ID,
+		AuthorType:  "User",
+		AuthorId:    author.ID,
+	}
+	if err := e.service.CreateBid(&bid); err != nil {
+		e.t.Fatalf("CreateBid: %v", err)
+	}
+	if status != "" {
+		updated, err := e.service.UpdateBidStatus(bid.ID.String(), status)
+		if err != nil {
+			e.t.Fatalf("UpdateBidStatus: %v", err)
+		}
+		bid = *updated
+	}
+	return &bid
+}
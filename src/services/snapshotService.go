@@ -0,0 +1,28 @@
+package services
+
+import (
+	"zadanie_6105/src/repository"
+)
+
+// Snapshot - выгрузка данных сервиса со всеми версиями тендеров и предложений
+type Snapshot = repository.Snapshot
+
+func (s *Service) ExportSnapshot() (*Snapshot, error) {
+	s, span := s.startSpan("ExportSnapshot")
+	defer span.End()
+
+	return s.snapshots.Export(s.ctx)
+}
+
+// ImportSnapshot добавляет записи снимка в одной транзакции.
+// Записи с уже существующим первичным ключом пропускаются.
+func (s *Service) ImportSnapshot(snapshot *Snapshot) error {
+	s, span := s.startSpan("ImportSnapshot")
+	defer span.End()
+
+	if err := s.snapshots.Import(s.ctx, snapshot); err != nil {
+		return err
+	}
+	s.invalidateTenderPages()
+	return nil
+}
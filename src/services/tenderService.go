@@ -1,122 +1,131 @@
 package services
 
 import (
-	"errors"
+	"fmt"
 	"github.com/google/uuid"
+	"zadanie_6105/src/metrics"
 	"zadanie_6105/src/models"
 )
 
-func (s *Service) CheckIfUserIsResponsible(creatorUsername string, organizationID string) (bool, error) {
-	var count int64
-
-	err := s.db.Table("organization_responsible").
-		Select("COUNT(*)").
-		Joins("JOIN employee ON employee.id = organization_responsible.user_id").
-		Joins("JOIN organization ON organization.id = organization_responsible.organization_id").
-		Where("employee.username = ?", creatorUsername).
-		Where("organization.id = ?", organizationID).
-		Count(&count).Error
+func (s *Service) CheckIfTenderPublished(tenderId string) (bool, error) {
+	s, span := s.startSpan("CheckIfTenderPublished")
+	defer span.End()
 
+	tender, err := s.getTenderLastVersion(tenderId)
 	if err != nil {
 		return false, err
 	}
-
-	if count > 0 {
-		return true, nil
-	}
-	return false, errors.New("the user is not responsible for the organization")
+	return tender.Status == "Published", nil
 }
 
-func (s *Service) CheckIfUserIsResponsibleForTender(creatorUsername string, tenderID string) (bool, error) {
-	tender, err := s.getTenderLastVersion(tenderID)
-	if err != nil {
-		return false, err
-	}
-	organizationID := tender.OrganizationId
+func (s *Service) GetTenders(filter *models.TenderFilter, limit, offset int) (*[]models.Tender, error) {
+	s, span := s.startSpan("GetTenders")
+	defer span.End()
 
-	return s.CheckIfUserIsResponsible(creatorUsername, organizationID)
-}
-
-func (s *Service) CheckIfTenderPublished(tenderId string) (bool, error) {
-	tender, err := s.getTenderLastVersion(tenderId)
+	page, err := s.GetTenderPage(filter, limit, offset)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
-	return tender.Status == "Published", nil
+	return &page.Tenders, nil
 }
 
-func (s *Service) GetTenders(serviceTypes []string, limit, offset int) (*[]models.Tender, error) {
-	var tenders []models.Tender
-
-	subQuery := s.db.Table("tenders as t1").
-		Select("MAX(t1.version)").
-		Where("t1.id = tenders.id").
-		Where("t1.status = ?", "Published")
-	query := s.db.Where("version = (?)", subQuery).
-		Where("status = ?", "Published")
-
-	if len(serviceTypes) > 0 {
-		query = query.Where("service_type IN ?", serviceTypes)
-	}
+func (s *Service) CreateTender(tender *models.Tender) error {
+	s, span := s.startSpan("CreateTender")
+	defer span.End()
 
-	if limit > 0 {
-		query = query.Limit(limit)
-	}
-	if offset > 0 {
-		query = query.Offset(offset)
+	if err := s.tenders.Create(s.ctx, tender); err != nil {
+		return err
 	}
-
-	err := query.Find(&tenders).Error
-
-	return &tenders, err
+	s.invalidateTenderPages()
+	metrics.TendersCreated.Inc()
+	return nil
 }
 
-func (s *Service) CreateTender(tender *models.Tender) error {
-	if err := s.db.Create(tender).Error; err != nil {
+// ImportTenders создает все тендеры в одной транзакции: при ошибке не создается ни один
+func (s *Service) ImportTenders(tenders []models.Tender) error {
+	s, span := s.startSpan("ImportTenders")
+	defer span.End()
+
+	if err := s.tenders.CreateMany(s.ctx, tenders); err != nil {
 		return err
 	}
+	s.invalidateTenderPages()
+	metrics.TendersCreated.Add(float64(len(tenders)))
 	return nil
 }
 
 func (s *Service) GetTendersByUser(username string, limit, offset int) (*[]models.Tender, error) {
-	var tenders []models.Tender
+	s, span := s.startSpan("GetTendersByUser")
+	defer span.End()
 
-	subQuery := s.db.Table("tenders as t1").
-		Select("MAX(version)").
-		Where("t1.id = tenders.id")
-	query := s.db.Joins("JOIN organization_responsible ON organization_responsible.organization_id = tenders.organization_id").
-		Joins("JOIN employee ON employee.id = organization_responsible.user_id").
-		Where("employee.username = ?", username).
-		Where("version = (?)", subQuery)
+	employee, err := s.getEmployeeByUsername(username)
+	if err != nil {
+		return nil, err
+	}
 
-	if limit > 0 {
-		query = query.Limit(limit)
+	responsibilities, err := s.employees.ListResponsibilities(s.ctx, employee.ID)
+	if err != nil {
+		return nil, err
 	}
-	if offset > 0 {
-		query = query.Offset(offset)
+	var organizationIDs []string
+	for _, responsible := range responsibilities {
+		organizationIDs = append(organizationIDs, responsible.OrganizationID.String())
 	}
 
-	err := query.Find(&tenders).Error
-
+	tenders, err := s.tenders.ListByOrganizations(s.ctx, organizationIDs, limit, offset)
 	return &tenders, err
 }
 
 func (s *Service) getTenderLastVersion(id string) (*models.Tender, error) {
-	var tender models.Tender
+	s, span := s.startSpan("getTenderLastVersion")
+	defer span.End()
 
 	tenderID, err := uuid.Parse(id)
 	if err != nil {
-		return nil, errors.New("invalid tender ID format")
+		return nil, invalidID("tender")
+	}
+
+	tender, err := s.tenders.GetLast(s.ctx, tenderID)
+	if err != nil {
+		return nil, notFound("tender", err)
+	}
+	return tender, nil
+}
+
+func (s *Service) GetTender(id string) (*models.Tender, error) {
+	s, span := s.startSpan("GetTender")
+	defer span.End()
+
+	return s.getTenderLastVersion(id)
+}
+
+// GetTenderVersions возвращает все версии тендера по возрастанию номера
+func (s *Service) GetTenderVersions(id string) (*[]models.Tender, error) {
+	s, span := s.startSpan("GetTenderVersions")
+	defer span.End()
+
+	tender, err := s.getTenderLastVersion(id)
+	if err != nil {
+		return nil, err
 	}
 
-	err = s.db.Where("id = ?", tenderID).
-		Order("version DESC").
-		First(&tender).Error
+	tenders, err := s.tenders.ListVersions(s.ctx, tender.ID)
+	return &tenders, err
+}
+
+// ListTenders возвращает последние версии тендеров в любом статусе; пустой status - без фильтра
+func (s *Service) ListTenders(status string, limit, offset int) (*[]models.Tender, error) {
+	s, span := s.startSpan("ListTenders")
+	defer span.End()
 
-	return &tender, err
+	tenders, err := s.tenders.List(s.ctx, status, limit, offset)
+	return &tenders, err
 }
 
 func (s *Service) GetTenderStatus(id string) (string, error) {
+	s, span := s.startSpan("GetTenderStatus")
+	defer span.End()
+
 	tender, err := s.getTenderLastVersion(id)
 	if err != nil {
 		return "", err
@@ -125,18 +134,36 @@ func (s *Service) GetTenderStatus(id string) (string, error) {
 }
 
 func (s *Service) UpdateTenderStatus(id string, status string) (*models.Tender, error) {
+	s, span := s.startSpan("UpdateTenderStatus")
+	defer span.End()
+
 	tender, err := s.getTenderLastVersion(id)
 	if err != nil {
 		return nil, err
 	}
+	previousStatus := tender.Status
 	tender.Status = status
-	if err := s.db.Save(tender).Error; err != nil {
+	if err := s.tenders.Save(s.ctx, tender); err != nil {
 		return nil, err
 	}
+	s.invalidateTenderPages()
+
+	if status != previousStatus {
+		switch status {
+		case "Published":
+			metrics.TendersPublished.Inc()
+			s.notifySavedSearches(tender, false)
+		case "Closed":
+			metrics.TendersClosed.Inc()
+		}
+	}
 	return tender, nil
 }
 
 func (s *Service) UpdateTender(id string, edit *models.TenderEdit) (*models.Tender, error) {
+	s, span := s.startSpan("UpdateTender")
+	defer span.End()
+
 	tender, err := s.getTenderLastVersion(id)
 	if err != nil {
 		return nil, err
@@ -149,6 +176,12 @@ func (s *Service) UpdateTender(id string, edit *models.TenderEdit) (*models.Tend
 		ServiceType:    tender.ServiceType,
 		Status:         tender.Status,
 		OrganizationId: tender.OrganizationId,
+		Deadline:       tender.Deadline,
+		Budget:         tender.Budget,
+		Region:         tender.Region,
+		Rounds:         tender.Rounds,
+		Round:          tender.Round,
+		Sealed:         tender.Sealed,
 		Version:        tender.Version + 1,
 	}
 	if edit.Name != "" {
@@ -160,27 +193,58 @@ func (s *Service) UpdateTender(id string, edit *models.TenderEdit) (*models.Tend
 	if edit.ServiceType != "" {
 		newTender.ServiceType = edit.ServiceType
 	}
+	if edit.Deadline != nil {
+		newTender.Deadline = edit.Deadline
+	}
+	if edit.Budget != nil {
+		newTender.Budget = edit.Budget
+	}
+	// Пустой регион снимает регион с тендера
+	if edit.Region != nil {
+		newTender.Region = *edit.Region
+	}
+	if edit.Rounds != nil {
+		if *edit.Rounds < newTender.Round {
+			return nil, fmt.Errorf("%w: tender is already in round %d", ErrConflict, newTender.Round)
+		}
+		newTender.Rounds = *edit.Rounds
+	}
+	if err := checkSealedDeadline(tender, &newTender); err != nil {
+		return nil, err
+	}
+	if err := s.checkAuctionEdit(tender, &newTender); err != nil {
+		return nil, err
+	}
+	if err := checkSealedRounds(&newTender); err != nil {
+		return nil, err
+	}
 
-	if err := s.db.Create(&newTender).Error; err != nil {
+	if err := s.tenders.Create(s.ctx, &newTender); err != nil {
 		return nil, err
 	}
+	s.invalidateTenderPages()
+	if newTender.Status == "Published" {
+		s.notifySavedSearches(&newTender, true)
+	}
 
 	return &newTender, nil
 }
 
 func (s *Service) RollbackTender(id string, version int32) (*models.Tender, error) {
-	var tender models.Tender
+	s, span := s.startSpan("RollbackTender")
+	defer span.End()
 
-	err := s.db.Where("id = ? AND version = ?", id, version).First(&tender).Error
+	lastTender, err := s.getTenderLastVersion(id)
 	if err != nil {
 		return nil, err
 	}
 
-	lastTender, err := s.getTenderLastVersion(id)
+	tender, err := s.tenders.GetVersion(s.ctx, lastTender.ID, version)
 	if err != nil {
-		return nil, err
+		return nil, notFound("tender version", err)
 	}
 
+	// Пройденные раунды не откатываются: текущий раунд сохраняется, а раундов не меньше, чем уже идет
 	newTender := models.Tender{
 		ID:             tender.ID,
 		Name:           tender.Name,
@@ -188,11 +252,27 @@ func (s *Service) RollbackTender(id string, version int32) (*models.Tender, erro
 		ServiceType:    tender.ServiceType,
 		Status:         tender.Status,
 		OrganizationId: tender.OrganizationId,
+		Deadline:       tender.Deadline,
+		Budget:         tender.Budget,
+		Region:         tender.Region,
+		Rounds:         max(tender.Rounds, lastTender.Round),
+		Round:          lastTender.Round,
+		Sealed:         lastTender.Sealed,
 		Version:        lastTender.Version + 1,
 	}
+	if err := checkSealedDeadline(lastTender, &newTender); err != nil {
+		return nil, err
+	}
+	if err := s.checkAuctionEdit(lastTender, &newTender); err != nil {
+		return nil, err
+	}
+	if err := checkSealedRounds(&newTender); err != nil {
+		return nil, err
+	}
 
-	if err := s.db.Create(&newTender).Error; err != nil {
+	if err := s.tenders.Create(s.ctx, &newTender); err != nil {
 		return nil, err
 	}
+	s.invalidateTenderPages()
 	return &newTender, nil
 }
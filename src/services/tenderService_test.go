@@ -0,0 +1,361 @@
+package services
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"slices"
+	"testing"
+	"time"
+	"zadanie_6105/src/cache"
+	"zadanie_6105/src/models"
+)
+
+func tenderNames(tenders *[]models.Tender) []string {
+	names := []string{}
+	for _, tender := range *tenders {
+		names = append(names, tender.Name)
+	}
+	return names
+}
+
+func TestGetTendersShowsOnlyPublished(t *testing.T) {
+	e := newTestEnv(t)
+	e.tender("Черновик", "")
+	e.tender("Закрытый", "Closed")
+	e.tender("Б", "Published")
+	e.tender("А", "Published")
+
+	tenders, err := e.service.GetTenders(nil, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := tenderNames(tenders)
+	if len(got) != 2 || got[0] != "А" || got[1] != "Б" {
+		t.Errorf("GetTenders = %v, want [А Б]", got)
+	}
+
+	tenders, err = e.service.GetTenders(&models.TenderFilter{ServiceTypes: []string{"Construction"}}, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*tenders) != 0 {
+		t.Errorf("GetTenders(Construction) = %v, want none", tenderNames(tenders))
+	}
+
+	tenders, err = e.service.GetTenders(nil, 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := tenderNames(tenders); len(got) != 1 || got[0] != "Б" {
+		t.Errorf("GetTenders(limit=1, offset=1) = %v, want [Б]", got)
+	}
+}
+
+func TestGetTendersByUser(t *testing.T) {
+	e := newTestEnv(t)
+	viewer := e.employee("viewer", models.RoleViewer)
+	outsider := e.employee("outsider", "")
+	e.tender("Черновик", "")
+
+	tenders, err := e.service.GetTendersByUser(viewer.Username, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*tenders) != 1 {
+		t.Errorf("GetTendersByUser(viewer) = %v, want the draft", tenderNames(tenders))
+	}
+
+	tenders, err = e.service.GetTendersByUser(outsider.Username, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*tenders) != 0 {
+		t.Errorf("GetTendersByUser(outsider) = %v, want none", tenderNames(tenders))
+	}
+
+	if _, err := e.service.GetTendersByUser("nobody", 0, 0); !errors.Is(err, ErrUserNotFound) {
+		t.Errorf("GetTendersByUser(nobody) error = %v, want ErrUserNotFound", err)
+	}
+}
+
+func TestTenderVersioning(t *testing.T) {
+	e := newTestEnv(t)
+	tender := e.tender("Доставка", "")
+	id := tender.ID.String()
+
+	edited, err := e.service.UpdateTender(id, &models.TenderEdit{Name: "Доставка 2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if edited.Version != 2 || edited.Name != "Доставка 2" || edited.Description != tender.Description {
+		t.Errorf("UpdateTender = version %d name %q, want version 2 name %q", edited.Version, edited.Name, "Доставка 2")
+	}
+
+	rolledBack, err := e.service.RollbackTender(id, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rolledBack.Version != 3 || rolledBack.Name != "Доставка" {
+		t.Errorf("RollbackTender = version %d name %q, want version 3 name %q", rolledBack.Version, rolledBack.Name, "Доставка")
+	}
+
+	versions, err := e.service.GetTenderVersions(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*versions) != 3 {
+		t.Fatalf("GetTenderVersions returned %d versions, want 3", len(*versions))
+	}
+	for i, version := range *versions {
+		if version.Version != int32(i+1) || version.ID != tender.ID {
+			t.Errorf("version %d = %d of %s", i, version.Version, version.ID)
+		}
+	}
+
+	if _, err := e.service.RollbackTender(id, 10); !errors.Is(err, ErrNotFound) {
+		t.Errorf("RollbackTender(10) error = %v, want ErrNotFound", err)
+	}
+	if _, err := e.service.GetTender("not-a-uuid"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetTender(invalid) error = %v, want ErrNotFound", err)
+	}
+}
+
+// Статус меняется в текущей версии, а новая версия наследует его
+func TestTenderStatusKeptAcrossVersions(t *testing.T) {
+	e := newTestEnv(t)
+	tender := e.tender("Доставка", "Published")
+
+	edited, err := e.service.UpdateTender(tender.ID.String(), &models.TenderEdit{Description: "Новое описание"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if edited.Status != "Published" {
+		t.Errorf("edited status = %q, want Published", edited.Status)
+	}
+
+	status, err := e.service.GetTenderStatus(tender.ID.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status != "Published" {
+		t.Errorf("GetTenderStatus = %q, want Published", status)
+	}
+}
+
+// Закешированная страница сбрасывается при любом изменении тендеров
+func TestGetTenderPageCache(t *testing.T) {
+	e := newTestEnv(t)
+	e.service.UseCache(cache.NewMemoryStore(), time.Minute)
+	tender := e.tender("А", "Published")
+
+	first, err := e.service.GetTenderPage(nil, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cached, err := e.service.GetTenderPage(nil, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cached.ModifiedAt.Equal(first.ModifiedAt) {
+		t.Errorf("ModifiedAt changed without edits: %v, %v", first.ModifiedAt, cached.ModifiedAt)
+	}
+
+	changes := []struct {
+		name   string
+		change func() error
+		want   []string
+	}{
+		{"edit", func() error {
+			_, err := e.service.UpdateTender(tender.ID.String(), &models.TenderEdit{Name: "Б"})
+			return err
+		}, []string{"Б"}},
+		{"rollback", func() error {
+			_, err := e.service.RollbackTender(tender.ID.String(), 1)
+			return err
+		}, []string{"А"}},
+		{"create", func() error {
+			return e.service.CreateTender(&models.Tender{
+				Name: "В", Status: "Published", ServiceType: "Delivery", OrganizationId: e.organization.String(),
+			})
+		}, []string{"А", "В"}},
+		{"status", func() error {
+			_, err := e.service.UpdateTenderStatus(tender.ID.String(), "Closed")
+			return err
+		}, []string{"В"}},
+	}
+	for _, tt := range changes {
+		if err := tt.change(); err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		page, err := e.service.GetTenderPage(nil, 0, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := tenderNames(&page.Tenders)
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("after %s GetTenderPage = %v, want %v", tt.name, got, tt.want)
+		}
+		if page.ModifiedAt.Before(first.ModifiedAt) {
+			t.Errorf("after %s ModifiedAt went back: %v", tt.name, page.ModifiedAt)
+		}
+	}
+}
+
+// Импорт создает все тендеры или ни одного, срок и бюджет переходят в новые версии
+func TestImportTenders(t *testing.T) {
+	e := newTestEnv(t)
+	e.employee("admin", "admin")
+	existing := e.tender("Доставка", "")
+
+	deadline := time.Date(2030, 1, 31, 0, 0, 0, 0, time.UTC)
+	budget := 1500.5
+	tenders := []models.Tender{
+		{Name: "Ремонт", Description: "Описание", ServiceType: "Construction", OrganizationId: e.organization.String(), Deadline: &deadline, Budget: &budget},
+		{ID: existing.ID, Name: "Дубликат", Description: "Описание", ServiceType: "Delivery", OrganizationId: e.organization.String()},
+	}
+	if err := e.service.ImportTenders(tenders); err == nil {
+		t.Fatal("ImportTenders with an existing id succeeded")
+	}
+	mine, err := e.service.GetTendersByUser("admin", 10, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*mine) != 1 {
+		t.Fatalf("failed import left %d tenders, want 1", len(*mine))
+	}
+
+	tenders = tenders[:1]
+	if err := e.service.ImportTenders(tenders); err != nil {
+		t.Fatal(err)
+	}
+	edited, err := e.service.UpdateTender(tenders[0].ID.String(), &models.TenderEdit{Name: "Ремонт 2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if edited.Deadline == nil || !edited.Deadline.Equal(deadline) || edited.Budget == nil || *edited.Budget != budget {
+		t.Errorf("edited tender deadline %v budget %v, want %v and %v", edited.Deadline, edited.Budget, deadline, budget)
+	}
+}
+
+// В архив попадают все версии тендера и опубликованных предложений, черновики - нет
+func TestGetTenderArchive(t *testing.T) {
+	e := newTestEnv(t)
+	author := e.employee("author", "")
+	approver := e.employee("approver", models.RoleApprover)
+	tender := e.tender("Доставка", "Published")
+	if _, err := e.service.UpdateTender(tender.ID.String(), &models.TenderEdit{Name: "Доставка 2"}); err != nil {
+		t.Fatal(err)
+	}
+	bid := e.bid(tender, author, "Published")
+	if _, err := e.service.UpdateBid(bid.ID.String(), &models.BidEdit{Name: "Предложение 2"}); err != nil {
+		t.Fatal(err)
+	}
+	draft := e.bid(tender, author, "")
+	if _, err := e.service.SubmitBid(bid.ID.String(), approver.Username, true); err != nil {
+		t.Fatal(err)
+	}
+	for _, target := range []*models.Bid{bid, draft} {
+		if _, err := e.service.CreateFeedback(&models.BidFeedback{Description: "Отзыв", BidId: target.ID}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	second := e.bid(tender, e.employee("second", ""), "Published")
+	archive, err := e.service.GetTenderArchive(tender.ID.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Страницы по одному предложению: архив все равно обходит их все
+	for _, pageSize := range []int{archivePageSize, 1} {
+		archive.pageSize = pageSize
+		var tenders, decisions, feedbacks int
+		bids := map[uuid.UUID]int{}
+		err := errors.Join(
+			archive.Tenders(func(*models.Tender) error { tenders++; return nil }),
+			archive.Bids(func(version *models.Bid) error { bids[version.ID]++; return nil }),
+			archive.Decisions(func(*models.BidDecision) error { decisions++; return nil }),
+			archive.Feedbacks(func(*models.BidFeedback) error { feedbacks++; return nil }),
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tenders != 2 || len(bids) != 2 || bids[bid.ID] != 2 || bids[second.ID] != 1 || decisions != 1 || feedbacks != 1 {
+			t.Errorf("page size %d: archive has %d tenders, bid versions %v, %d decisions, %d feedbacks; want 2, 2+1, 1, 1",
+				pageSize, tenders, bids, decisions, feedbacks)
+		}
+	}
+	failed := errors.New("write failed")
+	if err := archive.Bids(func(*models.Bid) error { return failed }); !errors.Is(err, failed) {
+		t.Errorf("Bids error = %v, want the callback error", err)
+	}
+
+	if _, err := e.service.GetTenderArchive("550e8400-e29b-41d4-a716-446655440000"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetTenderArchive(unknown) error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestServiceTypeCatalog(t *testing.T) {
+	e := newTestEnv(t)
+	construction := "Construction"
+	roadworks := "Roadworks"
+	for _, serviceType := range []models.ServiceType{
+		{Code: roadworks, ParentCode: &construction, Names: map[string]string{"ru": "Дорожные работы"}},
+		{Code: "Bridges", ParentCode: &roadworks, Names: map[string]string{"ru": "Мосты"}},
+	} {
+		if err := e.service.CreateServiceType(&serviceType); err != nil {
+			t.Fatalf("CreateServiceType(%s): %v", serviceType.Code, err)
+		}
+	}
+	if err := e.service.CreateServiceType(&models.ServiceType{Code: "Roadworks"}); !errors.Is(err, ErrConflict) {
+		t.Errorf("CreateServiceType(duplicate) error = %v, want ErrConflict", err)
+	}
+	missing := "Missing"
+	if err := e.service.CreateServiceType(&models.ServiceType{Code: "Paving", ParentCode: &missing}); !errors.Is(err, ErrNotFound) {
+		t.Errorf("CreateServiceType(unknown parent) error = %v, want ErrNotFound", err)
+	}
+
+	codes, err := e.service.ResolveServiceTypes([]string{"Construction", "Delivery"}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	slices.Sort(codes)
+	if want := []string{"Bridges", "Construction", "Delivery", "Roadworks"}; !slices.Equal(codes, want) {
+		t.Errorf("ResolveServiceTypes(include subcategories) = %v, want %v", codes, want)
+	}
+	if codes, err = e.service.ResolveServiceTypes([]string{"Roadworks"}, false); err != nil || !slices.Equal(codes, []string{"Roadworks"}) {
+		t.Errorf("ResolveServiceTypes(Roadworks) = %v, %v", codes, err)
+	}
+	if _, err := e.service.ResolveServiceTypes([]string{"Cleaning"}, true); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("ResolveServiceTypes(unknown) error = %v, want ErrInvalidInput", err)
+	}
+
+	bridges := "Bridges"
+	if _, err := e.service.UpdateServiceType("Construction", &models.ServiceTypeEdit{ParentCode: &bridges}); !errors.Is(err, ErrConflict) {
+		t.Errorf("UpdateServiceType(cycle) error = %v, want ErrConflict", err)
+	}
+	root := ""
+	updated, err := e.service.UpdateServiceType("Bridges", &models.ServiceTypeEdit{
+		ParentCode: &root,
+		Names:      map[string]string{"en": "Bridges", "ru": ""},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.ParentCode != nil || updated.Name("ru", "en") != "Bridges" {
+		t.Errorf("UpdateServiceType = parent %v, names %v", updated.ParentCode, updated.Names)
+	}
+
+	if err := e.service.DeleteServiceType("Construction"); !errors.Is(err, ErrConflict) {
+		t.Errorf("DeleteServiceType(with subcategories) error = %v, want ErrConflict", err)
+	}
+	e.tender("Доставка", "")
+	if err := e.service.DeleteServiceType("Delivery"); !errors.Is(err, ErrConflict) {
+		t.Errorf("DeleteServiceType(in use) error = %v, want ErrConflict", err)
+	}
+	if err := e.service.DeleteServiceType("Bridges"); err != nil {
+		t.Fatalf("DeleteServiceType: %v", err)
+	}
+	if _, err := e.service.GetServiceType("Bridges"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetServiceType(deleted) error = %v, want ErrNotFound", err)
+	}
+}
@@ -0,0 +1,134 @@
+// Package tabular читает табличные файлы CSV и XLSX, которые загружают пользователи,
+// и выгружает таблицы в CSV, XLSX и JSON Lines.
+package tabular
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"github.com/xuri/excelize/v2"
+	"io"
+	"mime"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	FormatCSV  = "csv"
+	FormatXLSX = "xlsx"
+
+	// MaxUnzipSize - предел распакованного XLSX. Файл на импорт не больше 10 МБ, и без предела
+	// маленький архив мог бы распаковаться в гигабайты.
+	MaxUnzipSize = 100 << 20
+	// maxUnzipXMLSize - листы больше этого распаковываются во временные файлы, а не в память
+	maxUnzipXMLSize = 16 << 20
+)
+
+var ErrUnknownFormat = errors.New("file must be CSV or XLSX")
+
+// DetectFormat определяет формат по Content-Type, а если он не задан - по расширению имени файла
+func DetectFormat(contentType, filename string) (string, error) {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch mediaType {
+	case "text/csv", "application/csv":
+		return FormatCSV, nil
+	case ContentType(FormatXLSX):
+		return FormatXLSX, nil
+	}
+
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".csv":
+		return FormatCSV, nil
+	case ".xlsx":
+		return FormatXLSX, nil
+	}
+	return "", ErrUnknownFormat
+}
+
+// Read возвращает все строки файла; для XLSX читается первый лист.
+// Значения ячеек обрезаются по краям, номер строки в файле равен индексу плюс один.
+func Read(r io.Reader, format string) ([][]string, error) {
+	var rows [][]string
+	var err error
+	switch format {
+	case FormatCSV:
+		rows, err = readCSV(r)
+	case FormatXLSX:
+		rows, err = readXLSX(r)
+	default:
+		return nil, ErrUnknownFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		for i := range row {
+			row[i] = strings.TrimSpace(row[i])
+		}
+	}
+	return rows, nil
+}
+
+// IsEmpty сообщает, что в строке нет ни одного значения
+func IsEmpty(row []string) bool {
+	for _, value := range row {
+		if value != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func readCSV(r io.Reader) ([][]string, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("invalid CSV: %w", err)
+	}
+	// Excel сохраняет CSV в UTF-8 с BOM
+	if len(rows) > 0 && len(rows[0]) > 0 {
+		rows[0][0] = strings.TrimPrefix(rows[0][0], "\ufeff")
+	}
+	return rows, nil
+}
+
+func readXLSX(r io.Reader) ([][]string, error) {
+	// Без форматирования даты остаются серийными числами и разбираются в ParseTime
+	file, err := excelize.OpenReader(r, excelize.Options{
+		RawCellValue:      true,
+		UnzipSizeLimit:    MaxUnzipSize,
+		UnzipXMLSizeLimit: maxUnzipXMLSize,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid XLSX: %w", err)
+	}
+	defer file.Close()
+
+	sheets := file.GetSheetList()
+	if len(sheets) == 0 {
+		return nil, nil
+	}
+	rows, err := file.GetRows(sheets[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid XLSX: %w", err)
+	}
+	return rows, nil
+}
+
+// ParseTime разбирает дату в RFC3339, в виде 2006-01-02 или серийное число даты Excel
+func ParseTime(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(time.DateOnly, value); err == nil {
+		return t, nil
+	}
+	if serial, err := strconv.ParseFloat(value, 64); err == nil && serial > 0 {
+		return excelize.ExcelDateToTime(serial, false)
+	}
+	return time.Time{}, fmt.Errorf("invalid date %q", value)
+}
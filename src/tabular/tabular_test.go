@@ -0,0 +1,199 @@
+package tabular_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"github.com/xuri/excelize/v2"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+	"zadanie_6105/src/tabular"
+)
+
+func TestReadCSV(t *testing.T) {
+	input := "\ufeffname,budget\n Road , 100\n,\nBridge,\n"
+	rows, err := tabular.Read(strings.NewReader(input), tabular.FormatCSV)
+	if err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+	want := [][]string{{"name", "budget"}, {"Road", "100"}, {"", ""}, {"Bridge", ""}}
+	if !reflect.DeepEqual(rows, want) {
+		t.Fatalf("rows = %q, want %q", rows, want)
+	}
+	if !tabular.IsEmpty(rows[2]) || tabular.IsEmpty(rows[3]) {
+		t.Fatalf("IsEmpty reports wrong rows")
+	}
+}
+
+func TestReadXLSX(t *testing.T) {
+	file := excelize.NewFile()
+	sheet := file.GetSheetName(0)
+	_ = file.SetSheetRow(sheet, "A1", &[]interface{}{"name", "deadline"})
+	_ = file.SetSheetRow(sheet, "A2", &[]interface{}{"Road", time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)})
+	var buf bytes.Buffer
+	if err := file.Write(&buf); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	rows, err := tabular.Read(&buf, tabular.FormatXLSX)
+	if err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+	if len(rows) != 2 || rows[1][0] != "Road" {
+		t.Fatalf("rows = %q", rows)
+	}
+	deadline, err := tabular.ParseTime(rows[1][1])
+	if err != nil {
+		t.Fatalf("ParseTime(%q): %v", rows[1][1], err)
+	}
+	if !deadline.Equal(time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("deadline = %v", deadline)
+	}
+}
+
+// Архив, который распаковывается больше MaxUnzipSize, отклоняется до распаковки
+func TestReadXLSXRejectsZipBomb(t *testing.T) {
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	sheet, err := archive.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	chunk := bytes.Repeat([]byte(" "), 1<<20)
+	for written := 0; written <= tabular.MaxUnzipSize; written += len(chunk) {
+		if _, err := sheet.Write(chunk); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := archive.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if buf.Len() > 1<<20 {
+		t.Fatalf("archive is %d bytes, want a small one", buf.Len())
+	}
+
+	_, err = tabular.Read(&buf, tabular.FormatXLSX)
+	if err == nil || !strings.Contains(err.Error(), "unzip size") {
+		t.Fatalf("Read error = %v, want unzip size limit", err)
+	}
+}
+
+func TestDetectFormat(t *testing.T) {
+	cases := []struct {
+		contentType, filename, want string
+	}{
+		{"text/csv; charset=utf-8", "", tabular.FormatCSV},
+		{"application/octet-stream", "tenders.XLSX", tabular.FormatXLSX},
+		{"", "tenders.csv", tabular.FormatCSV},
+	}
+	for _, c := range cases {
+		got, err := tabular.DetectFormat(c.contentType, c.filename)
+		if err != nil || got != c.want {
+			t.Errorf("DetectFormat(%q, %q) = %q, %v; want %q", c.contentType, c.filename, got, err, c.want)
+		}
+	}
+	if _, err := tabular.DetectFormat("application/json", "tenders.json"); err == nil {
+		t.Errorf("DetectFormat accepted JSON")
+	}
+}
+
+func TestWrite(t *testing.T) {
+	createdAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	tables := []tabular.Table{
+		{Name: "tender", Header: []string{"id", "budget", "createdAt"}, Rows: tabular.StaticRows([]interface{}{"t1", 150.5, createdAt}, []interface{}{"t1", nil, createdAt})},
+		{Name: "bid", Header: []string{"id", "tenderId"}, Rows: tabular.StaticRows([]interface{}{"b1", "t1"})},
+	}
+
+	var csv bytes.Buffer
+	if err := tabular.Write(&csv, tabular.FormatCSV, tables); err != nil {
+		t.Fatalf("Write CSV: %v", err)
+	}
+	wantCSV := "record,id,budget,createdAt,tenderId\n" +
+		"tender,t1,150.5,2025-03-01T12:00:00Z,\n" +
+		"tender,t1,,2025-03-01T12:00:00Z,\n" +
+		"bid,b1,,,t1\n"
+	if csv.String() != wantCSV {
+		t.Errorf("CSV = %q, want %q", csv.String(), wantCSV)
+	}
+
+	var jsonl bytes.Buffer
+	if err := tabular.Write(&jsonl, tabular.FormatJSONL, tables); err != nil {
+		t.Fatalf("Write JSONL: %v", err)
+	}
+	wantJSONL := `{"record":"tender","id":"t1","budget":150.5,"createdAt":"2025-03-01T12:00:00Z"}` + "\n" +
+		`{"record":"tender","id":"t1","createdAt":"2025-03-01T12:00:00Z"}` + "\n" +
+		`{"record":"bid","id":"b1","tenderId":"t1"}` + "\n"
+	if jsonl.String() != wantJSONL {
+		t.Errorf("JSONL = %q, want %q", jsonl.String(), wantJSONL)
+	}
+
+	var xlsx bytes.Buffer
+	if err := tabular.Write(&xlsx, tabular.FormatXLSX, tables); err != nil {
+		t.Fatalf("Write XLSX: %v", err)
+	}
+	file, err := excelize.OpenReader(&xlsx)
+	if err != nil {
+		t.Fatalf("OpenReader: %v", err)
+	}
+	if sheets := file.GetSheetList(); !reflect.DeepEqual(sheets, []string{"tender", "bid"}) {
+		t.Errorf("sheets = %q", sheets)
+	}
+	if budget, _ := file.GetCellValue("tender", "B2"); budget != "150.5" {
+		t.Errorf("tender!B2 = %q, want 150.5", budget)
+	}
+	if rows, _ := file.GetRows("tender"); !reflect.DeepEqual(rows, [][]string{
+		{"id", "budget", "createdAt"}, {"t1", "150.5", "2025-03-01T12:00:00Z"}, {"t1", "", "2025-03-01T12:00:00Z"},
+	}) {
+		t.Errorf("tender rows = %q", rows)
+	}
+
+	// Строки пишутся в XML листа как есть, поэтому спецсимволы должны экранироваться
+	xlsx.Reset()
+	special := []tabular.Table{{Name: "R&D", Header: []string{"description", "sealed"}, Rows: tabular.StaticRows([]interface{}{" <b>A & B</b> ", true})}}
+	if err := tabular.Write(&xlsx, tabular.FormatXLSX, special); err != nil {
+		t.Fatalf("Write XLSX: %v", err)
+	}
+	if file, err = excelize.OpenReader(&xlsx); err != nil {
+		t.Fatalf("OpenReader: %v", err)
+	}
+	if rows, _ := file.GetRows("R&D"); !reflect.DeepEqual(rows, [][]string{{"description", "sealed"}, {" <b>A & B</b> ", "TRUE"}}) {
+		t.Errorf("R&D rows = %q", rows)
+	}
+}
+
+// Ошибка источника строк прерывает выгрузку
+func TestWriteRowsError(t *testing.T) {
+	failed := errors.New("page failed")
+	tables := []tabular.Table{{Name: "bid", Header: []string{"id"}, Rows: func(write func(row []interface{}) error) error {
+		if err := write([]interface{}{"b1"}); err != nil {
+			return err
+		}
+		return failed
+	}}}
+	for _, format := range []string{tabular.FormatCSV, tabular.FormatXLSX, tabular.FormatJSONL} {
+		if err := tabular.Write(io.Discard, format, tables); !errors.Is(err, failed) {
+			t.Errorf("Write %s error = %v, want the source error", format, err)
+		}
+	}
+}
+
+func TestNegotiateFormat(t *testing.T) {
+	cases := map[string]string{
+		"":                         tabular.FormatCSV,
+		"*/*":                      tabular.FormatCSV,
+		"application/x-ndjson":     tabular.FormatJSONL,
+		"application/json, text/*": tabular.FormatCSV,
+		tabular.ContentType(tabular.FormatXLSX) + ";q=0.9": tabular.FormatXLSX,
+	}
+	for accept, want := range cases {
+		if got, ok := tabular.NegotiateFormat(accept); !ok || got != want {
+			t.Errorf("NegotiateFormat(%q) = %q, %v; want %q", accept, got, ok, want)
+		}
+	}
+	if _, ok := tabular.NegotiateFormat("application/json"); ok {
+		t.Errorf("NegotiateFormat accepted application/json")
+	}
+}
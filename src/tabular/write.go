@@ -0,0 +1,281 @@
+package tabular
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"github.com/xuri/excelize/v2"
+	"io"
+	"mime"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const FormatJSONL = "jsonl"
+
+var contentTypes = map[string]string{
+	FormatCSV:   "text/csv",
+	FormatXLSX:  "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	FormatJSONL: "application/jsonl",
+}
+
+// Table - именованная таблица для выгрузки. Значения строк - string, числа, bool,
+// time.Time или nil для пустой ячейки.
+type Table struct {
+	Name   string
+	Header []string
+	Rows   RowSource
+}
+
+// RowSource передает строки таблицы в write по порядку, не держа их все в памяти.
+// Ошибка write или самого источника прерывает выгрузку.
+type RowSource func(write func(row []interface{}) error) error
+
+// StaticRows - источник строк, которые уже в памяти
+func StaticRows(rows ...[]interface{}) RowSource {
+	return func(write func(row []interface{}) error) error {
+		for _, row := range rows {
+			if err := write(row); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func ContentType(format string) string {
+	return contentTypes[format]
+}
+
+// NegotiateFormat выбирает первый поддерживаемый формат из заголовка Accept.
+// Пустой Accept и */* означают CSV.
+func NegotiateFormat(accept string) (string, bool) {
+	if strings.TrimSpace(accept) == "" {
+		return FormatCSV, true
+	}
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		switch mediaType {
+		case "*/*", "text/*", "text/csv":
+			return FormatCSV, true
+		case ContentType(FormatXLSX):
+			return FormatXLSX, true
+		case "application/jsonl", "application/x-ndjson", "application/x-jsonlines":
+			return FormatJSONL, true
+		}
+	}
+	return "", false
+}
+
+// Write выгружает таблицы в формате format по мере чтения строк:
+//   - CSV - одна таблица с колонкой record и объединением колонок всех таблиц;
+//   - XLSX - по листу на таблицу;
+//   - JSONL - по объекту на строку с полем record.
+func Write(w io.Writer, format string, tables []Table) error {
+	switch format {
+	case FormatCSV:
+		return writeCSV(w, tables)
+	case FormatXLSX:
+		return writeXLSX(w, tables)
+	case FormatJSONL:
+		return writeJSONL(w, tables)
+	}
+	return ErrUnknownFormat
+}
+
+func formatValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case time.Time:
+		return v.Format(time.RFC3339)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func writeCSV(w io.Writer, tables []Table) error {
+	header := []string{"record"}
+	columns := map[string]int{}
+	for _, table := range tables {
+		for _, column := range table.Header {
+			if _, ok := columns[column]; !ok {
+				columns[column] = len(header)
+				header = append(header, column)
+			}
+		}
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+	for _, table := range tables {
+		err := table.Rows(func(row []interface{}) error {
+			record := make([]string, len(header))
+			record[0] = table.Name
+			for i, value := range row {
+				record[columns[table.Header[i]]] = formatValue(value)
+			}
+			return writer.Write(record)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// writeXLSX пишет книгу прямо в w: листы строятся по мере чтения строк, а не в памяти.
+// Строки хранятся в ячейках (inlineStr), поэтому общая таблица строк не нужна.
+func writeXLSX(w io.Writer, tables []Table) error {
+	archive := zip.NewWriter(w)
+
+	var sheets, sheetRels, sheetTypes strings.Builder
+	for i, table := range tables {
+		n := strconv.Itoa(i + 1)
+		fmt.Fprintf(&sheets, `<sheet name="%s" sheetId="%s" r:id="rId%s"/>`, escapeXML(table.Name), n, n)
+		fmt.Fprintf(&sheetRels, `<Relationship Id="rId%s" Type="%s/worksheet" Target="worksheets/sheet%s.xml"/>`, n, xlsxRelationships, n)
+		fmt.Fprintf(&sheetTypes, `<Override PartName="/xl/worksheets/sheet%s.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>`, n)
+	}
+	parts := []struct{ name, content string }{
+		{"[Content_Types].xml", xml.Header + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+			`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+			`<Default Extension="xml" ContentType="application/xml"/>` +
+			`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+			sheetTypes.String() + `</Types>`},
+		{"_rels/.rels", xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+			`<Relationship Id="rId1" Type="` + xlsxRelationships + `/officeDocument" Target="xl/workbook.xml"/></Relationships>`},
+		{"xl/workbook.xml", xml.Header + `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" ` +
+			`xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets>` + sheets.String() + `</sheets></workbook>`},
+		{"xl/_rels/workbook.xml.rels", xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+			sheetRels.String() + `</Relationships>`},
+	}
+	for _, part := range parts {
+		file, err := archive.Create(part.name)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(file, part.content); err != nil {
+			return err
+		}
+	}
+
+	for i, table := range tables {
+		file, err := archive.Create("xl/worksheets/sheet" + strconv.Itoa(i+1) + ".xml")
+		if err != nil {
+			return err
+		}
+		if err := writeSheet(file, table); err != nil {
+			return err
+		}
+	}
+	return archive.Close()
+}
+
+const xlsxRelationships = "http://schemas.openxmlformats.org/officeDocument/2006/relationships"
+
+func writeSheet(w io.Writer, table Table) error {
+	buffered := bufio.NewWriter(w)
+	buffered.WriteString(xml.Header + `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+	rowNumber := 0
+	writeRow := func(row []interface{}) error {
+		rowNumber++
+		fmt.Fprintf(buffered, `<row r="%d">`, rowNumber)
+		for i, value := range row {
+			if value == nil {
+				continue
+			}
+			cell, err := excelize.CoordinatesToCellName(i+1, rowNumber)
+			if err != nil {
+				return err
+			}
+			switch v := cellValue(value).(type) {
+			case string:
+				fmt.Fprintf(buffered, `<c r="%s" t="inlineStr"><is><t xml:space="preserve">%s</t></is></c>`, cell, escapeXML(v))
+			case bool:
+				flag := 0
+				if v {
+					flag = 1
+				}
+				fmt.Fprintf(buffered, `<c r="%s" t="b"><v>%d</v></c>`, cell, flag)
+			default:
+				fmt.Fprintf(buffered, `<c r="%s"><v>%s</v></c>`, cell, escapeXML(fmt.Sprint(v)))
+			}
+		}
+		_, err := buffered.WriteString(`</row>`)
+		return err
+	}
+	if err := writeRow(stringsToCells(table.Header)); err != nil {
+		return err
+	}
+	if err := table.Rows(writeRow); err != nil {
+		return err
+	}
+	buffered.WriteString(`</sheetData></worksheet>`)
+	return buffered.Flush()
+}
+
+func escapeXML(s string) string {
+	var escaped strings.Builder
+	_ = xml.EscapeText(&escaped, []byte(s))
+	return escaped.String()
+}
+
+// cellValue пишет время строкой RFC3339, как в CSV, остальные значения - как есть
+func cellValue(value interface{}) interface{} {
+	if t, ok := value.(time.Time); ok {
+		return t.Format(time.RFC3339)
+	}
+	return value
+}
+
+func stringsToCells(values []string) []interface{} {
+	cells := make([]interface{}, len(values))
+	for i, value := range values {
+		cells[i] = value
+	}
+	return cells
+}
+
+func writeJSONL(w io.Writer, tables []Table) error {
+	buffered := bufio.NewWriter(w)
+	for _, table := range tables {
+		err := table.Rows(func(row []interface{}) error {
+			// Поля пишутся в порядке колонок, record - первым
+			name, _ := json.Marshal(table.Name)
+			line := append([]byte(`{"record":`), name...)
+			for i, value := range row {
+				if value == nil {
+					continue
+				}
+				key, _ := json.Marshal(table.Header[i])
+				encoded, err := json.Marshal(cellValue(value))
+				if err != nil {
+					return err
+				}
+				line = append(line, ',')
+				line = append(line, key...)
+				line = append(line, ':')
+				line = append(line, encoded...)
+			}
+			line = append(line, '}', '\n')
+			_, err := buffered.Write(line)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return buffered.Flush()
+}
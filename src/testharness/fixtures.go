@@ -0,0 +1,57 @@
+package testharness
+
+import (
+	"gorm.io/gorm"
+	"zadanie_6105/src/models"
+)
+
+// Fixtures - организации и сотрудники, с которыми стартует каждая база
+type Fixtures struct {
+	// Alpha - организация с ответственными во всех ролях
+	Alpha models.Organization
+	// Beta - вторая организация, ее администратор не отвечает за Alpha
+	Beta models.Organization
+
+	AlphaAdmin    models.Employee
+	AlphaAuthor   models.Employee
+	AlphaApprover models.Employee
+	AlphaViewer   models.Employee
+	BetaAdmin     models.Employee
+	// Bidder не состоит ни в одной организации и подает предложения
+	Bidder models.Employee
+}
+
+func loadFixtures(db *gorm.DB) (*Fixtures, error) {
+	f := &Fixtures{
+		Alpha:         models.Organization{Name: "Alpha", Description: "Строительная компания", Type: "LLC"},
+		Beta:          models.Organization{Name: "Beta", Description: "Логистическая компания", Type: "JSC"},
+		AlphaAdmin:    models.Employee{Username: "alpha_admin", FirstName: "Анна", LastName: "Смирнова"},
+		AlphaAuthor:   models.Employee{Username: "alpha_author", FirstName: "Иван", LastName: "Петров"},
+		AlphaApprover: models.Employee{Username: "alpha_approver", FirstName: "Олег", LastName: "Козлов"},
+		AlphaViewer:   models.Employee{Username: "alpha_viewer", FirstName: "Мария", LastName: "Иванова"},
+		BetaAdmin:     models.Employee{Username: "beta_admin", FirstName: "Петр", LastName: "Соколов"},
+		Bidder:        models.Employee{Username: "bidder", FirstName: "Елена", LastName: "Новикова"},
+	}
+
+	records := []interface{}{
+		&f.Alpha, &f.Beta,
+		&f.AlphaAdmin, &f.AlphaAuthor, &f.AlphaApprover, &f.AlphaViewer, &f.BetaAdmin, &f.Bidder,
+	}
+	for _, record := range records {
+		if err := db.Omit("ResponsibleEmployees", "OrganizationsResponsible").Create(record).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	responsibles := []models.OrganizationResponsible{
+		{OrganizationID: f.Alpha.ID, UserID: f.AlphaAdmin.ID, Role: models.RoleOrgAdmin},
+		{OrganizationID: f.Alpha.ID, UserID: f.AlphaAuthor.ID, Role: models.RoleTenderAuthor},
+		{OrganizationID: f.Alpha.ID, UserID: f.AlphaApprover.ID, Role: models.RoleApprover},
+		{OrganizationID: f.Alpha.ID, UserID: f.AlphaViewer.ID, Role: models.RoleViewer},
+		{OrganizationID: f.Beta.ID, UserID: f.BetaAdmin.ID, Role: models.RoleOrgAdmin},
+	}
+	if err := db.Omit("Organization", "Employee").Create(&responsibles).Error; err != nil {
+		return nil, err
+	}
+	return f, nil
+}
@@ -0,0 +1,156 @@
+// Package testharness поднимает одноразовую базу PostgreSQL с таблицами и фикстурами
+// и настоящий роутер routes.RegisterRoutes для интеграционных тестов.
+//
+// Если задана TEST_POSTGRES_CONN, используется указанный сервер, иначе запускается
+// встроенный PostgreSQL. Каждый вызов New создает отдельную базу и удаляет ее
+// после теста. Когда сервер недоступен, тест пропускается.
+package testharness
+
+import (
+	"bytes"
+	"encoding/json"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+	"zadanie_6105/src/cache"
+	"zadanie_6105/src/database"
+	"zadanie_6105/src/idempotency"
+	"zadanie_6105/src/mailer"
+	"zadanie_6105/src/routes"
+	"zadanie_6105/src/services"
+)
+
+// baseSchema - таблицы пользователей и организаций из задания, их сервис не мигрирует
+var baseSchema = []string{
+	`CREATE EXTENSION IF NOT EXISTS "uuid-ossp"`,
+	`CREATE TABLE employee (
+		id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+		username VARCHAR(50) UNIQUE NOT NULL,
+		first_name VARCHAR(50),
+		last_name VARCHAR(50),
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+	)`,
+	`CREATE TYPE organization_type AS ENUM ('IE', 'LLC', 'JSC')`,
+	`CREATE TABLE organization (
+		id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+		name VARCHAR(100) NOT NULL,
+		description TEXT,
+		type organization_type,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+	)`,
+	`CREATE TABLE organization_responsible (
+		id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+		organization_id UUID REFERENCES organization(id) ON DELETE CASCADE,
+		user_id UUID REFERENCES employee(id) ON DELETE CASCADE
+	)`,
+}
+
+type Harness struct {
+	DB       *gorm.DB
+	Service  *services.Service
+	Router   http.Handler
+	Fixtures *Fixtures
+}
+
+// Main запускает тесты пакета и останавливает встроенный сервер после них
+func Main(m *testing.M) {
+	code := m.Run()
+	stopEmbedded()
+	os.Exit(code)
+}
+
+// New создает базу, применяет схему и фикстуры и собирает роутер
+func New(t testing.TB) *Harness {
+	t.Helper()
+	if testing.Short() {
+		t.Skip("Integration tests are skipped in short mode")
+	}
+
+	connStr, err := serverConn()
+	if err != nil {
+		t.Skipf("PostgreSQL is not available: %v", err)
+	}
+
+	admin, err := gorm.Open(postgres.Open(connStr), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		t.Skipf("PostgreSQL is not available: %v", err)
+	}
+	name := randomDatabaseName(t)
+	if err := admin.Exec("CREATE DATABASE " + name).Error; err != nil {
+		t.Skipf("PostgreSQL is not available: %v", err)
+	}
+
+	db, err := database.Open(withDatabase(connStr, name))
+	if err != nil {
+		t.Fatalf("Failed to connect to database %s: %v", name, err)
+	}
+	db.Logger = logger.Discard
+	t.Cleanup(func() {
+		if sqlDB, err := db.DB(); err == nil {
+			sqlDB.Close()
+		}
+		admin.Exec("DROP DATABASE IF EXISTS " + name + " WITH (FORCE)")
+		if sqlDB, err := admin.DB(); err == nil {
+			sqlDB.Close()
+		}
+	})
+
+	for _, statement := range baseSchema {
+		if err := db.Exec(statement).Error; err != nil {
+			t.Fatalf("Failed to apply schema: %v", err)
+		}
+	}
+	if err := database.Migrate(db); err != nil {
+		t.Fatalf("Failed to migrate database: %v", err)
+	}
+
+	fixtures, err := loadFixtures(db)
+	if err != nil {
+		t.Fatalf("Failed to load fixtures: %v", err)
+	}
+
+	service := services.NewService(db, mailer.LogMailer{})
+	// Общий кеш списка тендеров включен, чтобы тесты ловили пропущенную инвалидацию
+	service.UseCache(cache.NewPostgresStore(db), time.Minute)
+	return &Harness{
+		DB:       db,
+		Service:  service,
+		Router:   routes.RegisterRoutes(service, idempotency.NewMemoryStore()),
+		Fixtures: fixtures,
+	}
+}
+
+// Do отправляет запрос в роутер; body кодируется в JSON, если это не nil
+func (h *Harness) Do(t testing.TB, method, target string, body interface{}) *httptest.ResponseRecorder {
+	t.Helper()
+
+	var payload []byte
+	if body != nil {
+		var err error
+		if payload, err = json.Marshal(body); err != nil {
+			t.Fatalf("Failed to encode body: %v", err)
+		}
+	}
+	req := httptest.NewRequest(method, target, bytes.NewReader(payload))
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	rec := httptest.NewRecorder()
+	h.Router.ServeHTTP(rec, req)
+	return rec
+}
+
+// Decode разбирает JSON ответа в out
+func Decode(t testing.TB, rec *httptest.ResponseRecorder, out interface{}) {
+	t.Helper()
+	if err := json.Unmarshal(rec.Body.Bytes(), out); err != nil {
+		t.Fatalf("Failed to decode response %q: %v", rec.Body.String(), err)
+	}
+}
@@ -0,0 +1,101 @@
+package testharness
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	embeddedpostgres "github.com/fergusstrange/embedded-postgres"
+	"net"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Переменная окружения с адресом готового сервера PostgreSQL.
+// Без нее поднимается встроенный сервер.
+const connEnv = "TEST_POSTGRES_CONN"
+
+var (
+	serverOnce    sync.Once
+	serverConnStr string
+	serverErr     error
+	embedded      *embeddedpostgres.EmbeddedPostgres
+	runtimeDir    string
+)
+
+// serverConn возвращает адрес сервера, при необходимости запуская встроенный
+func serverConn() (string, error) {
+	serverOnce.Do(func() {
+		if connStr := os.Getenv(connEnv); connStr != "" {
+			serverConnStr = connStr
+			return
+		}
+		serverConnStr, serverErr = startEmbedded()
+	})
+	return serverConnStr, serverErr
+}
+
+func startEmbedded() (string, error) {
+	port, err := freePort()
+	if err != nil {
+		return "", err
+	}
+	runtimeDir, err = os.MkdirTemp("", "tender-postgres-")
+	if err != nil {
+		return "", err
+	}
+
+	config := embeddedpostgres.DefaultConfig().
+		Version(embeddedpostgres.V16).
+		Port(port).
+		RuntimePath(runtimeDir).
+		StartTimeout(time.Minute).
+		Logger(nil)
+	embedded = embeddedpostgres.NewDatabase(config)
+	if err := embedded.Start(); err != nil {
+		embedded = nil
+		os.RemoveAll(runtimeDir)
+		return "", fmt.Errorf("start embedded postgres: %w", err)
+	}
+	return config.GetConnectionURL() + "?sslmode=disable", nil
+}
+
+// stopEmbedded останавливает встроенный сервер, если он запускался
+func stopEmbedded() {
+	if embedded == nil {
+		return
+	}
+	embedded.Stop()
+	os.RemoveAll(runtimeDir)
+}
+
+func freePort() (uint32, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return 0, err
+	}
+	defer listener.Close()
+	return uint32(listener.Addr().(*net.TCPAddr).Port), nil
+}
+
+// withDatabase подставляет имя базы в строку подключения в формате URL или key=value
+func withDatabase(connStr, name string) string {
+	if strings.HasPrefix(connStr, "postgres://") || strings.HasPrefix(connStr, "postgresql://") {
+		if u, err := url.Parse(connStr); err == nil {
+			u.Path = "/" + name
+			return u.String()
+		}
+	}
+	return connStr + " dbname=" + name
+}
+
+func randomDatabaseName(t testing.TB) string {
+	buf := make([]byte, 6)
+	if _, err := rand.Read(buf); err != nil {
+		t.Fatalf("Failed to generate database name: %v", err)
+	}
+	return "tender_test_" + hex.EncodeToString(buf)
+}